@@ -3,7 +3,10 @@ package common
 import (
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"strconv"
+	"treffly/api/models"
 	"treffly/token"
 )
 
@@ -32,6 +35,29 @@ func GetUserIDFromContextPayload(ctx *gin.Context) int32 {
 	return userID
 }
 
+func GetSessionIDFromContextPayload(ctx *gin.Context) uuid.UUID {
+	authPayload := ctx.MustGet(AuthorizationPayloadKey).(*token.Payload)
+	return authPayload.SessionID
+}
+
+func GetRoleFromContextPayload(ctx *gin.Context) string {
+	authPayload := ctx.MustGet(AuthorizationPayloadKey).(*token.Payload)
+	return authPayload.Role
+}
+
+// ParseIDParam reads a path parameter holding a database ID.
+func ParseIDParam(ctx *gin.Context, param string) (int32, error) {
+	id, err := strconv.ParseInt(ctx.Param(param), 10, 32)
+	return int32(id), err
+}
+
+func GetSessionMeta(ctx *gin.Context) models.SessionMeta {
+	return models.SessionMeta{
+		UserAgent: ctx.Request.UserAgent(),
+		ClientIP:  ctx.ClientIP(),
+	}
+}
+
 func GetUserLocation(ctx *gin.Context) (lat pgtype.Numeric, lon pgtype.Numeric, err error) {
 	latStr := ctx.Query("user_lat")
 	if latStr == "" {
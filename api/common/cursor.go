@@ -0,0 +1,27 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// EncodeCursor turns the sort key of the last row on a page into an opaque
+// token the client sends back for the next page.
+func EncodeCursor(c any) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(s string, c any) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	if err := json.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"treffly/apperror"
+	"treffly/moderation"
+)
+
+// localModerator takes over when the configured moderator cannot answer, so
+// an outage of the external service does not block writes.
+var localModerator = moderation.NewWordListModerator(moderation.DefaultWords, nil)
+
+// Moderate checks user-provided text fields and reports a rejection as
+// ContentRejected pointing at the offending field.
+func Moderate(ctx context.Context, moderator moderation.Moderator, fields ...moderation.Field) error {
+	err := moderation.CheckFields(ctx, moderator, fields...)
+	if err == nil {
+		return nil
+	}
+
+	var rejection *moderation.Rejection
+	if !errors.As(err, &rejection) {
+		err = moderation.CheckFields(ctx, localModerator, fields...)
+		if err == nil {
+			return nil
+		}
+		if !errors.As(err, &rejection) {
+			return apperror.InternalServer.WithCause(err)
+		}
+	}
+
+	return apperror.ContentRejected.WithField(rejection.Field, err)
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"treffly/apperror"
+	"treffly/moderation"
+
+	"github.com/stretchr/testify/require"
+)
+
+type unavailableModerator struct{}
+
+func (unavailableModerator) Check(context.Context, string) error {
+	return errors.New("moderation service returned status 503")
+}
+
+func TestModerateFallsBackToWordList(t *testing.T) {
+	err := Moderate(context.Background(), unavailableModerator{},
+		moderation.Field{Name: "name", Text: "Shiitake cooking class"},
+	)
+	require.NoError(t, err)
+
+	err = Moderate(context.Background(), unavailableModerator{},
+		moderation.Field{Name: "name", Text: "Пикник"},
+		moderation.Field{Name: "description", Text: "полная хуйня"},
+	)
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.ContentRejected.HTTPCode, appErr.HTTPCode)
+	require.Equal(t, "description", appErr.Field)
+}
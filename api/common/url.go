@@ -0,0 +1,41 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+func ImageURL(env, domain, path string) string {
+	if path == "" {
+		return ""
+	}
+	normalizedPath := strings.ReplaceAll(path, "\\", "/")
+
+	url := fmt.Sprintf("%s/images/%s", baseURL(env, domain), normalizedPath)
+
+	return url
+}
+
+func CalendarFeedURL(env, domain, token string) string {
+	return fmt.Sprintf("%s/calendar/%s.ics", baseURL(env, domain), token)
+}
+
+func AppURL(env, domain, path string) string {
+	protocol := "http"
+	if env == "production" {
+		protocol = "https"
+	}
+
+	return fmt.Sprintf("%s://%s%s", protocol, domain, path)
+}
+
+func baseURL(env, domain string) string {
+	prefix := ""
+	protocol := "http"
+	if env == "production" {
+		protocol = "https"
+		prefix = "/api"
+	}
+
+	return fmt.Sprintf("%s://%s%s", protocol, domain, prefix)
+}
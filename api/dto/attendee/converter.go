@@ -0,0 +1,40 @@
+package attendeedto
+
+import (
+	"treffly/api/common"
+	"treffly/api/models"
+)
+
+type AttendeeConverter struct {
+	env    string
+	domain string
+}
+
+func NewAttendeeConverter(env, domain string) *AttendeeConverter {
+	return &AttendeeConverter{
+		env:    env,
+		domain: domain,
+	}
+}
+
+func (c *AttendeeConverter) ToAttendeeResponse(a models.Attendee) AttendeeResponse {
+	return AttendeeResponse{
+		ID:       a.UserID,
+		Username: a.Username,
+		ImageURL: common.ImageURL(c.env, c.domain, a.UserImagePath),
+		JoinedAt: a.JoinedAt,
+	}
+}
+
+func (c *AttendeeConverter) ToAttendeesPageResponse(p models.AttendeesPage) AttendeesPageResponse {
+	result := make([]AttendeeResponse, len(p.Attendees))
+	for i, a := range p.Attendees {
+		result[i] = c.ToAttendeeResponse(a)
+	}
+
+	return AttendeesPageResponse{
+		Participants: result,
+		NextCursor:   p.NextCursor,
+		HasMore:      p.HasMore,
+	}
+}
@@ -0,0 +1,6 @@
+package attendeedto
+
+type ListAttendeesRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int32  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,16 @@
+package attendeedto
+
+import "time"
+
+type AttendeeResponse struct {
+	ID       int32     `json:"id"`
+	Username string    `json:"username"`
+	ImageURL string    `json:"image_url,omitempty"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type AttendeesPageResponse struct {
+	Participants []AttendeeResponse `json:"participants"`
+	NextCursor   string             `json:"next_cursor,omitempty"`
+	HasMore      bool               `json:"has_more"`
+}
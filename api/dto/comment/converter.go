@@ -0,0 +1,60 @@
+package commentdto
+
+import (
+	"treffly/api/common"
+	"treffly/api/models"
+)
+
+type CommentConverter struct {
+	env    string
+	domain string
+}
+
+func NewCommentConverter(env, domain string) *CommentConverter {
+	return &CommentConverter{
+		env:    env,
+		domain: domain,
+	}
+}
+
+func (c *CommentConverter) ToCommentResponse(comment models.Comment) CommentResponse {
+	resp := CommentResponse{
+		ID:        comment.ID,
+		ParentID:  comment.ParentID,
+		Body:      comment.Body,
+		IsPinned:  comment.IsPinned,
+		IsEdited:  comment.IsEdited,
+		IsDeleted: comment.IsDeleted,
+		CreatedAt: comment.CreatedAt,
+	}
+
+	if comment.UserID != 0 {
+		resp.Author = &AuthorResponse{
+			ID:       comment.UserID,
+			Username: comment.Username,
+			ImageURL: common.ImageURL(c.env, c.domain, comment.UserImagePath),
+		}
+	}
+
+	if len(comment.Replies) > 0 {
+		resp.Replies = c.ToCommentResponses(comment.Replies)
+	}
+
+	return resp
+}
+
+func (c *CommentConverter) ToCommentResponses(comments []models.Comment) []CommentResponse {
+	result := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		result[i] = c.ToCommentResponse(comment)
+	}
+	return result
+}
+
+func (c *CommentConverter) ToCommentsPageResponse(p models.CommentsPage) CommentsPageResponse {
+	return CommentsPageResponse{
+		Comments:   c.ToCommentResponses(p.Comments),
+		NextCursor: p.NextCursor,
+		HasMore:    p.HasMore,
+	}
+}
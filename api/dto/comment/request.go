@@ -0,0 +1,15 @@
+package commentdto
+
+type CreateCommentRequest struct {
+	Body     string `json:"body" binding:"required,min=1,max=2000"`
+	ParentID int32  `json:"parent_id" binding:"omitempty,min=1"`
+}
+
+type UpdateCommentRequest struct {
+	Body string `json:"body" binding:"required,min=1,max=2000"`
+}
+
+type ListCommentsRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  int32  `form:"limit" binding:"omitempty,min=1,max=100"`
+}
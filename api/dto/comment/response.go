@@ -0,0 +1,27 @@
+package commentdto
+
+import "time"
+
+type AuthorResponse struct {
+	ID       int32  `json:"id"`
+	Username string `json:"username"`
+	ImageURL string `json:"image_url,omitempty"`
+}
+
+type CommentResponse struct {
+	ID        int32             `json:"id"`
+	ParentID  int32             `json:"parent_id,omitempty"`
+	Author    *AuthorResponse   `json:"author,omitempty"`
+	Body      string            `json:"body"`
+	IsPinned  bool              `json:"is_pinned"`
+	IsEdited  bool              `json:"is_edited"`
+	IsDeleted bool              `json:"is_deleted"`
+	CreatedAt time.Time         `json:"created_at"`
+	Replies   []CommentResponse `json:"replies,omitempty"`
+}
+
+type CommentsPageResponse struct {
+	Comments   []CommentResponse `json:"comments"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasMore    bool              `json:"has_more"`
+}
@@ -19,24 +19,32 @@ func NewEventConverter(env, domain string) *EventConverter {
 
 func (c *EventConverter) ToEventResponse(e models.Event) EventResponse {
 	return EventResponse{
-		ID:               e.ID,
-		Name:             e.Name,
-		Description:      e.Description,
-		Capacity:         e.Capacity,
-		Latitude:         e.Latitude,
-		Longitude:        e.Longitude,
-		Address:          e.Address,
-		Date:             e.Date,
-		IsPrivate:        e.IsPrivate,
-		IsPremium:        e.IsPremium,
-		CreatedAt:        e.CreatedAt,
-		OwnerUsername: 	  e.OwnerUsername,
-		IsOwner:          e.IsOwner,
-		IsParticipant:    e.IsParticipant,
-		Tags:             c.convertTagsToResponse(e.Tags),
-		ParticipantCount: e.ParticipantCount,
-		ImageEventURL:    common.ImageURL(c.env, c.domain, e.ImagePath),
-		ImageUserURL:     common.ImageURL(c.env, c.domain, e.OwnerImagePath),
+		ID:                e.ID,
+		Name:              e.Name,
+		Description:       e.Description,
+		Capacity:          e.Capacity,
+		Latitude:          e.Latitude,
+		Longitude:         e.Longitude,
+		Address:           e.Address,
+		Date:              e.Date,
+		IsPrivate:         e.IsPrivate,
+		IsPremium:         e.IsPremium,
+		CreatedAt:         e.CreatedAt,
+		OwnerID:           e.OwnerID,
+		OwnerUsername:     e.OwnerUsername,
+		IsOwner:           e.IsOwner,
+		IsParticipant:     e.IsParticipant,
+		Tags:              c.convertTagsToResponse(e.Tags),
+		ParticipantCount:  e.ParticipantCount,
+		WaitlistCount:     e.WaitlistCount,
+		WaitlistPosition:  e.WaitlistPosition,
+		SeriesID:          e.SeriesID,
+		ImageEventURL:     common.ImageURL(c.env, c.domain, e.ImagePath),
+		ImageUserURL:      common.ImageURL(c.env, c.domain, e.OwnerImagePath),
+		NameHighlight:     e.NameHighlight,
+		Snippet:           e.Snippet,
+		OwnerRating:       e.OwnerRating,
+		OwnerReviewsCount: e.OwnerReviewsCount,
 	}
 }
 
@@ -59,6 +67,42 @@ func (c *EventConverter) ToEventsResponse(events []models.Event) []EventResponse
 	return result
 }
 
+func (c *EventConverter) ToEventMarkersResponse(markers []models.EventMarker) []EventMarkerResponse {
+	result := make([]EventMarkerResponse, len(markers))
+	for i, m := range markers {
+		result[i] = EventMarkerResponse{
+			ID:        m.ID,
+			Name:      m.Name,
+			Latitude:  m.Latitude,
+			Longitude: m.Longitude,
+			Date:      m.Date,
+			IsPremium: m.IsPremium,
+		}
+	}
+	return result
+}
+
+func (c *EventConverter) ToEventClustersResponse(clusters []models.EventCluster) []EventClusterResponse {
+	result := make([]EventClusterResponse, len(clusters))
+	for i, cl := range clusters {
+		result[i] = EventClusterResponse{
+			Count:     cl.Count,
+			Latitude:  cl.Latitude,
+			Longitude: cl.Longitude,
+			EventIDs:  cl.EventIDs,
+		}
+	}
+	return result
+}
+
+func (c *EventConverter) ToEventsPageResponse(p models.EventsPage) EventsPageResponse {
+	return EventsPageResponse{
+		Events:     c.ToEventsResponse(p.Events),
+		NextCursor: p.NextCursor,
+		HasMore:    p.HasMore,
+	}
+}
+
 func (c *EventConverter) ToHomeEventsResponse(h models.HomeEvents) HomeEventsResponse {
 	return HomeEventsResponse{
 		Premium:     c.ToEventsResponse(h.Premium),
@@ -15,6 +15,7 @@ type CreateEventRequest struct {
 	Date        time.Time      `form:"date" binding:"required,valid_date"`
 	IsPrivate   bool           `form:"is_private" binding:"boolean"`
 	Tags        []int32        `form:"tags" binding:"required,min=1,max=3,dive,required,positive"`
+	Recurrence  string         `form:"recurrence" binding:"omitempty,max=200"`
 }
 
 type UpdateEventRequest struct {
@@ -28,4 +29,9 @@ type UpdateEventRequest struct {
 	IsPrivate   bool           `form:"is_private" binding:"boolean"`
 	Tags        []int32        `form:"tags" binding:"required,min=1,max=3,dive,required,positive"`
 	DeleteImage bool           `form:"delete_image" binding:"boolean"`
+	Scope       string         `form:"scope" binding:"omitempty,oneof=this following"`
+}
+
+type UpdatePremiumRequest struct {
+	IsPremium *bool `json:"is_premium" binding:"required"`
 }
@@ -5,24 +5,34 @@ import (
 )
 
 type EventResponse struct {
-	ID               int32         `json:"id"`
-	Name             string        `json:"name"`
-	Description      string        `json:"description,omitempty"`
-	Capacity         int32         `json:"capacity"`
-	Latitude         float64       `json:"latitude"`
-	Longitude        float64       `json:"longitude"`
-	Address          string        `json:"address"`
-	Date             time.Time     `json:"date"`
-	IsPrivate        bool          `json:"is_private"`
-	IsPremium        bool          `json:"is_premium"`
-	CreatedAt        time.Time     `json:"created_at"`
-	OwnerUsername    string        `json:"owner_username"`
-	IsOwner          bool          `json:"is_owner"`
-	IsParticipant    bool          `json:"is_participant"`
-	Tags             []TagResponse `json:"tags"`
-	ParticipantCount int           `json:"participant_count"`
-	ImageEventURL    string        `json:"image_event_url"`
-	ImageUserURL     string        `json:"image_user_url"`
+	ID                int32         `json:"id"`
+	Name              string        `json:"name"`
+	Description       string        `json:"description,omitempty"`
+	Capacity          int32         `json:"capacity"`
+	Latitude          float64       `json:"latitude"`
+	Longitude         float64       `json:"longitude"`
+	Address           string        `json:"address"`
+	Date              time.Time     `json:"date"`
+	IsPrivate         bool          `json:"is_private"`
+	IsPremium         bool          `json:"is_premium"`
+	CreatedAt         time.Time     `json:"created_at"`
+	OwnerID           int32         `json:"owner_id"`
+	OwnerUsername     string        `json:"owner_username"`
+	IsOwner           bool          `json:"is_owner"`
+	IsParticipant     bool          `json:"is_participant"`
+	Tags              []TagResponse `json:"tags"`
+	ParticipantCount  int           `json:"participant_count"`
+	WaitlistCount     int           `json:"waitlist_count"`
+	WaitlistPosition  int           `json:"waitlist_position"`
+	SeriesID          int32         `json:"series_id,omitempty"`
+	ImageEventURL     string        `json:"image_event_url"`
+	ImageUserURL      string        `json:"image_user_url"`
+	NameHighlight     string        `json:"name_highlight,omitempty"`
+	Snippet           string        `json:"snippet,omitempty"`
+	// Listed events are mostly upcoming and have no reviews of their own, so
+	// lists carry the organiser's average over all of their past events.
+	OwnerRating       float64       `json:"owner_rating,omitempty"`
+	OwnerReviewsCount int           `json:"owner_reviews_count"`
 }
 
 type TagResponse struct {
@@ -30,6 +40,28 @@ type TagResponse struct {
 	Name string `json:"name"`
 }
 
+type EventMarkerResponse struct {
+	ID        int32     `json:"id"`
+	Name      string    `json:"name"`
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Date      time.Time `json:"date"`
+	IsPremium bool      `json:"is_premium"`
+}
+
+type EventClusterResponse struct {
+	Count     int     `json:"count"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	EventIDs  []int32 `json:"event_ids"`
+}
+
+type EventsPageResponse struct {
+	Events     []EventResponse `json:"events"`
+	NextCursor string          `json:"next_cursor,omitempty"`
+	HasMore    bool            `json:"has_more"`
+}
+
 type HomeEventsResponse struct {
 	Premium     []EventResponse `json:"premium"`
 	Recommended []EventResponse `json:"recommended"`
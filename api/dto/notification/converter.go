@@ -0,0 +1,29 @@
+package notificationdto
+
+import "treffly/api/models"
+
+func ToNotificationResponse(n models.Notification) NotificationResponse {
+	return NotificationResponse{
+		ID:            n.ID,
+		Type:          n.Type,
+		EventID:       n.EventID,
+		EventName:     n.EventName,
+		ActorID:       n.ActorID,
+		ActorUsername: n.ActorUsername,
+		IsRead:        n.IsRead,
+		CreatedAt:     n.CreatedAt,
+	}
+}
+
+func ToNotificationsPageResponse(p models.NotificationsPage) NotificationsPageResponse {
+	notifications := make([]NotificationResponse, len(p.Notifications))
+	for i, n := range p.Notifications {
+		notifications[i] = ToNotificationResponse(n)
+	}
+
+	return NotificationsPageResponse{
+		Notifications: notifications,
+		UnreadCount:   p.UnreadCount,
+		HasMore:       p.HasMore,
+	}
+}
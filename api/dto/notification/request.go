@@ -0,0 +1,7 @@
+package notificationdto
+
+type ListNotificationsRequest struct {
+	UnreadOnly bool  `form:"unread_only"`
+	Limit      int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset     int32 `form:"offset" binding:"omitempty,min=0"`
+}
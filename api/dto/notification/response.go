@@ -0,0 +1,24 @@
+package notificationdto
+
+import "time"
+
+type NotificationResponse struct {
+	ID            int32     `json:"id"`
+	Type          string    `json:"type"`
+	EventID       int32     `json:"event_id,omitempty"`
+	EventName     string    `json:"event_name"`
+	ActorID       int32     `json:"actor_id,omitempty"`
+	ActorUsername string    `json:"actor_username,omitempty"`
+	IsRead        bool      `json:"is_read"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type NotificationsPageResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int                    `json:"unread_count"`
+	HasMore       bool                   `json:"has_more"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int `json:"unread_count"`
+}
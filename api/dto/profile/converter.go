@@ -0,0 +1,59 @@
+package profiledto
+
+import (
+	"treffly/api/common"
+	eventdto "treffly/api/dto/event"
+	reviewdto "treffly/api/dto/review"
+	userdto "treffly/api/dto/user"
+	"treffly/api/models"
+)
+
+type ProfileConverter struct {
+	env    string
+	domain string
+	events *eventdto.EventConverter
+}
+
+func NewProfileConverter(env, domain string, events *eventdto.EventConverter) *ProfileConverter {
+	return &ProfileConverter{
+		env:    env,
+		domain: domain,
+		events: events,
+	}
+}
+
+func (c *ProfileConverter) ToPublicProfileResponse(p models.PublicProfile) PublicProfileResponse {
+	tags := make([]userdto.TagResponse, len(p.Tags))
+	for i, t := range p.Tags {
+		tags[i] = userdto.TagResponse{
+			ID:   t.ID,
+			Name: t.Name,
+		}
+	}
+
+	resp := PublicProfileResponse{
+		ID:                p.ID,
+		Username:          p.Username,
+		ImageURL:          common.ImageURL(c.env, c.domain, p.ImagePath),
+		CreatedAt:         p.CreatedAt,
+		Tags:              tags,
+		HostedEventsCount: p.HostedEventsCount,
+		FollowersCount:    p.FollowersCount,
+		FollowingCount:    p.FollowingCount,
+		IsFollowing:       p.IsFollowing,
+		Rating:            reviewdto.ToRatingResponse(p.Rating),
+		UpcomingEvents:    c.events.ToEventsResponse(p.UpcomingEvents),
+		AttendanceHidden:  p.AttendanceHidden,
+	}
+	if p.AttendedEvents != nil {
+		resp.AttendedEvents = c.events.ToEventsResponse(p.AttendedEvents)
+	}
+
+	return resp
+}
+
+func ToPrivacyResponse(s models.PrivacySettings) PrivacyResponse {
+	return PrivacyResponse{
+		HideAttendance: s.HideAttendance,
+	}
+}
@@ -0,0 +1,5 @@
+package profiledto
+
+type UpdatePrivacyRequest struct {
+	HideAttendance *bool `json:"hide_attendance" binding:"required"`
+}
@@ -0,0 +1,28 @@
+package profiledto
+
+import (
+	"time"
+	eventdto "treffly/api/dto/event"
+	reviewdto "treffly/api/dto/review"
+	userdto "treffly/api/dto/user"
+)
+
+type PublicProfileResponse struct {
+	ID                int32                    `json:"id"`
+	Username          string                   `json:"username"`
+	ImageURL          string                   `json:"image_url"`
+	CreatedAt         time.Time                `json:"created_at"`
+	Tags              []userdto.TagResponse    `json:"tags"`
+	HostedEventsCount int                      `json:"hosted_events_count"`
+	FollowersCount    int                      `json:"followers_count"`
+	FollowingCount    int                      `json:"following_count"`
+	IsFollowing       bool                     `json:"is_following"`
+	Rating            reviewdto.RatingResponse `json:"rating"`
+	UpcomingEvents    []eventdto.EventResponse `json:"upcoming_events"`
+	AttendedEvents    []eventdto.EventResponse `json:"attended_events,omitempty"`
+	AttendanceHidden  bool                     `json:"attendance_hidden"`
+}
+
+type PrivacyResponse struct {
+	HideAttendance bool `json:"hide_attendance"`
+}
@@ -0,0 +1,38 @@
+package promotiondto
+
+import (
+	"time"
+	"treffly/api/models"
+)
+
+func ToPromotionResponse(p models.Promotion) PromotionResponse {
+	return PromotionResponse{
+		ID:          p.ID,
+		EventID:     p.EventID,
+		EventName:   p.EventName,
+		RequestedBy: p.RequestedBy,
+		Status:      p.Status,
+		StartsAt:    p.StartsAt,
+		EndsAt:      p.EndsAt,
+		ReviewedBy:  p.ReviewedBy,
+		ReviewedAt:  optionalTime(p.ReviewedAt),
+		ActivatedAt: optionalTime(p.ActivatedAt),
+		FinishedAt:  optionalTime(p.FinishedAt),
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+func ToPromotionResponses(promotions []models.Promotion) []PromotionResponse {
+	result := make([]PromotionResponse, len(promotions))
+	for i, p := range promotions {
+		result[i] = ToPromotionResponse(p)
+	}
+	return result
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
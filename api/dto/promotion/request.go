@@ -0,0 +1,14 @@
+package promotiondto
+
+import "time"
+
+type CreatePromotionRequest struct {
+	StartsAt time.Time `json:"starts_at" binding:"required"`
+	EndsAt   time.Time `json:"ends_at" binding:"required"`
+}
+
+type ListPromotionsRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending approved rejected active finished expired"`
+	Limit  int32  `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int32  `form:"offset" binding:"omitempty,min=0"`
+}
@@ -0,0 +1,18 @@
+package promotiondto
+
+import "time"
+
+type PromotionResponse struct {
+	ID          int32      `json:"id"`
+	EventID     int32      `json:"event_id"`
+	EventName   string     `json:"event_name,omitempty"`
+	RequestedBy int32      `json:"requested_by"`
+	Status      string     `json:"status"`
+	StartsAt    time.Time  `json:"starts_at"`
+	EndsAt      time.Time  `json:"ends_at"`
+	ReviewedBy  int32      `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
@@ -0,0 +1,10 @@
+package reminderdto
+
+import "treffly/api/models"
+
+func ToPreferencesResponse(p models.ReminderPreferences) PreferencesResponse {
+	return PreferencesResponse{
+		EmailEnabled: p.EmailEnabled,
+		PushEnabled:  p.PushEnabled,
+	}
+}
@@ -0,0 +1,21 @@
+package reminderdto
+
+type UpdatePreferencesRequest struct {
+	EmailEnabled *bool `json:"email_enabled" binding:"required"`
+	PushEnabled  *bool `json:"push_enabled" binding:"required"`
+}
+
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh" binding:"required,max=256"`
+	Auth   string `json:"auth" binding:"required,max=64"`
+}
+
+// PushSubscriptionRequest mirrors PushSubscription.toJSON() in the browser.
+type PushSubscriptionRequest struct {
+	Endpoint string               `json:"endpoint" binding:"required,url,max=2048"`
+	Keys     PushSubscriptionKeys `json:"keys" binding:"required"`
+}
+
+type DeletePushSubscriptionRequest struct {
+	Endpoint string `json:"endpoint" binding:"required,url,max=2048"`
+}
@@ -0,0 +1,10 @@
+package reminderdto
+
+type PreferencesResponse struct {
+	EmailEnabled bool `json:"email_enabled"`
+	PushEnabled  bool `json:"push_enabled"`
+}
+
+type VAPIDPublicKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
@@ -0,0 +1,34 @@
+package reportdto
+
+import "treffly/api/models"
+
+func ToReportResponse(r models.Report) ReportResponse {
+	resp := ReportResponse{
+		ID:               r.ID,
+		ReporterID:       r.ReporterID,
+		ReporterUsername: r.ReporterUsername,
+		TargetType:       r.TargetType,
+		EventID:          r.EventID,
+		EventName:        r.EventName,
+		UserID:           r.UserID,
+		TargetUsername:   r.TargetUsername,
+		Reason:           r.Reason,
+		Comment:          r.Comment,
+		Status:           r.Status,
+		ResolvedBy:       r.ResolvedBy,
+		CreatedAt:        r.CreatedAt,
+		OpenReports:      r.OpenReports,
+	}
+	if !r.ResolvedAt.IsZero() {
+		resp.ResolvedAt = &r.ResolvedAt
+	}
+	return resp
+}
+
+func ToReportResponses(reports []models.Report) []ReportResponse {
+	result := make([]ReportResponse, len(reports))
+	for i, r := range reports {
+		result[i] = ToReportResponse(r)
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+package reportdto
+
+type CreateReportRequest struct {
+	Reason  string `json:"reason" binding:"required,oneof=spam fraud abuse inappropriate other"`
+	Comment string `json:"comment" binding:"max=1000"`
+}
+
+type ListReportsRequest struct {
+	Status     string `form:"status" binding:"omitempty,oneof=open resolved dismissed"`
+	TargetType string `form:"target_type" binding:"omitempty,oneof=event user"`
+	Limit      int32  `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset     int32  `form:"offset" binding:"omitempty,min=0"`
+}
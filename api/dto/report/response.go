@@ -0,0 +1,21 @@
+package reportdto
+
+import "time"
+
+type ReportResponse struct {
+	ID               int32      `json:"id"`
+	ReporterID       int32      `json:"reporter_id"`
+	ReporterUsername string     `json:"reporter_username,omitempty"`
+	TargetType       string     `json:"target_type"`
+	EventID          int32      `json:"event_id,omitempty"`
+	EventName        string     `json:"event_name,omitempty"`
+	UserID           int32      `json:"user_id,omitempty"`
+	TargetUsername   string     `json:"target_username,omitempty"`
+	Reason           string     `json:"reason"`
+	Comment          string     `json:"comment,omitempty"`
+	Status           string     `json:"status"`
+	ResolvedBy       int32      `json:"resolved_by,omitempty"`
+	ResolvedAt       *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	OpenReports      int64      `json:"open_reports,omitempty"`
+}
@@ -0,0 +1,51 @@
+package reviewdto
+
+import (
+	"treffly/api/common"
+	"treffly/api/models"
+)
+
+type ReviewConverter struct {
+	env    string
+	domain string
+}
+
+func NewReviewConverter(env, domain string) *ReviewConverter {
+	return &ReviewConverter{
+		env:    env,
+		domain: domain,
+	}
+}
+
+func (c *ReviewConverter) ToReviewResponse(r models.Review) ReviewResponse {
+	return ReviewResponse{
+		EventID:   r.EventID,
+		UserID:    r.UserID,
+		Username:  r.Username,
+		ImageURL:  common.ImageURL(c.env, c.domain, r.UserImagePath),
+		Rating:    r.Rating,
+		Body:      r.Body,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func (c *ReviewConverter) ToReviewsPageResponse(p models.ReviewsPage) ReviewsPageResponse {
+	reviews := make([]ReviewResponse, len(p.Reviews))
+	for i, r := range p.Reviews {
+		reviews[i] = c.ToReviewResponse(r)
+	}
+
+	return ReviewsPageResponse{
+		Rating:  ToRatingResponse(p.Rating),
+		Reviews: reviews,
+		HasMore: p.HasMore,
+	}
+}
+
+func ToRatingResponse(r models.Rating) RatingResponse {
+	return RatingResponse{
+		Average:      r.Average,
+		ReviewsCount: r.ReviewsCount,
+	}
+}
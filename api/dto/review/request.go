@@ -0,0 +1,11 @@
+package reviewdto
+
+type UpsertReviewRequest struct {
+	Rating int    `json:"rating" binding:"required,min=1,max=5"`
+	Body   string `json:"body" binding:"max=2000"`
+}
+
+type ListReviewsRequest struct {
+	Limit  int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int32 `form:"offset" binding:"omitempty,min=0"`
+}
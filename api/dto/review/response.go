@@ -0,0 +1,25 @@
+package reviewdto
+
+import "time"
+
+type ReviewResponse struct {
+	EventID   int32     `json:"event_id"`
+	UserID    int32     `json:"user_id,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	ImageURL  string    `json:"image_url,omitempty"`
+	Rating    int       `json:"rating"`
+	Body      string    `json:"body,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RatingResponse struct {
+	Average      float64 `json:"average"`
+	ReviewsCount int     `json:"reviews_count"`
+}
+
+type ReviewsPageResponse struct {
+	Rating  RatingResponse   `json:"rating"`
+	Reviews []ReviewResponse `json:"reviews"`
+	HasMore bool             `json:"has_more"`
+}
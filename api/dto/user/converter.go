@@ -22,14 +22,37 @@ func (c *UserConverter) ToUserResponse(user models.User) UserResponse {
 		Username: user.Username,
 		Email:    user.Email,
 		CreatedAt: user.CreatedAt,
+		EmailVerified: user.EmailVerified,
 	}
 }
 
+func (c *UserConverter) ToAdminUserResponse(user models.User) AdminUserResponse {
+	return AdminUserResponse{
+		ID:            user.ID,
+		Username:      user.Username,
+		Email:         user.Email,
+		Role:          user.Role,
+		IsBlocked:     user.IsBlocked,
+		EmailVerified: user.EmailVerified,
+		CreatedAt:     user.CreatedAt,
+	}
+}
+
+func (c *UserConverter) ToAdminUserResponses(users []models.User) []AdminUserResponse {
+	result := make([]AdminUserResponse, len(users))
+	for i, u := range users {
+		result[i] = c.ToAdminUserResponse(u)
+	}
+	return result
+}
+
 func (c *UserConverter) ToUserWithTagsResponse(user models.UserWithTags) UserWithTagsResponse {
 	return UserWithTagsResponse{
 		UserResponse: c.ToUserResponse(user.User),
 		Tags: c.convertTagsToResponse(user.Tags),
 		ImageURL: common.ImageURL(c.env, c.domain, user.ImagePath),
+		FollowersCount: user.FollowersCount,
+		FollowingCount: user.FollowingCount,
 	}
 }
 
@@ -18,4 +18,26 @@ type UpdateUserRequest struct {
 
 type UpdateCurrentUserTagsRequest struct {
 	TagIDs []int32 `json:"tag_ids" binding:"required,dive,gt=0"`
-}
\ No newline at end of file
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+type ListUsersRequest struct {
+	Limit  int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int32 `form:"offset" binding:"omitempty,min=0"`
+}
+
+type UpdateUserRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user organiser moderator admin"`
+}
@@ -5,15 +5,28 @@ import (
 )
 
 type UserResponse struct {
-	Username  string    `json:"username"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	CreatedAt     time.Time `json:"created_at"`
+	EmailVerified bool      `json:"email_verified"`
+}
+
+type AdminUserResponse struct {
+	ID            int32     `json:"id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role"`
+	IsBlocked     bool      `json:"is_blocked"`
+	EmailVerified bool      `json:"email_verified"`
+	CreatedAt     time.Time `json:"created_at"`
 }
 
 type UserWithTagsResponse struct {
 	UserResponse
-	Tags      []TagResponse `json:"tags"`
-	ImageURL  string        `json:"image_url"`
+	Tags           []TagResponse `json:"tags"`
+	ImageURL       string        `json:"image_url"`
+	FollowersCount int           `json:"followers_count"`
+	FollowingCount int           `json:"following_count"`
 }
 
 type TagResponse struct {
@@ -0,0 +1,65 @@
+package webhookdto
+
+import (
+	"time"
+	"treffly/api/models"
+)
+
+func ToWebhookResponse(w models.Webhook) WebhookResponse {
+	return WebhookResponse{
+		ID:                  w.ID,
+		URL:                 w.URL,
+		Secret:              w.Secret,
+		EventTypes:          w.EventTypes,
+		IsActive:            w.IsActive,
+		ConsecutiveFailures: w.ConsecutiveFailures,
+		DisabledAt:          optionalTime(w.DisabledAt),
+		CreatedAt:           w.CreatedAt,
+	}
+}
+
+func ToWebhookResponses(webhooks []models.Webhook) []WebhookResponse {
+	result := make([]WebhookResponse, len(webhooks))
+	for i, w := range webhooks {
+		result[i] = ToWebhookResponse(w)
+	}
+	return result
+}
+
+func ToDeliveryResponse(d models.WebhookDelivery) DeliveryResponse {
+	resp := DeliveryResponse{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    optionalTime(d.DeliveredAt),
+	}
+	if d.Status == models.WebhookDeliveryPending {
+		resp.NextAttemptAt = optionalTime(d.NextAttemptAt)
+	}
+	return resp
+}
+
+func ToDeliveriesPageResponse(p models.WebhookDeliveriesPage) DeliveriesPageResponse {
+	deliveries := make([]DeliveryResponse, len(p.Deliveries))
+	for i, d := range p.Deliveries {
+		deliveries[i] = ToDeliveryResponse(d)
+	}
+
+	return DeliveriesPageResponse{
+		Deliveries: deliveries,
+		HasMore:    p.HasMore,
+	}
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,17 @@
+package webhookdto
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required,http_url,max=2048"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,unique,dive,oneof=participant.joined participant.left event.updated event.deleted"`
+}
+
+type UpdateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required,http_url,max=2048"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,unique,dive,oneof=participant.joined participant.left event.updated event.deleted"`
+	IsActive   *bool    `json:"is_active" binding:"required"`
+}
+
+type ListDeliveriesRequest struct {
+	Limit  int32 `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int32 `form:"offset" binding:"omitempty,min=0"`
+}
@@ -0,0 +1,36 @@
+package webhookdto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type WebhookResponse struct {
+	ID                  int32      `json:"id"`
+	URL                 string     `json:"url"`
+	Secret              string     `json:"secret,omitempty"`
+	EventTypes          []string   `json:"event_types"`
+	IsActive            bool       `json:"is_active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+type DeliveryResponse struct {
+	ID             int64           `json:"id"`
+	WebhookID      int32           `json:"webhook_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	ResponseStatus int             `json:"response_status,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+}
+
+type DeliveriesPageResponse struct {
+	Deliveries []DeliveryResponse `json:"deliveries"`
+	HasMore    bool               `json:"has_more"`
+}
@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"treffly/api/common"
+	eventdto "treffly/api/dto/event"
+	userdto "treffly/api/dto/user"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultUsersPageSize = 20
+
+type adminService interface {
+	ListUsers(ctx context.Context, params models.ListUsersParams) ([]models.User, error)
+	SetUserBlocked(ctx context.Context, adminID, userID int32, blocked bool) (models.User, error)
+	UpdateUserRole(ctx context.Context, adminID, userID int32, role string) (models.User, error)
+	DeleteEvent(ctx context.Context, eventID int32) error
+	SetEventPremium(ctx context.Context, eventID int32, premium bool) error
+}
+
+type imageService interface {
+	GetDBImageByEventID(ctx context.Context, eventID int32) (uuid.UUID, string, error)
+	DeleteIfUnused(ctx context.Context, id uuid.UUID, path string) error
+}
+
+type Handler struct {
+	adminService adminService
+	imageService imageService
+	converter    *userdto.UserConverter
+}
+
+func NewAdminHandler(adminService adminService, imageService imageService, converter *userdto.UserConverter) *Handler {
+	return &Handler{
+		adminService: adminService,
+		imageService: imageService,
+		converter:    converter,
+	}
+}
+
+func (h *Handler) ListUsers(ctx *gin.Context) {
+	var req userdto.ListUsersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultUsersPageSize
+	}
+
+	users, err := h.adminService.ListUsers(ctx, models.ListUsersParams{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToAdminUserResponses(users))
+}
+
+func (h *Handler) BlockUser(ctx *gin.Context) {
+	h.setUserBlocked(ctx, true)
+}
+
+func (h *Handler) UnblockUser(ctx *gin.Context) {
+	h.setUserBlocked(ctx, false)
+}
+
+func (h *Handler) setUserBlocked(ctx *gin.Context, blocked bool) {
+	userID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	adminID := common.GetUserIDFromContextPayload(ctx)
+	user, err := h.adminService.SetUserBlocked(ctx, adminID, userID, blocked)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToAdminUserResponse(user))
+}
+
+func (h *Handler) UpdateUserRole(ctx *gin.Context) {
+	userID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req userdto.UpdateUserRoleRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	adminID := common.GetUserIDFromContextPayload(ctx)
+	user, err := h.adminService.UpdateUserRole(ctx, adminID, userID, req.Role)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToAdminUserResponse(user))
+}
+
+func (h *Handler) DeleteEvent(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	imageID, path, err := h.imageService.GetDBImageByEventID(ctx, eventID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	if err := h.adminService.DeleteEvent(ctx, eventID); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	if path != "" {
+		_ = h.imageService.DeleteIfUnused(ctx, imageID, path)
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) UpdateEventPremium(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req eventdto.UpdatePremiumRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if err := h.adminService.SetEventPremium(ctx, eventID, *req.IsPremium); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
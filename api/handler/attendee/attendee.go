@@ -0,0 +1,141 @@
+package attendee
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	attendeedto "treffly/api/dto/attendee"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const (
+	defaultAttendeesPageSize = 20
+	csvContentType           = "text/csv; charset=utf-8"
+)
+
+type attendeeService interface {
+	List(ctx context.Context, params models.ListAttendeesParams) (models.AttendeesPage, error)
+	Export(ctx context.Context, eventID, ownerID int32) ([]byte, error)
+	Remove(ctx context.Context, params models.RemoveAttendeeParams) error
+	Unban(ctx context.Context, eventID, ownerID, userID int32) error
+}
+
+type Handler struct {
+	attendeeService attendeeService
+	converter       *attendeedto.AttendeeConverter
+}
+
+func NewAttendeeHandler(attendeeService attendeeService, converter *attendeedto.AttendeeConverter) *Handler {
+	return &Handler{
+		attendeeService: attendeeService,
+		converter:       converter,
+	}
+}
+
+func (h *Handler) List(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req attendeedto.ListAttendeesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultAttendeesPageSize
+	}
+
+	page, err := h.attendeeService.List(ctx, models.ListAttendeesParams{
+		EventID: eventID,
+		OwnerID: common.GetUserIDFromContextPayload(ctx),
+		Cursor:  req.Cursor,
+		Limit:   req.Limit,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToAttendeesPageResponse(page))
+}
+
+func (h *Handler) Export(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	data, err := h.attendeeService.Export(ctx, eventID, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="event-%d-participants.csv"`, eventID))
+	ctx.Data(http.StatusOK, csvContentType, data)
+}
+
+func (h *Handler) Remove(ctx *gin.Context) {
+	h.remove(ctx, false)
+}
+
+func (h *Handler) Ban(ctx *gin.Context) {
+	h.remove(ctx, true)
+}
+
+func (h *Handler) remove(ctx *gin.Context, ban bool) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	userID, err := common.ParseIDParam(ctx, "user_id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.attendeeService.Remove(ctx, models.RemoveAttendeeParams{
+		EventID: eventID,
+		OwnerID: common.GetUserIDFromContextPayload(ctx),
+		UserID:  userID,
+		Ban:     ban,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) Unban(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	userID, err := common.ParseIDParam(ctx, "user_id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.attendeeService.Unban(ctx, eventID, common.GetUserIDFromContextPayload(ctx), userID)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
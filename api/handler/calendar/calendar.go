@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+	"treffly/util"
+)
+
+const contentType = "text/calendar; charset=utf-8"
+
+type eventGetter interface {
+	GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error)
+}
+
+type feedService interface {
+	Render(events []models.Event) []byte
+	CreateFeedToken(ctx context.Context, userID int32) (string, error)
+	RevokeFeedToken(ctx context.Context, userID int32) error
+	GetFeedEvents(ctx context.Context, token string) ([]models.Event, error)
+}
+
+type Handler struct {
+	events eventGetter
+	feeds  feedService
+	config util.Config
+}
+
+func NewCalendarHandler(events eventGetter, feeds feedService, config util.Config) *Handler {
+	return &Handler{
+		events: events,
+		feeds:  feeds,
+		config: config,
+	}
+}
+
+type feedResponse struct {
+	URL string `json:"url"`
+}
+
+func (h *Handler) Event(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	token := ctx.Query("invite")
+	userID := common.GetUserIDFromSoftAuth(ctx)
+
+	event, err := h.events.GetEvent(ctx, eventID, userID, token)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="event-%d.ics"`, event.ID))
+	ctx.Data(http.StatusOK, contentType, h.feeds.Render([]models.Event{event}))
+}
+
+func (h *Handler) CreateFeed(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+
+	token, err := h.feeds.CreateFeedToken(ctx, userID)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, feedResponse{
+		URL: common.CalendarFeedURL(h.config.Environment, h.config.Domain, token),
+	})
+}
+
+func (h *Handler) RevokeFeed(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+
+	if err := h.feeds.RevokeFeedToken(ctx, userID); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) Feed(ctx *gin.Context) {
+	token := strings.TrimSuffix(ctx.Param("token"), ".ics")
+
+	events, err := h.feeds.GetFeedEvents(ctx, token)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Header("Cache-Control", "private, max-age=900")
+	ctx.Data(http.StatusOK, contentType, h.feeds.Render(events))
+}
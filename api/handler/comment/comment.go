@@ -0,0 +1,159 @@
+package comment
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	commentdto "treffly/api/dto/comment"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultCommentsPageSize = 20
+
+type commentService interface {
+	List(ctx context.Context, params models.ListCommentsParams) (models.CommentsPage, error)
+	Create(ctx context.Context, params models.CreateCommentParams) (models.Comment, error)
+	Update(ctx context.Context, params models.UpdateCommentParams) (models.Comment, error)
+	Delete(ctx context.Context, commentID, userID int32) error
+	SetPinned(ctx context.Context, commentID, userID int32, pinned bool) (models.Comment, error)
+}
+
+type Handler struct {
+	commentService commentService
+	converter      *commentdto.CommentConverter
+}
+
+func NewCommentHandler(commentService commentService, converter *commentdto.CommentConverter) *Handler {
+	return &Handler{
+		commentService: commentService,
+		converter:      converter,
+	}
+}
+
+func (h *Handler) List(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req commentdto.ListCommentsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultCommentsPageSize
+	}
+
+	page, err := h.commentService.List(ctx, models.ListCommentsParams{
+		EventID: eventID,
+		UserID:  common.GetUserIDFromSoftAuth(ctx),
+		Token:   ctx.Query("invite"),
+		Cursor:  req.Cursor,
+		Limit:   req.Limit,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToCommentsPageResponse(page))
+}
+
+func (h *Handler) Create(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req commentdto.CreateCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	comment, err := h.commentService.Create(ctx, models.CreateCommentParams{
+		EventID:  eventID,
+		UserID:   common.GetUserIDFromContextPayload(ctx),
+		Token:    ctx.Query("invite"),
+		ParentID: req.ParentID,
+		Body:     req.Body,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, h.converter.ToCommentResponse(comment))
+}
+
+func (h *Handler) Update(ctx *gin.Context) {
+	commentID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req commentdto.UpdateCommentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	comment, err := h.commentService.Update(ctx, models.UpdateCommentParams{
+		CommentID: commentID,
+		UserID:    common.GetUserIDFromContextPayload(ctx),
+		Body:      req.Body,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToCommentResponse(comment))
+}
+
+func (h *Handler) Delete(ctx *gin.Context) {
+	commentID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.commentService.Delete(ctx, commentID, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) Pin(ctx *gin.Context) {
+	h.setPinned(ctx, true)
+}
+
+func (h *Handler) Unpin(ctx *gin.Context) {
+	h.setPinned(ctx, false)
+}
+
+func (h *Handler) setPinned(ctx *gin.Context, pinned bool) {
+	commentID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	comment, err := h.commentService.SetPinned(ctx, commentID, common.GetUserIDFromContextPayload(ctx), pinned)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToCommentResponse(comment))
+}
@@ -12,15 +12,29 @@ import (
 type IDParser struct{}
 
 func (p *IDParser) ParseEventID(ctx *gin.Context) (int32, error) {
-	idStr := ctx.Param("id")
-	id, err := strconv.Atoi(idStr)
-	return int32(id), err
+	return common.ParseIDParam(ctx, "id")
 }
 
 func (p *IDParser) GetUserID(c *gin.Context) int32 {
 	return common.GetUserIDFromContextPayload(c)
 }
 
+func parsePageParams(ctx *gin.Context) (string, int32, error) {
+	cursor := ctx.Query("cursor")
+
+	limitStr := ctx.Query("limit")
+	if limitStr == "" {
+		return cursor, 0, nil
+	}
+
+	limit, err := strconv.ParseInt(limitStr, 10, 32)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return cursor, int32(limit), nil
+}
+
 type BaseHandler struct {
 	idParser  *IDParser
 }
@@ -28,5 +42,6 @@ type BaseHandler struct {
 type ImageService interface {
 	Upload(file multipart.File, header *multipart.FileHeader, objType string, id string) (path string, err error)
 	Delete(path string) error
+	DeleteIfUnused(ctx context.Context, id uuid.UUID, path string) error
 	GetDBImageByEventID(ctx context.Context, eventID int32) (uuid.UUID, string, error)
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -16,9 +17,13 @@ import (
 	"treffly/apperror"
 )
 
+const maxMapZoom = 20
+
 type crudService interface {
 	Create(ctx context.Context, params models.CreateParams) (models.Event, error)
-	List(ctx context.Context, params models.ListParams) ([]models.Event, error)
+	List(ctx context.Context, params models.ListParams) (models.EventsPage, error)
+	ListMarkers(ctx context.Context, params models.BoundsParams) ([]models.EventMarker, error)
+	ListClusters(ctx context.Context, params models.ClusterParams) ([]models.EventCluster, error)
 	GetEvent(ctx context.Context, eventID int32, userID int32, token string) (models.Event, error)
 	Update(ctx context.Context, params models.UpdateParams) (models.Event, error)
 	Delete(ctx context.Context, params models.DeleteParams) error
@@ -76,6 +81,7 @@ func (h *CRUDHandler) Create(ctx *gin.Context) {
 		OwnerID:     userID,
 		ImageID:     imageID,
 		Path:        path,
+		Recurrence:  req.Recurrence,
 	}
 
 	createdEvent, err := h.crudService.Create(ctx, params)
@@ -105,27 +111,104 @@ func (h *CRUDHandler) List(ctx *gin.Context) {
 		return
 	}
 
+	radius, err := parseRadius(ctx.Query("radius"))
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	cursor, limit, err := parsePageParams(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
 	params := models.ListParams{
 		Lat:       lat,
 		Lon:       lon,
 		Search:    ctx.Query("keywords"),
 		TagIDs:    tagIDs,
 		DateRange: ctx.Query("dateWithin"),
+		Radius:    radius,
+		Cursor:    cursor,
+		Limit:     limit,
+	}
+
+	page, err := h.crudService.List(ctx, params)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	resp := h.converter.ToEventsPageResponse(page)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *CRUDHandler) ListMarkers(ctx *gin.Context) {
+	params, err := parseBounds(ctx.Query("bbox"))
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
 	}
 
-	events, err := h.crudService.List(ctx, params)
+	params.TagIDs, err = parseTagIDs(ctx.Query("tags"))
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+	params.DateRange = ctx.Query("dateWithin")
+
+	markers, err := h.crudService.ListMarkers(ctx, params)
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
 	}
 
-	resp := h.converter.ToEventsResponse(events)
+	resp := h.converter.ToEventMarkersResponse(markers)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *CRUDHandler) ListClusters(ctx *gin.Context) {
+	bounds, err := parseBounds(ctx.Query("bbox"))
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	bounds.TagIDs, err = parseTagIDs(ctx.Query("tags"))
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+	bounds.DateRange = ctx.Query("dateWithin")
+
+	zoom, err := strconv.Atoi(ctx.Query("zoom"))
+	if err != nil || zoom < 0 || zoom > maxMapZoom {
+		ctx.Error(apperror.BadRequest.WithCause(fmt.Errorf("invalid zoom: %s", ctx.Query("zoom"))))
+		return
+	}
+
+	params := models.ClusterParams{
+		Bounds: bounds,
+		Search: ctx.Query("keywords"),
+		Zoom:   zoom,
+	}
+
+	clusters, err := h.crudService.ListClusters(ctx, params)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	resp := h.converter.ToEventClustersResponse(clusters)
 
 	ctx.JSON(http.StatusOK, resp)
 }
 
 func (h *CRUDHandler) GetByID(ctx *gin.Context) {
-	eventID, err := strconv.Atoi(ctx.Param("id"))
+	eventID, err := common.ParseIDParam(ctx, "id")
 	if err != nil {
 		ctx.Error(apperror.BadRequest.WithCause(err))
 		return
@@ -135,7 +218,7 @@ func (h *CRUDHandler) GetByID(ctx *gin.Context) {
 
 	userID := common.GetUserIDFromSoftAuth(ctx)
 
-	Event, err := h.crudService.GetEvent(ctx, int32(eventID), userID, token)
+	Event, err := h.crudService.GetEvent(ctx, eventID, userID, token)
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
@@ -148,7 +231,7 @@ func (h *CRUDHandler) GetByID(ctx *gin.Context) {
 
 func (h *CRUDHandler) Update(ctx *gin.Context) {
 	userID := common.GetUserIDFromContextPayload(ctx)
-	eventID, err := strconv.Atoi(ctx.Param("id"))
+	eventID, err := common.ParseIDParam(ctx, "id")
 	if err != nil {
 		ctx.Error(apperror.BadRequest.WithCause(err))
 		return
@@ -165,7 +248,7 @@ func (h *CRUDHandler) Update(ctx *gin.Context) {
 		oldPath    string
 	)
 
-	oldImageID, oldPath, err = h.imageService.GetDBImageByEventID(ctx, int32(eventID))
+	oldImageID, oldPath, err = h.imageService.GetDBImageByEventID(ctx, eventID)
 	if err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			ctx.Error(apperror.WrapDBError(err))
@@ -194,7 +277,7 @@ func (h *CRUDHandler) Update(ctx *gin.Context) {
 	}
 
 	params := models.UpdateParams{
-		EventID:     int32(eventID),
+		EventID:     eventID,
 		Name:        req.Name,
 		Description: req.Description,
 		Capacity:    req.Capacity,
@@ -209,6 +292,7 @@ func (h *CRUDHandler) Update(ctx *gin.Context) {
 		NewImageID:  imageID,
 		DeleteImage: req.DeleteImage,
 		OldImageID:  oldImageID,
+		Following:   req.Scope == "following",
 	}
 
 	updatedEvent, err := h.crudService.Update(ctx, params)
@@ -218,7 +302,7 @@ func (h *CRUDHandler) Update(ctx *gin.Context) {
 	}
 
 	if req.DeleteImage && oldPath != "" {
-		err = h.imageService.Delete(oldPath) //TODO: make deletes transactional
+		err = h.imageService.DeleteIfUnused(ctx, oldImageID, oldPath) //TODO: make deletes transactional
 		if err != nil {
 			ctx.Error(apperror.WrapDBError(err))
 			return
@@ -226,7 +310,7 @@ func (h *CRUDHandler) Update(ctx *gin.Context) {
 	}
 
 	if oldPath != "" && file != nil {
-		_ = h.imageService.Delete(oldPath)
+		_ = h.imageService.DeleteIfUnused(ctx, oldImageID, oldPath)
 	}
 
 	resp := h.converter.ToEventResponse(updatedEvent)
@@ -236,28 +320,20 @@ func (h *CRUDHandler) Update(ctx *gin.Context) {
 
 func (h *CRUDHandler) Delete(ctx *gin.Context) {
 	userID := common.GetUserIDFromContextPayload(ctx)
-	eventID, err := strconv.Atoi(ctx.Param("id"))
+	eventID, err := common.ParseIDParam(ctx, "id")
 	if err != nil {
 		ctx.Error(apperror.BadRequest.WithCause(err))
 		return
 	}
 
-	_, path, err := h.imageService.GetDBImageByEventID(ctx, int32(eventID)) //TODO: make deletes transactional
-	if err != nil {
+	imageID, path, err := h.imageService.GetDBImageByEventID(ctx, eventID) //TODO: make deletes transactional
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		ctx.Error(apperror.WrapDBError(err))
 		return
 	}
 
-	if path != "" {
-		err = h.imageService.Delete(path)
-		if err != nil {
-			ctx.Error(apperror.WrapDBError(err))
-			return
-		}
-	}
-
 	err = h.crudService.Delete(ctx, models.DeleteParams{
-		EventID: int32(eventID),
+		EventID: eventID,
 		UserID:  userID,
 	})
 	if err != nil {
@@ -265,6 +341,10 @@ func (h *CRUDHandler) Delete(ctx *gin.Context) {
 		return
 	}
 
+	if path != "" {
+		_ = h.imageService.DeleteIfUnused(ctx, imageID, path)
+	}
+
 	ctx.Status(http.StatusNoContent)
 }
 
@@ -291,4 +371,47 @@ func parseTagIDs(tagsStr string) ([]int32, error) {
 	}
 
 	return result, nil
-}
\ No newline at end of file
+}
+
+func parseRadius(radiusStr string) (float64, error) {
+	if radiusStr == "" {
+		return 0, nil
+	}
+
+	radius, err := strconv.ParseFloat(radiusStr, 64)
+	if err != nil || math.IsNaN(radius) || radius <= 0 || math.IsInf(radius, 0) {
+		return 0, fmt.Errorf("invalid radius: %s", radiusStr)
+	}
+
+	return radius, nil
+}
+
+func parseBounds(bboxStr string) (models.BoundsParams, error) {
+	parts := strings.Split(bboxStr, ",")
+	if len(parts) != 4 {
+		return models.BoundsParams{}, fmt.Errorf("invalid bbox: %s", bboxStr)
+	}
+
+	coords := make([]float64, len(parts))
+	for i, part := range parts {
+		coord, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || math.IsNaN(coord) || math.IsInf(coord, 0) {
+			return models.BoundsParams{}, fmt.Errorf("invalid bbox: %s", bboxStr)
+		}
+		coords[i] = coord
+	}
+
+	params := models.BoundsParams{
+		MinLat: coords[0],
+		MinLon: coords[1],
+		MaxLat: coords[2],
+		MaxLon: coords[3],
+	}
+
+	if params.MinLat < -90 || params.MaxLat > 90 || params.MinLat >= params.MaxLat ||
+		params.MinLon < -180 || params.MaxLon > 180 || params.MinLon >= params.MaxLon {
+		return models.BoundsParams{}, fmt.Errorf("invalid bbox: %s", bboxStr)
+	}
+
+	return params, nil
+}
@@ -0,0 +1,89 @@
+package event
+
+import (
+	"testing"
+	"treffly/api/models"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRadius(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected float64
+		wantErr  bool
+	}{
+		{name: "Empty", input: "", expected: 0},
+		{name: "Integer", input: "5000", expected: 5000},
+		{name: "Fraction", input: "1500.5", expected: 1500.5},
+		{name: "Zero", input: "0", wantErr: true},
+		{name: "Negative", input: "-100", wantErr: true},
+		{name: "NotANumber", input: "far", wantErr: true},
+		{name: "NaN", input: "NaN", wantErr: true},
+		{name: "Infinity", input: "+Inf", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			radius, err := parseRadius(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, radius)
+		})
+	}
+}
+
+func TestParseBounds(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected models.BoundsParams
+		wantErr  bool
+	}{
+		{
+			name:     "Valid",
+			input:    "55.5,37.3,56,38",
+			expected: models.BoundsParams{MinLat: 55.5, MinLon: 37.3, MaxLat: 56, MaxLon: 38},
+		},
+		{
+			name:     "Spaces",
+			input:    " 55.5, 37.3 ,56 , 38",
+			expected: models.BoundsParams{MinLat: 55.5, MinLon: 37.3, MaxLat: 56, MaxLon: 38},
+		},
+		{
+			name:     "WholeWorld",
+			input:    "-90,-180,90,180",
+			expected: models.BoundsParams{MinLat: -90, MinLon: -180, MaxLat: 90, MaxLon: 180},
+		},
+		{name: "Empty", input: "", wantErr: true},
+		{name: "ThreeParts", input: "55,37,56", wantErr: true},
+		{name: "FiveParts", input: "55,37,56,38,1", wantErr: true},
+		{name: "NotANumber", input: "55,east,56,38", wantErr: true},
+		{name: "SwappedLatitudes", input: "56,37,55,38", wantErr: true},
+		{name: "SwappedLongitudes", input: "55,38,56,37", wantErr: true},
+		{name: "EmptyBox", input: "55,37,55,37", wantErr: true},
+		// Boxes crossing the antimeridian have MinLon > MaxLon; clients are
+		// expected to split them.
+		{name: "Antimeridian", input: "60,170,70,-170", wantErr: true},
+		{name: "LatitudeOutOfRange", input: "-91,37,56,38", wantErr: true},
+		{name: "LongitudeOutOfRange", input: "55,37,56,181", wantErr: true},
+		{name: "NaN", input: "NaN,37,56,38", wantErr: true},
+		{name: "Infinity", input: "55,-Inf,56,38", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds, err := parseBounds(tc.input)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, bounds)
+		})
+	}
+}
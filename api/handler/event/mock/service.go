@@ -43,6 +43,21 @@ func (m *MockqueryService) EXPECT() *MockqueryServiceMockRecorder {
 	return m.recorder
 }
 
+// GetFeed mocks base method.
+func (m *MockqueryService) GetFeed(ctx context.Context, params models.FeedParams) (models.EventsPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, params)
+	ret0, _ := ret[0].(models.EventsPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockqueryServiceMockRecorder) GetFeed(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockqueryService)(nil).GetFeed), ctx, params)
+}
+
 // GetHomeForGuest mocks base method.
 func (m *MockqueryService) GetHomeForGuest(ctx context.Context, params models.GetHomeParams) (models.HomeEvents, error) {
 	m.ctrl.T.Helper()
@@ -74,48 +89,48 @@ func (mr *MockqueryServiceMockRecorder) GetHomeForUser(ctx, params any) *gomock.
 }
 
 // GetOwnedUserEvents mocks base method.
-func (m *MockqueryService) GetOwnedUserEvents(ctx context.Context, userID int32) ([]models.Event, error) {
+func (m *MockqueryService) GetOwnedUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOwnedUserEvents", ctx, userID)
-	ret0, _ := ret[0].([]models.Event)
+	ret := m.ctrl.Call(m, "GetOwnedUserEvents", ctx, params)
+	ret0, _ := ret[0].(models.EventsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOwnedUserEvents indicates an expected call of GetOwnedUserEvents.
-func (mr *MockqueryServiceMockRecorder) GetOwnedUserEvents(ctx, userID any) *gomock.Call {
+func (mr *MockqueryServiceMockRecorder) GetOwnedUserEvents(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnedUserEvents", reflect.TypeOf((*MockqueryService)(nil).GetOwnedUserEvents), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnedUserEvents", reflect.TypeOf((*MockqueryService)(nil).GetOwnedUserEvents), ctx, params)
 }
 
 // GetPastUserEvents mocks base method.
-func (m *MockqueryService) GetPastUserEvents(ctx context.Context, userID int32) ([]models.Event, error) {
+func (m *MockqueryService) GetPastUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPastUserEvents", ctx, userID)
-	ret0, _ := ret[0].([]models.Event)
+	ret := m.ctrl.Call(m, "GetPastUserEvents", ctx, params)
+	ret0, _ := ret[0].(models.EventsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPastUserEvents indicates an expected call of GetPastUserEvents.
-func (mr *MockqueryServiceMockRecorder) GetPastUserEvents(ctx, userID any) *gomock.Call {
+func (mr *MockqueryServiceMockRecorder) GetPastUserEvents(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastUserEvents", reflect.TypeOf((*MockqueryService)(nil).GetPastUserEvents), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastUserEvents", reflect.TypeOf((*MockqueryService)(nil).GetPastUserEvents), ctx, params)
 }
 
 // GetUpcomingUserEvents mocks base method.
-func (m *MockqueryService) GetUpcomingUserEvents(ctx context.Context, userID int32) ([]models.Event, error) {
+func (m *MockqueryService) GetUpcomingUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUpcomingUserEvents", ctx, userID)
-	ret0, _ := ret[0].([]models.Event)
+	ret := m.ctrl.Call(m, "GetUpcomingUserEvents", ctx, params)
+	ret0, _ := ret[0].(models.EventsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUpcomingUserEvents indicates an expected call of GetUpcomingUserEvents.
-func (mr *MockqueryServiceMockRecorder) GetUpcomingUserEvents(ctx, userID any) *gomock.Call {
+func (mr *MockqueryServiceMockRecorder) GetUpcomingUserEvents(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingUserEvents", reflect.TypeOf((*MockqueryService)(nil).GetUpcomingUserEvents), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingUserEvents", reflect.TypeOf((*MockqueryService)(nil).GetUpcomingUserEvents), ctx, params)
 }
 
 // MocksubscriptionService is a mock of subscriptionService interface.
@@ -142,6 +157,36 @@ func (m *MocksubscriptionService) EXPECT() *MocksubscriptionServiceMockRecorder
 	return m.recorder
 }
 
+// JoinWaitlist mocks base method.
+func (m *MocksubscriptionService) JoinWaitlist(ctx context.Context, params models.SubscriptionParams) (models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx, params)
+	ret0, _ := ret[0].(models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MocksubscriptionServiceMockRecorder) JoinWaitlist(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MocksubscriptionService)(nil).JoinWaitlist), ctx, params)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MocksubscriptionService) LeaveWaitlist(ctx context.Context, params models.SubscriptionParams) (models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx, params)
+	ret0, _ := ret[0].(models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MocksubscriptionServiceMockRecorder) LeaveWaitlist(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MocksubscriptionService)(nil).LeaveWaitlist), ctx, params)
+}
+
 // Subscribe mocks base method.
 func (m *MocksubscriptionService) Subscribe(ctx context.Context, params models.SubscriptionParams) (models.Event, error) {
 	m.ctrl.T.Helper()
@@ -226,25 +271,25 @@ func (mr *MockcrudServiceMockRecorder) Delete(ctx, params any) *gomock.Call {
 }
 
 // GetEvent mocks base method.
-func (m *MockcrudService) GetEvent(ctx context.Context, eventID, userID int32) (models.Event, error) {
+func (m *MockcrudService) GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID, userID)
+	ret := m.ctrl.Call(m, "GetEvent", ctx, eventID, userID, token)
 	ret0, _ := ret[0].(models.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEvent indicates an expected call of GetEvent.
-func (mr *MockcrudServiceMockRecorder) GetEvent(ctx, eventID, userID any) *gomock.Call {
+func (mr *MockcrudServiceMockRecorder) GetEvent(ctx, eventID, userID, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockcrudService)(nil).GetEvent), ctx, eventID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockcrudService)(nil).GetEvent), ctx, eventID, userID, token)
 }
 
 // List mocks base method.
-func (m *MockcrudService) List(ctx context.Context, params models.ListParams) ([]models.Event, error) {
+func (m *MockcrudService) List(ctx context.Context, params models.ListParams) (models.EventsPage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", ctx, params)
-	ret0, _ := ret[0].([]models.Event)
+	ret0, _ := ret[0].(models.EventsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -255,6 +300,36 @@ func (mr *MockcrudServiceMockRecorder) List(ctx, params any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockcrudService)(nil).List), ctx, params)
 }
 
+// ListClusters mocks base method.
+func (m *MockcrudService) ListClusters(ctx context.Context, params models.ClusterParams) ([]models.EventCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusters", ctx, params)
+	ret0, _ := ret[0].([]models.EventCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockcrudServiceMockRecorder) ListClusters(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockcrudService)(nil).ListClusters), ctx, params)
+}
+
+// ListMarkers mocks base method.
+func (m *MockcrudService) ListMarkers(ctx context.Context, params models.BoundsParams) ([]models.EventMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMarkers", ctx, params)
+	ret0, _ := ret[0].([]models.EventMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMarkers indicates an expected call of ListMarkers.
+func (mr *MockcrudServiceMockRecorder) ListMarkers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMarkers", reflect.TypeOf((*MockcrudService)(nil).ListMarkers), ctx, params)
+}
+
 // Update mocks base method.
 func (m *MockcrudService) Update(ctx context.Context, params models.UpdateParams) (models.Event, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +383,20 @@ func (mr *MockImageServiceMockRecorder) Delete(path any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockImageService)(nil).Delete), path)
 }
 
+// DeleteIfUnused mocks base method.
+func (m *MockImageService) DeleteIfUnused(ctx context.Context, id uuid.UUID, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIfUnused", ctx, id, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIfUnused indicates an expected call of DeleteIfUnused.
+func (mr *MockImageServiceMockRecorder) DeleteIfUnused(ctx, id, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIfUnused", reflect.TypeOf((*MockImageService)(nil).DeleteIfUnused), ctx, id, path)
+}
+
 // GetDBImageByEventID mocks base method.
 func (m *MockImageService) GetDBImageByEventID(ctx context.Context, eventID int32) (uuid.UUID, string, error) {
 	m.ctrl.T.Helper()
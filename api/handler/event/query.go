@@ -13,9 +13,10 @@ import (
 type queryService interface {
 	GetHomeForUser(ctx context.Context, params models.GetHomeParams) (models.HomeEvents, error)
 	GetHomeForGuest(ctx context.Context, params models.GetHomeParams) (models.HomeEvents, error)
-	GetUpcomingUserEvents(ctx context.Context, userID int32) ([]models.Event, error)
-	GetPastUserEvents(ctx context.Context, userID int32) ([]models.Event, error)
-	GetOwnedUserEvents(ctx context.Context, userID int32) ([]models.Event, error)
+	GetUpcomingUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error)
+	GetPastUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error)
+	GetOwnedUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error)
+	GetFeed(ctx context.Context, params models.FeedParams) (models.EventsPage, error)
 }
 
 type QueryHandler struct {
@@ -69,16 +70,58 @@ func (h *QueryHandler) GetHome(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
+func (h *QueryHandler) GetFeed(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+
+	lat, lon, err := common.GetUserLocation(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	cursor, limit, err := parsePageParams(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	page, err := h.queryService.GetFeed(ctx, models.FeedParams{
+		UserID: userID,
+		Lat:    lat,
+		Lon:    lon,
+		Cursor: cursor,
+		Limit:  limit,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	resp := h.converter.ToEventsPageResponse(page)
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
 func (h *QueryHandler) GetUpcoming(ctx *gin.Context) {
 	userID := common.GetUserIDFromContextPayload(ctx)
 
-	events, err := h.queryService.GetUpcomingUserEvents(ctx, userID)
+	cursor, limit, err := parsePageParams(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	page, err := h.queryService.GetUpcomingUserEvents(ctx, models.UserEventsParams{
+		UserID: userID,
+		Cursor: cursor,
+		Limit:  limit,
+	})
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
 	}
 
-	resp := h.converter.ToEventsResponse(events)
+	resp := h.converter.ToEventsPageResponse(page)
 
 	ctx.JSON(http.StatusOK, resp)
 }
@@ -86,13 +129,23 @@ func (h *QueryHandler) GetUpcoming(ctx *gin.Context) {
 func (h *QueryHandler) GetPast(ctx *gin.Context) {
 	userID := common.GetUserIDFromContextPayload(ctx)
 
-	events, err := h.queryService.GetPastUserEvents(ctx, userID)
+	cursor, limit, err := parsePageParams(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	page, err := h.queryService.GetPastUserEvents(ctx, models.UserEventsParams{
+		UserID: userID,
+		Cursor: cursor,
+		Limit:  limit,
+	})
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
 	}
 
-	resp := h.converter.ToEventsResponse(events)
+	resp := h.converter.ToEventsPageResponse(page)
 
 	ctx.JSON(http.StatusOK, resp)
 }
@@ -100,13 +153,23 @@ func (h *QueryHandler) GetPast(ctx *gin.Context) {
 func (h *QueryHandler) GetOwned(ctx *gin.Context) {
 	userID := common.GetUserIDFromContextPayload(ctx)
 
-	events, err := h.queryService.GetOwnedUserEvents(ctx, userID)
+	cursor, limit, err := parsePageParams(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	page, err := h.queryService.GetOwnedUserEvents(ctx, models.UserEventsParams{
+		UserID: userID,
+		Cursor: cursor,
+		Limit:  limit,
+	})
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
 	}
 
-	resp := h.converter.ToEventsResponse(events)
+	resp := h.converter.ToEventsPageResponse(page)
 
 	ctx.JSON(http.StatusOK, resp)
 }
@@ -13,6 +13,8 @@ import (
 type subscriptionService interface {
 	Subscribe(ctx context.Context, params models.SubscriptionParams) (models.Event, error)
 	Unsubscribe(ctx context.Context, params models.SubscriptionParams) (models.Event, error)
+	JoinWaitlist(ctx context.Context, params models.SubscriptionParams) (models.Event, error)
+	LeaveWaitlist(ctx context.Context, params models.SubscriptionParams) (models.Event, error)
 }
 
 type SubscriptionHandler struct {
@@ -36,7 +38,23 @@ func (h *SubscriptionHandler) Unsubscribe(ctx *gin.Context) {
 	h.handleSubscription(ctx, false)
 }
 
+func (h *SubscriptionHandler) JoinWaitlist(ctx *gin.Context) {
+	h.handle(ctx, h.service.JoinWaitlist)
+}
+
+func (h *SubscriptionHandler) LeaveWaitlist(ctx *gin.Context) {
+	h.handle(ctx, h.service.LeaveWaitlist)
+}
+
 func (h *SubscriptionHandler) handleSubscription(ctx *gin.Context, subscribe bool) {
+	if subscribe {
+		h.handle(ctx, h.service.Subscribe)
+	} else {
+		h.handle(ctx, h.service.Unsubscribe)
+	}
+}
+
+func (h *SubscriptionHandler) handle(ctx *gin.Context, action func(context.Context, models.SubscriptionParams) (models.Event, error)) {
 	userID := common.GetUserIDFromContextPayload(ctx)
 	eventID, err := h.idParser.ParseEventID(ctx)
 	if err != nil {
@@ -52,17 +70,7 @@ func (h *SubscriptionHandler) handleSubscription(ctx *gin.Context, subscribe boo
 		Token:   token,
 	}
 
-	var Event models.Event
-	if subscribe {
-		Event, err = h.service.Subscribe(ctx, params)
-		if err != nil {
-			ctx.Error(apperror.BadRequest.WithCause(err))
-			return
-		}
-	} else {
-		Event, err = h.service.Unsubscribe(ctx, params)
-	}
-
+	Event, err := action(ctx, params)
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
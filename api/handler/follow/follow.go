@@ -0,0 +1,56 @@
+package follow
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	"treffly/apperror"
+)
+
+type followService interface {
+	Follow(ctx context.Context, followerID, followeeID int32) error
+	Unfollow(ctx context.Context, followerID, followeeID int32) error
+}
+
+type Handler struct {
+	followService followService
+}
+
+func NewFollowHandler(followService followService) *Handler {
+	return &Handler{
+		followService: followService,
+	}
+}
+
+func (h *Handler) Follow(ctx *gin.Context) {
+	followeeID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.followService.Follow(ctx, common.GetUserIDFromContextPayload(ctx), followeeID)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) Unfollow(ctx *gin.Context) {
+	followeeID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.followService.Unfollow(ctx, common.GetUserIDFromContextPayload(ctx), followeeID)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,91 @@
+package notification
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	notificationdto "treffly/api/dto/notification"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultNotificationsPageSize = 20
+
+type notificationService interface {
+	List(ctx context.Context, params models.ListNotificationsParams) (models.NotificationsPage, error)
+	UnreadCount(ctx context.Context, userID int32) (int, error)
+	MarkRead(ctx context.Context, notificationID, userID int32) error
+	MarkAllRead(ctx context.Context, userID int32) error
+}
+
+type Handler struct {
+	notificationService notificationService
+}
+
+func NewNotificationHandler(notificationService notificationService) *Handler {
+	return &Handler{
+		notificationService: notificationService,
+	}
+}
+
+func (h *Handler) List(ctx *gin.Context) {
+	var req notificationdto.ListNotificationsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultNotificationsPageSize
+	}
+
+	page, err := h.notificationService.List(ctx, models.ListNotificationsParams{
+		UserID:     common.GetUserIDFromContextPayload(ctx),
+		UnreadOnly: req.UnreadOnly,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notificationdto.ToNotificationsPageResponse(page))
+}
+
+func (h *Handler) UnreadCount(ctx *gin.Context) {
+	unread, err := h.notificationService.UnreadCount(ctx, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, notificationdto.UnreadCountResponse{UnreadCount: unread})
+}
+
+func (h *Handler) MarkRead(ctx *gin.Context) {
+	id, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.notificationService.MarkRead(ctx, id, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) MarkAllRead(ctx *gin.Context) {
+	err := h.notificationService.MarkAllRead(ctx, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+package profile
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	profiledto "treffly/api/dto/profile"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+type profileService interface {
+	Get(ctx context.Context, userID, viewerID int32) (models.PublicProfile, error)
+	GetPrivacy(ctx context.Context, userID int32) (models.PrivacySettings, error)
+	UpdatePrivacy(ctx context.Context, params models.PrivacySettings) (models.PrivacySettings, error)
+}
+
+type Handler struct {
+	profileService profileService
+	converter      *profiledto.ProfileConverter
+}
+
+func NewProfileHandler(profileService profileService, converter *profiledto.ProfileConverter) *Handler {
+	return &Handler{
+		profileService: profileService,
+		converter:      converter,
+	}
+}
+
+func (h *Handler) Get(ctx *gin.Context) {
+	userID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	profile, err := h.profileService.Get(ctx, userID, common.GetUserIDFromSoftAuth(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToPublicProfileResponse(profile))
+}
+
+func (h *Handler) GetPrivacy(ctx *gin.Context) {
+	settings, err := h.profileService.GetPrivacy(ctx, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, profiledto.ToPrivacyResponse(settings))
+}
+
+func (h *Handler) UpdatePrivacy(ctx *gin.Context) {
+	var req profiledto.UpdatePrivacyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	settings, err := h.profileService.UpdatePrivacy(ctx, models.PrivacySettings{
+		UserID:         common.GetUserIDFromContextPayload(ctx),
+		HideAttendance: *req.HideAttendance,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, profiledto.ToPrivacyResponse(settings))
+}
@@ -0,0 +1,122 @@
+package promotion
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	promotiondto "treffly/api/dto/promotion"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultPromotionsPageSize = 20
+
+type promotionService interface {
+	Request(ctx context.Context, params models.CreatePromotionParams) (models.Promotion, error)
+	ListForEvent(ctx context.Context, eventID, userID int32) ([]models.Promotion, error)
+	List(ctx context.Context, params models.ListPromotionsParams) ([]models.Promotion, error)
+	Approve(ctx context.Context, promotionID, adminID int32) (models.Promotion, error)
+	Reject(ctx context.Context, promotionID, adminID int32) (models.Promotion, error)
+}
+
+type Handler struct {
+	promotionService promotionService
+}
+
+func NewPromotionHandler(promotionService promotionService) *Handler {
+	return &Handler{
+		promotionService: promotionService,
+	}
+}
+
+func (h *Handler) Request(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req promotiondto.CreatePromotionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	promotion, err := h.promotionService.Request(ctx, models.CreatePromotionParams{
+		EventID:  eventID,
+		UserID:   common.GetUserIDFromContextPayload(ctx),
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, promotiondto.ToPromotionResponse(promotion))
+}
+
+func (h *Handler) ListForEvent(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	promotions, err := h.promotionService.ListForEvent(ctx, eventID, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, promotiondto.ToPromotionResponses(promotions))
+}
+
+func (h *Handler) List(ctx *gin.Context) {
+	var req promotiondto.ListPromotionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultPromotionsPageSize
+	}
+
+	promotions, err := h.promotionService.List(ctx, models.ListPromotionsParams{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, promotiondto.ToPromotionResponses(promotions))
+}
+
+func (h *Handler) Approve(ctx *gin.Context) {
+	h.review(ctx, h.promotionService.Approve)
+}
+
+func (h *Handler) Reject(ctx *gin.Context) {
+	h.review(ctx, h.promotionService.Reject)
+}
+
+func (h *Handler) review(ctx *gin.Context, review func(ctx context.Context, promotionID, adminID int32) (models.Promotion, error)) {
+	promotionID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	promotion, err := review(ctx, promotionID, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, promotiondto.ToPromotionResponse(promotion))
+}
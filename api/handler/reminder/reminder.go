@@ -0,0 +1,106 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	reminderdto "treffly/api/dto/reminder"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+type reminderService interface {
+	GetPreferences(ctx context.Context, userID int32) (models.ReminderPreferences, error)
+	UpdatePreferences(ctx context.Context, userID int32, prefs models.ReminderPreferences) (models.ReminderPreferences, error)
+	SavePushSubscription(ctx context.Context, params models.PushSubscriptionParams) error
+	DeletePushSubscription(ctx context.Context, userID int32, endpoint string) error
+}
+
+type Handler struct {
+	reminderService reminderService
+	vapidPublicKey  string
+}
+
+func NewReminderHandler(reminderService reminderService, vapidPublicKey string) *Handler {
+	return &Handler{
+		reminderService: reminderService,
+		vapidPublicKey:  vapidPublicKey,
+	}
+}
+
+func (h *Handler) GetPreferences(ctx *gin.Context) {
+	prefs, err := h.reminderService.GetPreferences(ctx, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reminderdto.ToPreferencesResponse(prefs))
+}
+
+func (h *Handler) UpdatePreferences(ctx *gin.Context) {
+	var req reminderdto.UpdatePreferencesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	prefs, err := h.reminderService.UpdatePreferences(ctx, common.GetUserIDFromContextPayload(ctx), models.ReminderPreferences{
+		EmailEnabled: *req.EmailEnabled,
+		PushEnabled:  *req.PushEnabled,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reminderdto.ToPreferencesResponse(prefs))
+}
+
+func (h *Handler) VAPIDPublicKey(ctx *gin.Context) {
+	if h.vapidPublicKey == "" {
+		ctx.Error(apperror.NotFound.WithCause(errors.New("web push is not configured")))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reminderdto.VAPIDPublicKeyResponse{PublicKey: h.vapidPublicKey})
+}
+
+func (h *Handler) SubscribePush(ctx *gin.Context) {
+	var req reminderdto.PushSubscriptionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err := h.reminderService.SavePushSubscription(ctx, models.PushSubscriptionParams{
+		UserID:   common.GetUserIDFromContextPayload(ctx),
+		Endpoint: req.Endpoint,
+		P256dh:   req.Keys.P256dh,
+		Auth:     req.Keys.Auth,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) UnsubscribePush(ctx *gin.Context) {
+	var req reminderdto.DeletePushSubscriptionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err := h.reminderService.DeletePushSubscription(ctx, common.GetUserIDFromContextPayload(ctx), req.Endpoint)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
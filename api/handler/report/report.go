@@ -0,0 +1,120 @@
+package report
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	reportdto "treffly/api/dto/report"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultReportsPageSize = 20
+
+type reportService interface {
+	Create(ctx context.Context, params models.CreateReportParams) (models.Report, error)
+	List(ctx context.Context, params models.ListReportsParams) ([]models.Report, error)
+	Resolve(ctx context.Context, reportID, moderatorID int32) ([]models.Report, error)
+	Dismiss(ctx context.Context, reportID, moderatorID int32) ([]models.Report, error)
+}
+
+type Handler struct {
+	reportService reportService
+}
+
+func NewReportHandler(reportService reportService) *Handler {
+	return &Handler{
+		reportService: reportService,
+	}
+}
+
+func (h *Handler) ReportEvent(ctx *gin.Context) {
+	h.create(ctx, models.ReportTargetEvent)
+}
+
+func (h *Handler) ReportUser(ctx *gin.Context) {
+	h.create(ctx, models.ReportTargetUser)
+}
+
+// List returns the moderation queue; only open reports are shown unless
+// another status is requested.
+func (h *Handler) List(ctx *gin.Context) {
+	var req reportdto.ListReportsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Status == "" {
+		req.Status = models.ReportOpen
+	}
+	if req.Limit == 0 {
+		req.Limit = defaultReportsPageSize
+	}
+
+	reports, err := h.reportService.List(ctx, models.ListReportsParams{
+		Status:     req.Status,
+		TargetType: req.TargetType,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reportdto.ToReportResponses(reports))
+}
+
+func (h *Handler) Resolve(ctx *gin.Context) {
+	h.review(ctx, h.reportService.Resolve)
+}
+
+func (h *Handler) Dismiss(ctx *gin.Context) {
+	h.review(ctx, h.reportService.Dismiss)
+}
+
+func (h *Handler) create(ctx *gin.Context, targetType string) {
+	targetID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req reportdto.CreateReportRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	report, err := h.reportService.Create(ctx, models.CreateReportParams{
+		ReporterID: common.GetUserIDFromContextPayload(ctx),
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     req.Reason,
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, reportdto.ToReportResponse(report))
+}
+
+func (h *Handler) review(ctx *gin.Context, review func(ctx context.Context, reportID, moderatorID int32) ([]models.Report, error)) {
+	reportID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	reports, err := review(ctx, reportID, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reportdto.ToReportResponses(reports))
+}
@@ -0,0 +1,123 @@
+package review
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"treffly/api/common"
+	reviewdto "treffly/api/dto/review"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultReviewsPageSize = 20
+
+type reviewService interface {
+	List(ctx context.Context, params models.ListReviewsParams) (models.ReviewsPage, error)
+	Upsert(ctx context.Context, params models.UpsertReviewParams) (models.Review, error)
+	Delete(ctx context.Context, eventID, userID int32) error
+	GetOrganizerRating(ctx context.Context, userID int32) (models.Rating, error)
+}
+
+type Handler struct {
+	reviewService reviewService
+	converter     *reviewdto.ReviewConverter
+}
+
+func NewReviewHandler(reviewService reviewService, converter *reviewdto.ReviewConverter) *Handler {
+	return &Handler{
+		reviewService: reviewService,
+		converter:     converter,
+	}
+}
+
+func (h *Handler) List(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req reviewdto.ListReviewsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultReviewsPageSize
+	}
+
+	page, err := h.reviewService.List(ctx, models.ListReviewsParams{
+		EventID: eventID,
+		UserID:  common.GetUserIDFromSoftAuth(ctx),
+		Token:   ctx.Query("invite"),
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToReviewsPageResponse(page))
+}
+
+func (h *Handler) Upsert(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req reviewdto.UpsertReviewRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	review, err := h.reviewService.Upsert(ctx, models.UpsertReviewParams{
+		EventID: eventID,
+		UserID:  common.GetUserIDFromContextPayload(ctx),
+		Rating:  req.Rating,
+		Body:    req.Body,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.converter.ToReviewResponse(review))
+}
+
+func (h *Handler) Delete(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.reviewService.Delete(ctx, eventID, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) OrganizerRating(ctx *gin.Context) {
+	userID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	rating, err := h.reviewService.GetOrganizerRating(ctx, userID)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reviewdto.ToRatingResponse(rating))
+}
@@ -0,0 +1,76 @@
+package search
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+	"treffly/util"
+)
+
+type suggester interface {
+	Suggest(ctx context.Context, params models.SuggestParams) ([]models.Suggestion, error)
+}
+
+type Handler struct {
+	suggester suggester
+	config    util.Config
+}
+
+func NewSearchHandler(suggester suggester, config util.Config) *Handler {
+	return &Handler{
+		suggester: suggester,
+		config:    config,
+	}
+}
+
+type suggestionResponse struct {
+	Type     string     `json:"type"`
+	ID       int32      `json:"id"`
+	Text     string     `json:"text"`
+	Date     *time.Time `json:"date,omitempty"`
+	ImageURL string     `json:"image_url,omitempty"`
+}
+
+type suggestResponse struct {
+	Suggestions []suggestionResponse `json:"suggestions"`
+}
+
+func (h *Handler) newSuggestResponse(suggestions []models.Suggestion) suggestResponse {
+	result := make([]suggestionResponse, len(suggestions))
+	for i, s := range suggestions {
+		result[i] = suggestionResponse{
+			Type:     s.Type,
+			ID:       s.ID,
+			Text:     s.Text,
+			ImageURL: common.ImageURL(h.config.Environment, h.config.Domain, s.ImagePath),
+		}
+		if !s.Date.IsZero() {
+			result[i].Date = &s.Date
+		}
+	}
+	return suggestResponse{Suggestions: result}
+}
+
+func (h *Handler) Suggest(ctx *gin.Context) {
+	lat, lon, err := common.GetUserLocation(ctx)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	suggestions, err := h.suggester.Suggest(ctx, models.SuggestParams{
+		Query: ctx.Query("q"),
+		Lat:   lat,
+		Lon:   lon,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, h.newSuggestResponse(suggestions))
+}
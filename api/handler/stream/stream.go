@@ -0,0 +1,121 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"regexp"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+// retryMillis is how long browsers wait before reconnecting a dropped stream.
+const retryMillis = 3000
+
+var streamIDPattern = regexp.MustCompile(`^\d+-\d+$`)
+
+type eventGetter interface {
+	GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error)
+}
+
+type streamService interface {
+	Open(ctx context.Context, eventID int32) (models.EventStreamMessage, error)
+	Read(ctx context.Context, eventID int32, after string, block time.Duration) ([]models.EventStreamMessage, error)
+}
+
+type Handler struct {
+	events    eventGetter
+	streams   streamService
+	heartbeat time.Duration
+	slots     chan struct{}
+}
+
+// NewStreamHandler limits open streams to maxConnections: each one holds a
+// Redis connection in a blocking read.
+func NewStreamHandler(events eventGetter, streams streamService, heartbeat time.Duration, maxConnections int) *Handler {
+	return &Handler{
+		events:    events,
+		streams:   streams,
+		heartbeat: heartbeat,
+		slots:     make(chan struct{}, maxConnections),
+	}
+}
+
+// Stream sends live updates of an event as Server-Sent Events until the event
+// is cancelled or the client goes away.
+func (h *Handler) Stream(ctx *gin.Context) {
+	eventID, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	event, err := h.events.GetEvent(ctx, eventID, common.GetUserIDFromSoftAuth(ctx), ctx.Query("invite"))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	select {
+	case h.slots <- struct{}{}:
+		defer func() { <-h.slots }()
+	default:
+		ctx.Error(apperror.ServiceUnavailable.WithCause(errors.New("too many open streams")))
+		return
+	}
+
+	reqCtx := ctx.Request.Context()
+
+	after := ctx.GetHeader("Last-Event-ID")
+	var pending []models.EventStreamMessage
+	if !streamIDPattern.MatchString(after) {
+		snapshot, err := h.streams.Open(reqCtx, event.ID)
+		if err != nil {
+			ctx.Error(apperror.InternalServer.WithCause(err))
+			return
+		}
+		after = snapshot.ID
+		pending = append(pending, snapshot)
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	fmt.Fprintf(ctx.Writer, "retry: %d\n\n", retryMillis)
+
+	for {
+		for _, msg := range pending {
+			writeMessage(ctx.Writer, msg)
+			after = msg.ID
+			if msg.Type == models.EventStreamCancelled {
+				ctx.Writer.Flush()
+				return
+			}
+		}
+		if len(pending) == 0 {
+			fmt.Fprint(ctx.Writer, ": heartbeat\n\n")
+		}
+		ctx.Writer.Flush()
+
+		pending, err = h.streams.Read(reqCtx, event.ID, after, h.heartbeat)
+		if err != nil {
+			// Either the client left or Redis failed; in the latter case the
+			// browser reconnects with the last id it saw.
+			return
+		}
+	}
+}
+
+func writeMessage(w gin.ResponseWriter, msg models.EventStreamMessage) {
+	if msg.ID != "" {
+		fmt.Fprintf(w, "id: %s\n", msg.ID)
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", msg.Type, msg.Data)
+}
@@ -0,0 +1,94 @@
+package token
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+type sessionManager interface {
+	ListSessions(ctx context.Context, userID int32, currentID uuid.UUID) ([]models.Session, error)
+	RevokeSession(ctx context.Context, userID int32, sessionID uuid.UUID) error
+	RevokeOtherSessions(ctx context.Context, userID int32, currentID uuid.UUID) error
+}
+
+type SessionHandler struct {
+	sessionManager sessionManager
+}
+
+func NewSessionHandler(sessionManager sessionManager) *SessionHandler {
+	return &SessionHandler{
+		sessionManager: sessionManager,
+	}
+}
+
+type sessionResponse struct {
+	ID         uuid.UUID `json:"uuid"`
+	UserAgent  string    `json:"user_agent"`
+	ClientIP   string    `json:"client_ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	IsCurrent  bool      `json:"is_current"`
+}
+
+func newSessionsResponse(sessions []models.Session) []sessionResponse {
+	result := make([]sessionResponse, len(sessions))
+	for i, s := range sessions {
+		result[i] = sessionResponse{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			ClientIP:   s.ClientIP,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			IsCurrent:  s.IsCurrent,
+		}
+	}
+	return result
+}
+
+func (h *SessionHandler) List(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+	sessionID := common.GetSessionIDFromContextPayload(ctx)
+
+	sessions, err := h.sessionManager.ListSessions(ctx, userID, sessionID)
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionsResponse(sessions))
+}
+
+func (h *SessionHandler) Revoke(ctx *gin.Context) {
+	sessionID, err := uuid.Parse(ctx.Param("uuid"))
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	userID := common.GetUserIDFromContextPayload(ctx)
+
+	if err := h.sessionManager.RevokeSession(ctx, userID, sessionID); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *SessionHandler) RevokeOthers(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+	sessionID := common.GetSessionIDFromContextPayload(ctx)
+
+	if err := h.sessionManager.RevokeOtherSessions(ctx, userID, sessionID); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -8,12 +8,13 @@ import (
 	"net/http"
 	"strconv"
 	"treffly/api/common"
+	"treffly/api/models"
 	"treffly/apperror"
 	"treffly/util"
 )
 
 type tokenManager interface {
-	RefreshTokens(ctx context.Context, reqRefreshToken string) (accessToken string, refreshToken string, err error)
+	RefreshTokens(ctx context.Context, reqRefreshToken string, meta models.SessionMeta) (accessToken string, refreshToken string, err error)
 	ValidateSession(ctx context.Context, refreshToken string) error
 	CreatePrivateEventToken(ctx context.Context, eventID int32, userID int32) (string, error)
 }
@@ -41,7 +42,7 @@ func (h *Handler) RefreshTokens(ctx *gin.Context) {
 		return
 	}
 
-	accessToken, refreshToken, err := h.tokenManager.RefreshTokens(ctx, reqRefreshToken)
+	accessToken, refreshToken, err := h.tokenManager.RefreshTokens(ctx, reqRefreshToken, common.GetSessionMeta(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ctx.Error(apperror.NotFound.WithCause(err))
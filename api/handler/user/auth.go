@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"net/http"
 	"treffly/api/common"
 	userdto "treffly/api/dto/user"
@@ -18,23 +19,33 @@ type creator interface {
 }
 
 type authService interface {
-	LoginUser(ctx context.Context, email, password string) (models.User, string, string, error)
-	CreateAuthSession(ctx context.Context, userID int32) (string, string, error)
+	LoginUser(ctx context.Context, email, password string, meta models.SessionMeta) (models.User, string, string, error)
+	CreateAuthSession(ctx context.Context, userID int32, role string, meta models.SessionMeta) (string, string, error)
+	Logout(ctx context.Context, userID int32, sessionID uuid.UUID) error
+}
+
+type accountService interface {
+	SendVerificationEmail(ctx context.Context, userID int32) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
 }
 
 type AuthHandler struct {
-	authService authService
-	creator     creator
-	converter   *userdto.UserConverter
-	config      util.Config
+	authService    authService
+	creator        creator
+	accountService accountService
+	converter      *userdto.UserConverter
+	config         util.Config
 }
 
-func NewAuthHandler(authService authService, creator creator, converter *userdto.UserConverter, config util.Config) *AuthHandler {
+func NewAuthHandler(authService authService, creator creator, accountService accountService, converter *userdto.UserConverter, config util.Config) *AuthHandler {
 	return &AuthHandler{
-		authService: authService,
-		creator:     creator,
-		converter:   converter,
-		config:      config,
+		authService:    authService,
+		creator:        creator,
+		accountService: accountService,
+		converter:      converter,
+		config:         config,
 	}
 }
 
@@ -55,7 +66,7 @@ func (h *AuthHandler) Create(ctx *gin.Context) {
 		return
 	}
 
-	accessToken, refreshToken, err := h.authService.CreateAuthSession(ctx, user.ID)
+	accessToken, refreshToken, err := h.authService.CreateAuthSession(ctx, user.ID, user.Role, common.GetSessionMeta(ctx))
 	if err != nil {
 		ctx.Error(apperror.WrapDBError(err))
 		return
@@ -75,7 +86,7 @@ func (h *AuthHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	user, accessToken, refreshToken, err := h.authService.LoginUser(ctx, req.Email, req.Password)
+	user, accessToken, refreshToken, err := h.authService.LoginUser(ctx, req.Email, req.Password, common.GetSessionMeta(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			ctx.Error(apperror.InvalidCredentials.WithCause(err))
@@ -100,6 +111,14 @@ func (h *AuthHandler) setAuthCookies(ctx *gin.Context, accessToken, refreshToken
 }
 
 func (h *AuthHandler) Logout(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+	sessionID := common.GetSessionIDFromContextPayload(ctx)
+
+	if err := h.authService.Logout(ctx, userID, sessionID); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
 	isSecure := false
 	path := "" //TODO: define path vars on server init
 	if h.config.Environment == "production" {
@@ -110,7 +129,62 @@ func (h *AuthHandler) Logout(ctx *gin.Context) {
 		common.CookieDomain, isSecure, true)
 	ctx.SetCookie("refresh_token", "", -1, path+common.RefreshTokenCookiePath,
 		common.CookieDomain, isSecure, true)
-	//TODO: block session
 	ctx.JSON(http.StatusNoContent, gin.H{})
 	ctx.Status(http.StatusNoContent)
 }
+
+func (h *AuthHandler) SendVerification(ctx *gin.Context) {
+	userID := common.GetUserIDFromContextPayload(ctx)
+
+	if err := h.accountService.SendVerificationEmail(ctx, userID); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *AuthHandler) VerifyEmail(ctx *gin.Context) {
+	var req userdto.VerifyEmailRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if err := h.accountService.VerifyEmail(ctx, req.Token); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ForgotPassword(ctx *gin.Context) {
+	var req userdto.ForgotPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if err := h.accountService.RequestPasswordReset(ctx, req.Email); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ResetPassword(ctx *gin.Context) {
+	var req userdto.ResetPasswordRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if err := h.accountService.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"treffly/api/common"
+	webhookdto "treffly/api/dto/webhook"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+const defaultDeliveriesPageSize = 20
+
+type webhookService interface {
+	Create(ctx context.Context, params models.CreateWebhookParams) (models.Webhook, error)
+	List(ctx context.Context, ownerID int32) ([]models.Webhook, error)
+	Update(ctx context.Context, params models.UpdateWebhookParams) (models.Webhook, error)
+	Delete(ctx context.Context, id, ownerID int32) error
+	ListDeliveries(ctx context.Context, params models.ListWebhookDeliveriesParams) (models.WebhookDeliveriesPage, error)
+	Replay(ctx context.Context, deliveryID int64, webhookID, ownerID int32) (models.WebhookDelivery, error)
+}
+
+type Handler struct {
+	webhookService webhookService
+}
+
+func NewWebhookHandler(webhookService webhookService) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *Handler) Create(ctx *gin.Context) {
+	var req webhookdto.CreateWebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	webhook, err := h.webhookService.Create(ctx, models.CreateWebhookParams{
+		OwnerID:    common.GetUserIDFromContextPayload(ctx),
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, webhookdto.ToWebhookResponse(webhook))
+}
+
+func (h *Handler) List(ctx *gin.Context) {
+	webhooks, err := h.webhookService.List(ctx, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhookdto.ToWebhookResponses(webhooks))
+}
+
+func (h *Handler) Update(ctx *gin.Context) {
+	id, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req webhookdto.UpdateWebhookRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	webhook, err := h.webhookService.Update(ctx, models.UpdateWebhookParams{
+		ID:         id,
+		OwnerID:    common.GetUserIDFromContextPayload(ctx),
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		IsActive:   *req.IsActive,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhookdto.ToWebhookResponse(webhook))
+}
+
+func (h *Handler) Delete(ctx *gin.Context) {
+	id, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	err = h.webhookService.Delete(ctx, id, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *Handler) ListDeliveries(ctx *gin.Context) {
+	id, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	var req webhookdto.ListDeliveriesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = defaultDeliveriesPageSize
+	}
+
+	page, err := h.webhookService.ListDeliveries(ctx, models.ListWebhookDeliveriesParams{
+		WebhookID: id,
+		OwnerID:   common.GetUserIDFromContextPayload(ctx),
+		Limit:     req.Limit,
+		Offset:    req.Offset,
+	})
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, webhookdto.ToDeliveriesPageResponse(page))
+}
+
+func (h *Handler) Replay(ctx *gin.Context) {
+	id, err := common.ParseIDParam(ctx, "id")
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	deliveryID, err := strconv.ParseInt(ctx.Param("delivery_id"), 10, 64)
+	if err != nil {
+		ctx.Error(apperror.BadRequest.WithCause(err))
+		return
+	}
+
+	delivery, err := h.webhookService.Replay(ctx, deliveryID, id, common.GetUserIDFromContextPayload(ctx))
+	if err != nil {
+		ctx.Error(apperror.WrapDBError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusAccepted, webhookdto.ToDeliveryResponse(delivery))
+}
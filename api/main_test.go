@@ -7,19 +7,39 @@ import (
 	"testing"
 	"time"
 	db "treffly/db/sqlc"
+	"treffly/mail"
+	"treffly/token"
 	"treffly/util"
 )
 
-func newTestServer(t *testing.T, store db.Store) *Server {
+// newTestServer wires the router without the external clients NewServer
+// connects to. A random token maker is used when tokenMaker is nil.
+func newTestServer(t *testing.T, store db.Store, tokenMaker token.Maker) *Server {
 	config := util.Config{
 		TokenSymmetricKey: util.RandomString(32),
 		AccessTokenDuration: time.Minute,
 		RefreshTokenDuration: time.Hour * 24 * 7,
 	}
 
-	server, err := NewServer(config, store)
+	if tokenMaker == nil {
+		var err error
+		tokenMaker, err = token.NewPasetoMaker(config.TokenSymmetricKey)
+		require.NoError(t, err)
+	}
+
+	mailer, err := mail.NewFileMailer(t.TempDir(), "test@treffly.ru")
 	require.NoError(t, err)
 
+	server := &Server{
+		config:     config,
+		store:      store,
+		tokenMaker: tokenMaker,
+		mailer:     mailer,
+	}
+
+	require.NoError(t, server.registerValidators())
+	server.setupRouter()
+
 	return server
 }
 
@@ -1,14 +1,21 @@
 package api
 
 import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 	"treffly/api/common"
 	"treffly/api/models"
 	"treffly/apperror"
+	db "treffly/db/sqlc"
 	"treffly/token"
 )
 
@@ -16,7 +23,9 @@ const (
 	authorizationPayloadKey = "authorization_payload"
 )
 
-func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
+// authMiddleware looks the user up on every request: a blocked user or a
+// changed role takes effect immediately, not when the access token expires.
+func authMiddleware(tokenMaker token.Maker, store db.Store) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		accessToken, err := ctx.Cookie("access_token")
 		if err != nil {
@@ -37,11 +46,50 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 			return
 		}
 
+		access, err := store.GetUserAccess(ctx, payload.UserID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				ctx.Error(apperror.TokenExpired.WithCause(err))
+			} else {
+				ctx.Error(apperror.WrapDBError(err))
+			}
+			ctx.Abort()
+			return
+		}
+
+		if access.IsBlocked {
+			ctx.Error(apperror.UserBlocked.WithCause(fmt.Errorf("user %d is blocked", payload.UserID)))
+			ctx.Abort()
+			return
+		}
+		payload.Role = access.Role
+
 		ctx.Set(authorizationPayloadKey, payload)
 		ctx.Next()
 	}
 }
 
+// roleMiddleware must run after authMiddleware and lets through only
+// requests whose access token carries one of the given roles.
+func roleMiddleware(roles ...string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		role := common.GetRoleFromContextPayload(ctx)
+		for _, r := range roles {
+			if role == r {
+				ctx.Next()
+				return
+			}
+		}
+
+		ctx.Error(apperror.Forbidden.WithCause(fmt.Errorf("role %q is not allowed", role)))
+		ctx.Abort()
+	}
+}
+
+func adminMiddleware() gin.HandlerFunc {
+	return roleMiddleware(models.RoleAdmin)
+}
+
 func ErrorHandler(log *zap.Logger) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Next()
@@ -96,11 +144,57 @@ type rateLimitStore interface {
 }
 
 func RateLimitMiddleware(store rateLimitStore, limit int, window time.Duration) gin.HandlerFunc {
+	return rateLimit(store, limit, window, func(ctx *gin.Context) (string, error) {
+		return string(common.GetUserIDFromContextPayload(ctx)), nil
+	})
+}
+
+// IPRateLimitMiddleware limits anonymous endpoints by client address.
+func IPRateLimitMiddleware(store rateLimitStore, limit int, window time.Duration) gin.HandlerFunc {
+	return rateLimit(store, limit, window, func(ctx *gin.Context) (string, error) {
+		return "ip:" + ctx.ClientIP(), nil
+	})
+}
+
+// EmailRateLimitMiddleware limits requests naming the same email address, so
+// that one inbox cannot be flooded by spreading requests over many clients.
+// The body is put back for the handler; requests without an email are left
+// to the handler's validation.
+func EmailRateLimitMiddleware(store rateLimitStore, limit int, window time.Duration) gin.HandlerFunc {
+	return rateLimit(store, limit, window, func(ctx *gin.Context) (string, error) {
+		body, err := io.ReadAll(ctx.Request.Body)
+		if err != nil {
+			return "", err
+		}
+		ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var req struct {
+			Email string `json:"email"`
+		}
+		if json.Unmarshal(body, &req) != nil || req.Email == "" {
+			return "", nil
+		}
+
+		return "email:" + strings.ToLower(strings.TrimSpace(req.Email)), nil
+	})
+}
+
+// rateLimit counts requests per endpoint and key; an empty key is not limited.
+func rateLimit(store rateLimitStore, limit int, window time.Duration, key func(ctx *gin.Context) (string, error)) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		userID := common.GetUserIDFromContextPayload(ctx)
+		id, err := key(ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, apperror.BadRequest.WithCause(err))
+			return
+		}
+		if id == "" {
+			ctx.Next()
+			return
+		}
+
 		endpoint := ctx.FullPath()
 
-		result, err := store.CheckDescriptionLimit(ctx, endpoint, string(userID), limit, window)
+		result, err := store.CheckDescriptionLimit(ctx, endpoint, id, limit, window)
 		if err != nil {
 			ctx.AbortWithStatusJSON(http.StatusInternalServerError, apperror.InternalServer.WithCause(err))
 			return
@@ -1,12 +1,20 @@
 package api
 
 import (
+	"database/sql"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
 	"treffly/token"
 	"treffly/util"
 )
@@ -25,18 +33,38 @@ func addAuthorization(
 		Name:  "access_token",
 		Value: newToken,
 		Path: "/",
-		Domain: cookieDomain,
+		Domain: common.CookieDomain,
 		Expires: time.Now().Add(duration),
 	}
 	request.AddCookie(cookie)
 }
 
+func addRoleAuthorization(
+	t *testing.T,
+	request *http.Request,
+	tokenMaker token.Maker,
+	userID int32,
+	role string,
+	duration time.Duration,
+) {
+	newToken, _, err := tokenMaker.CreateSessionToken(userID, uuid.New(), role, duration)
+	require.NoError(t, err)
+	request.AddCookie(&http.Cookie{
+		Name:    "access_token",
+		Value:   newToken,
+		Path:    "/",
+		Domain:  common.CookieDomain,
+		Expires: time.Now().Add(duration),
+	})
+}
+
 func TestAuthMiddleware(t *testing.T) {
 	userID := int32(util.RandomInt(0,100))
 
 	testCases := []struct {
 		name          string
 		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
 		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
 	}{
 		{
@@ -44,6 +72,11 @@ func TestAuthMiddleware(t *testing.T) {
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 				addAuthorization(t, request, tokenMaker, userID, time.Minute)
 			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), userID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser}, nil)
+			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 			},
@@ -52,6 +85,9 @@ func TestAuthMiddleware(t *testing.T) {
 			name: "NoAuthorization",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUserAccess(gomock.Any(), gomock.Any()).Times(0)
+			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
@@ -61,6 +97,37 @@ func TestAuthMiddleware(t *testing.T) {
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 				addAuthorization(t, request, tokenMaker,userID, -time.Minute)
 			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetUserAccess(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "BlockedUser",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, userID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), userID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser, IsBlocked: true}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "DeletedUser",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, userID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), userID).
+					Return(db.GetUserAccessRow{}, sql.ErrNoRows)
+			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
@@ -71,11 +138,15 @@ func TestAuthMiddleware(t *testing.T) {
 		tc := testCases[i]
 
 		t.Run(tc.name, func(t *testing.T) {
-			server := newTestServer(t, nil)
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
 			authPath := "/test"
 			server.router.GET(
 				authPath,
-				authMiddleware(server.tokenMaker),
+				authMiddleware(server.tokenMaker, server.store),
 				func(ctx *gin.Context) {
 					ctx.JSON(http.StatusOK, gin.H{})
 				},
@@ -90,4 +161,132 @@ func TestAuthMiddleware(t *testing.T) {
 			tc.checkResponse(t, recorder)
 		})
 	}
-}
\ No newline at end of file
+}
+func TestAdminMiddleware(t *testing.T) {
+	userID := int32(util.RandomInt(1, 100))
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		role          string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Admin",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addRoleAuthorization(t, request, tokenMaker, userID, models.RoleAdmin, time.Minute)
+			},
+			role: models.RoleAdmin,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Moderator",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addRoleAuthorization(t, request, tokenMaker, userID, models.RoleModerator, time.Minute)
+			},
+			role: models.RoleModerator,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NoRole",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, userID, time.Minute)
+			},
+			role: models.RoleUser,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "DemotedSinceLogin",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addRoleAuthorization(t, request, tokenMaker, userID, models.RoleAdmin, time.Minute)
+			},
+			role: models.RoleUser,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetUserAccess(gomock.Any(), userID).
+				Return(db.GetUserAccessRow{Role: tc.role}, nil).
+				AnyTimes()
+
+			server := newTestServer(t, store, nil)
+			adminPath := "/admin/test"
+			server.router.GET(
+				adminPath,
+				authMiddleware(server.tokenMaker, server.store),
+				adminMiddleware(),
+				func(ctx *gin.Context) {
+					ctx.JSON(http.StatusOK, gin.H{})
+				},
+			)
+
+			recorder := httptest.NewRecorder()
+			request, err := http.NewRequest(http.MethodGet, adminPath, nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+type countingRateLimitStore struct {
+	counts map[string]int
+}
+
+func (s *countingRateLimitStore) CheckDescriptionLimit(_ *gin.Context, endpoint string, key string, limit int, _ time.Duration) (models.RateLimitResult, error) {
+	s.counts[endpoint+":"+key]++
+	return models.RateLimitResult{Allowed: s.counts[endpoint+":"+key] <= limit}, nil
+}
+
+func TestEmailRateLimitMiddleware(t *testing.T) {
+	rlStore := &countingRateLimitStore{counts: make(map[string]int)}
+
+	router := gin.New()
+	router.POST("/forgot", EmailRateLimitMiddleware(rlStore, 1, time.Minute), func(ctx *gin.Context) {
+		var req struct {
+			Email string `json:"email"`
+		}
+		require.NoError(t, ctx.ShouldBindJSON(&req))
+		ctx.String(http.StatusOK, req.Email)
+	})
+
+	send := func(body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodPost, "/forgot", strings.NewReader(body))
+		router.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	recorder := send(`{"email":"anna@example.com"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "anna@example.com", recorder.Body.String())
+
+	require.Equal(t, http.StatusTooManyRequests, send(`{"email":"Anna@Example.com"}`).Code)
+	require.Equal(t, http.StatusOK, send(`{"email":"boris@example.com"}`).Code)
+	require.Equal(t, 1, rlStore.counts["/forgot:email:boris@example.com"])
+}
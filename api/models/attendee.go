@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+type Attendee struct {
+	UserID        int32
+	Username      string
+	UserImagePath string
+	JoinedAt      time.Time
+}
+
+type AttendeesPage struct {
+	Attendees  []Attendee
+	NextCursor string
+	HasMore    bool
+}
+
+type ListAttendeesParams struct {
+	EventID int32
+	OwnerID int32
+	Cursor  string
+	Limit   int32
+}
+
+type RemoveAttendeeParams struct {
+	EventID int32
+	OwnerID int32
+	UserID  int32
+	Ban     bool
+}
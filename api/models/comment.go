@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+type Comment struct {
+	ID            int32
+	EventID       int32
+	ParentID      int32
+	UserID        int32
+	Username      string
+	UserImagePath string
+	Body          string
+	IsPinned      bool
+	IsEdited      bool
+	IsDeleted     bool
+	CreatedAt     time.Time
+	Replies       []Comment
+}
+
+type CommentsPage struct {
+	Comments   []Comment
+	NextCursor string
+	HasMore    bool
+}
+
+type ListCommentsParams struct {
+	EventID int32
+	UserID  int32
+	Token   string
+	Cursor  string
+	Limit   int32
+}
+
+type CreateCommentParams struct {
+	EventID  int32
+	UserID   int32
+	Token    string
+	ParentID int32
+	Body     string
+}
+
+type UpdateCommentParams struct {
+	CommentID int32
+	UserID    int32
+	Body      string
+}
@@ -7,24 +7,54 @@ import (
 )
 
 type Event struct {
-	ID               int32
-	Name             string
-	Description      string
-	Capacity         int32
-	Latitude         float64
-	Longitude        float64
-	Address          string
-	Date             time.Time
-	IsPrivate        bool
-	IsPremium        bool
-	CreatedAt        time.Time
-	OwnerUsername    string
-	IsOwner          bool
-	IsParticipant    bool
-	Tags             []Tag
-	ParticipantCount int
-	ImagePath        string
-	OwnerImagePath   string
+	ID                int32
+	Name              string
+	Description       string
+	Capacity          int32
+	Latitude          float64
+	Longitude         float64
+	Address           string
+	Date              time.Time
+	IsPrivate         bool
+	IsPremium         bool
+	CreatedAt         time.Time
+	OwnerID           int32
+	OwnerUsername     string
+	IsOwner           bool
+	IsParticipant     bool
+	Tags              []Tag
+	ParticipantCount  int
+	WaitlistCount     int
+	WaitlistPosition  int
+	SeriesID          int32
+	ImagePath         string
+	OwnerImagePath    string
+	NameHighlight     string
+	Snippet           string
+	OwnerRating       float64
+	OwnerReviewsCount int
+}
+
+type EventMarker struct {
+	ID        int32
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Date      time.Time
+	IsPremium bool
+}
+
+type EventCluster struct {
+	Count     int
+	Latitude  float64
+	Longitude float64
+	EventIDs  []int32
+}
+
+type EventsPage struct {
+	Events     []Event
+	NextCursor string
+	HasMore    bool
 }
 
 type HomeEvents struct {
@@ -47,6 +77,7 @@ type CreateParams struct {
 	OwnerID     int32
 	ImageID     uuid.UUID
 	Path        string
+	Recurrence  string
 }
 
 type ListParams struct {
@@ -55,6 +86,24 @@ type ListParams struct {
 	Search    string
 	TagIDs    []int32
 	DateRange string
+	Radius    float64
+	Cursor    string
+	Limit     int32
+}
+
+type BoundsParams struct {
+	MinLat    float64
+	MinLon    float64
+	MaxLat    float64
+	MaxLon    float64
+	TagIDs    []int32
+	DateRange string
+}
+
+type ClusterParams struct {
+	Bounds BoundsParams
+	Search string
+	Zoom   int
 }
 
 type UpdateParams struct {
@@ -73,6 +122,7 @@ type UpdateParams struct {
 	Path        string
 	DeleteImage bool
 	OldImageID  uuid.UUID
+	Following   bool
 }
 
 type DeleteParams struct {
@@ -94,4 +144,14 @@ type SubscriptionParams struct {
 
 type UserEventsParams struct {
 	UserID int32
+	Cursor string
+	Limit  int32
+}
+
+type FeedParams struct {
+	UserID int32
+	Lat    pgtype.Numeric
+	Lon    pgtype.Numeric
+	Cursor string
+	Limit  int32
 }
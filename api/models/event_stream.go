@@ -0,0 +1,13 @@
+package models
+
+const (
+	EventStreamParticipants = "participants"
+	EventStreamUpdated      = "updated"
+	EventStreamCancelled    = "cancelled"
+)
+
+type EventStreamMessage struct {
+	ID   string
+	Type string
+	Data []byte
+}
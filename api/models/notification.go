@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+const (
+	NotificationEventUpdated      = "event_updated"
+	NotificationEventCancelled    = "event_cancelled"
+	NotificationParticipantJoined = "participant_joined"
+	NotificationWaitlistPromoted  = "waitlist_promoted"
+	NotificationEventStarting     = "event_starting"
+)
+
+type Notification struct {
+	ID            int32
+	Type          string
+	EventID       int32
+	EventName     string
+	ActorID       int32
+	ActorUsername string
+	IsRead        bool
+	CreatedAt     time.Time
+}
+
+type NotificationsPage struct {
+	Notifications []Notification
+	UnreadCount   int
+	HasMore       bool
+}
+
+type ListNotificationsParams struct {
+	UserID     int32
+	UnreadOnly bool
+	Limit      int32
+	Offset     int32
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type PublicProfile struct {
+	ID                int32
+	Username          string
+	ImagePath         string
+	CreatedAt         time.Time
+	Tags              []Tag
+	HostedEventsCount int
+	FollowersCount    int
+	FollowingCount    int
+	IsFollowing       bool
+	Rating            Rating
+	UpcomingEvents    []Event
+	AttendedEvents    []Event
+	AttendanceHidden  bool
+}
+
+type PrivacySettings struct {
+	UserID         int32
+	HideAttendance bool
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+const (
+	PromotionPending  = "pending"
+	PromotionApproved = "approved"
+	PromotionRejected = "rejected"
+	PromotionActive   = "active"
+	PromotionFinished = "finished"
+	PromotionExpired  = "expired"
+)
+
+type Promotion struct {
+	ID          int32
+	EventID     int32
+	EventName   string
+	RequestedBy int32
+	Status      string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	ReviewedBy  int32
+	ReviewedAt  time.Time
+	ActivatedAt time.Time
+	FinishedAt  time.Time
+	CreatedAt   time.Time
+}
+
+type CreatePromotionParams struct {
+	EventID  int32
+	UserID   int32
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+type ListPromotionsParams struct {
+	Status string
+	Limit  int32
+	Offset int32
+}
@@ -0,0 +1,13 @@
+package models
+
+type ReminderPreferences struct {
+	EmailEnabled bool
+	PushEnabled  bool
+}
+
+type PushSubscriptionParams struct {
+	UserID   int32
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+const (
+	ReportOpen      = "open"
+	ReportResolved  = "resolved"
+	ReportDismissed = "dismissed"
+)
+
+const (
+	ReportTargetEvent = "event"
+	ReportTargetUser  = "user"
+)
+
+type Report struct {
+	ID               int32
+	ReporterID       int32
+	ReporterUsername string
+	TargetType       string
+	EventID          int32
+	EventName        string
+	UserID           int32
+	TargetUsername   string
+	Reason           string
+	Comment          string
+	Status           string
+	ResolvedBy       int32
+	ResolvedAt       time.Time
+	CreatedAt        time.Time
+	OpenReports      int64
+}
+
+type CreateReportParams struct {
+	ReporterID int32
+	TargetType string
+	TargetID   int32
+	Reason     string
+	Comment    string
+}
+
+type ListReportsParams struct {
+	Status     string
+	TargetType string
+	Limit      int32
+	Offset     int32
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+type Review struct {
+	EventID       int32
+	UserID        int32
+	Username      string
+	UserImagePath string
+	Rating        int
+	Body          string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type Rating struct {
+	Average      float64
+	ReviewsCount int
+}
+
+type ReviewsPage struct {
+	Rating  Rating
+	Reviews []Review
+	HasMore bool
+}
+
+type ListReviewsParams struct {
+	EventID int32
+	UserID  int32
+	Token   string
+	Limit   int32
+	Offset  int32
+}
+
+type UpsertReviewParams struct {
+	EventID int32
+	UserID  int32
+	Rating  int
+	Body    string
+}
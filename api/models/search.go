@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+)
+
+const (
+	SuggestionEvent     = "event"
+	SuggestionTag       = "tag"
+	SuggestionOrganizer = "organizer"
+)
+
+type Suggestion struct {
+	Type      string
+	ID        int32
+	Text      string
+	Date      time.Time
+	ImagePath string
+}
+
+type SuggestParams struct {
+	Query string
+	Lat   pgtype.Numeric
+	Lon   pgtype.Numeric
+}
@@ -5,17 +5,29 @@ import (
 	"time"
 )
 
+const (
+	RoleUser      = "user"
+	RoleOrganiser = "organiser"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
 type User struct {
-	ID           int32
-	Username     string
-	Email        string
-	CreatedAt    time.Time
+	ID            int32
+	Username      string
+	Email         string
+	CreatedAt     time.Time
+	EmailVerified bool
+	Role          string
+	IsBlocked     bool
 }
 
 type UserWithTags struct {
 	User
-	Tags      []Tag
-	ImagePath string
+	Tags           []Tag
+	ImagePath      string
+	FollowersCount int
+	FollowingCount int
 }
 
 type CreateUserParams struct {
@@ -50,3 +62,21 @@ type UpdateUserTagsParams struct {
 	TagIDs []int32
 }
 
+type SessionMeta struct {
+	UserAgent string
+	ClientIP  string
+}
+
+type Session struct {
+	ID         uuid.UUID
+	UserAgent  string
+	ClientIP   string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	IsCurrent  bool
+}
+
+type ListUsersParams struct {
+	Limit  int32
+	Offset int32
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+type Webhook struct {
+	ID                  int32
+	URL                 string
+	Secret              string
+	EventTypes          []string
+	IsActive            bool
+	ConsecutiveFailures int
+	DisabledAt          time.Time
+	CreatedAt           time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int32
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	LastError      string
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
+
+type CreateWebhookParams struct {
+	OwnerID    int32
+	URL        string
+	EventTypes []string
+}
+
+type UpdateWebhookParams struct {
+	ID         int32
+	OwnerID    int32
+	URL        string
+	EventTypes []string
+	IsActive   bool
+}
+
+type ListWebhookDeliveriesParams struct {
+	WebhookID int32
+	OwnerID   int32
+	Limit     int32
+	Offset    int32
+}
+
+type WebhookDeliveriesPage struct {
+	Deliveries []WebhookDelivery
+	HasMore    bool
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"treffly/api/models"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+)
+
+func TestRequestPromotionAPI(t *testing.T) {
+	userID := int32(4)
+	eventID := int32(5)
+
+	testCases := []struct {
+		name          string
+		role          string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "User",
+			role: models.RoleUser,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Moderator",
+			role: models.RoleModerator,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			// The empty body gets past the role check and is rejected by the
+			// handler.
+			name: "Organiser",
+			role: models.RoleOrganiser,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().
+				GetUserAccess(gomock.Any(), userID).
+				Return(db.GetUserAccessRow{Role: tc.role}, nil)
+			store.EXPECT().CreatePromotionTx(gomock.Any(), gomock.Any()).Times(0)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(gin.H{})
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/events/%d/promotions", eventID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addRoleAuthorization(t, request, server.tokenMaker, userID, tc.role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"treffly/api/models"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/token"
+)
+
+func TestReportEventAPI(t *testing.T) {
+	reporterID := int32(1)
+	eventID := int32(5)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"reason": "spam"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, reporterID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), reporterID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser}, nil)
+				store.EXPECT().
+					GetEvent(gomock.Any(), db.GetEventParams{ID: eventID, OwnerID: reporterID}).
+					Return(db.GetEventRow{ID: eventID, OwnerID: 2}, nil)
+				store.EXPECT().
+					CreateReportTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.CreateReportTxResult{Report: db.Report{
+						ID:         1,
+						ReporterID: reporterID,
+						EventID:    pgtype.Int4{Int32: eventID, Valid: true},
+						Reason:     "spam",
+						Status:     models.ReportOpen,
+					}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			body: gin.H{"reason": "spam"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "BlockedUser",
+			body: gin.H{"reason": "spam"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, reporterID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), reporterID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser, IsBlocked: true}, nil)
+				store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "OwnEvent",
+			body: gin.H{"reason": "spam"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, reporterID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), reporterID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser}, nil)
+				store.EXPECT().
+					GetEvent(gomock.Any(), db.GetEventParams{ID: eventID, OwnerID: reporterID}).
+					Return(db.GetEventRow{ID: eventID, OwnerID: reporterID}, nil)
+				store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "HiddenEvent",
+			body: gin.H{"reason": "spam"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, reporterID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), reporterID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser}, nil)
+				store.EXPECT().
+					GetEvent(gomock.Any(), db.GetEventParams{ID: eventID, OwnerID: reporterID}).
+					Return(db.GetEventRow{}, sql.ErrNoRows)
+				store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidReason",
+			body: gin.H{"reason": "boring"},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, reporterID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), reporterID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser}, nil)
+				store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tc.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/events/%d/reports", eventID)
+			request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestListReportsAPI(t *testing.T) {
+	userID := int32(3)
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Moderator",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, userID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), userID).
+					Return(db.GetUserAccessRow{Role: models.RoleModerator}, nil)
+				store.EXPECT().
+					ListReports(gomock.Any(), db.ListReportsParams{Status: models.ReportOpen, Lim: 20}).
+					Times(1).
+					Return([]db.ListReportsRow{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "User",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, userID, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetUserAccess(gomock.Any(), userID).
+					Return(db.GetUserAccessRow{Role: models.RoleUser}, nil)
+				store.EXPECT().ListReports(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListReports(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			recorder := httptest.NewRecorder()
+
+			request, err := http.NewRequest(http.MethodGet, "/moderation/reports", nil)
+			require.NoError(t, err)
+
+			tc.setupAuth(t, request, server.tokenMaker)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
@@ -1,31 +1,74 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
+	"time"
+	attendeedto "treffly/api/dto/attendee"
+	commentdto "treffly/api/dto/comment"
 	eventdto "treffly/api/dto/event"
+	profiledto "treffly/api/dto/profile"
+	reviewdto "treffly/api/dto/review"
 	userdto "treffly/api/dto/user"
+	"treffly/api/handler/admin"
+	"treffly/api/handler/attendee"
+	"treffly/api/handler/calendar"
+	"treffly/api/handler/comment"
 	"treffly/api/handler/event"
+	"treffly/api/handler/follow"
 	"treffly/api/handler/geo"
 	image2 "treffly/api/handler/image"
+	"treffly/api/handler/notification"
+	"treffly/api/handler/profile"
+	"treffly/api/handler/promotion"
+	"treffly/api/handler/reminder"
+	"treffly/api/handler/report"
+	"treffly/api/handler/review"
+	"treffly/api/handler/search"
+	"treffly/api/handler/stream"
 	"treffly/api/handler/tag"
 	token2 "treffly/api/handler/token"
 	"treffly/api/handler/user"
+	webhook2 "treffly/api/handler/webhook"
+	"treffly/api/models"
+	adminservice "treffly/api/service/admin"
+	attendeeservice "treffly/api/service/attendee"
+	calendarservice "treffly/api/service/calendar"
+	commentservice "treffly/api/service/comment"
 	eventservice "treffly/api/service/event"
+	followservice "treffly/api/service/follow"
 	"treffly/api/service/generator"
 	geoservice "treffly/api/service/geo"
 	imageservice "treffly/api/service/image"
+	notificationservice "treffly/api/service/notification"
+	outboxservice "treffly/api/service/outbox"
+	profileservice "treffly/api/service/profile"
+	promotionservice "treffly/api/service/promotion"
+	reminderservice "treffly/api/service/reminder"
+	reportservice "treffly/api/service/report"
+	reviewservice "treffly/api/service/review"
+	searchservice "treffly/api/service/search"
+	streamservice "treffly/api/service/stream"
 	tagservice "treffly/api/service/tag"
 	tokenservice "treffly/api/service/token"
 	userservice "treffly/api/service/user"
+	webhookservice "treffly/api/service/webhook"
 	"treffly/db/redis"
 	db "treffly/db/sqlc"
+	"treffly/delivery"
+	"treffly/eventbus"
 	"treffly/image"
 	"treffly/logger"
+	"treffly/mail"
+	"treffly/moderation"
+	"treffly/push"
+	"treffly/scheduler"
 	"treffly/token"
 	"treffly/util"
+	"treffly/webhook"
 )
 
 type Server struct {
@@ -37,6 +80,10 @@ type Server struct {
 	suggestClient *geoservice.SuggestClient
 	imageStore    image.Store
 	rlClient      *redis.Client
+	streamClient  *redis.Client
+	mailer        mail.Mailer
+	pushSender    *push.VAPIDSender
+	scheduler     *scheduler.Scheduler
 }
 
 func NewServer(config util.Config, store db.Store) (*Server, error) {
@@ -63,6 +110,41 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		return nil, fmt.Errorf("cannot create redis store: %w", err)
 	}
 
+	// Every open event stream holds a connection in a blocking XREAD, so
+	// streams get their own pool sized for them.
+	streamClient, err := redis.NewClient(&redis.Config{
+		Host:     config.RedisHost,
+		Port:     config.RedisPort,
+		Password: config.RedisPassword,
+		DB:       config.RedisDB,
+		PoolSize: config.StreamMaxConnections + 10,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create redis stream client: %w", err)
+	}
+
+	if config.SMTPHost == "" && config.Environment != "development" {
+		return nil, fmt.Errorf("SMTP_HOST is required in %s", config.Environment)
+	}
+
+	var mailer mail.Mailer
+	if config.SMTPHost != "" {
+		mailer = mail.NewSMTPMailer(config.SMTPHost, config.SMTPPort, config.SMTPUsername, config.SMTPPassword, config.MailFrom)
+	} else {
+		mailer, err = mail.NewFileMailer(config.MailDir, config.MailFrom)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create file mailer: %w", err)
+		}
+	}
+
+	var pushSender *push.VAPIDSender
+	if config.VAPIDPrivateKey != "" {
+		pushSender, err = push.NewVAPIDSender(config.VAPIDPrivateKey, config.VAPIDSubject, config.PushTimeout, config.PushAllowPrivate)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create push sender: %w", err)
+		}
+	}
+
 	server := &Server{
 		store:         store,
 		tokenMaker:    tokenMaker,
@@ -71,6 +153,9 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		suggestClient: suggesterClient,
 		imageStore:    imageStore,
 		rlClient:      rlClient,
+		streamClient:  streamClient,
+		mailer:        mailer,
+		pushSender:    pushSender,
 	}
 
 	err = server.registerValidators()
@@ -97,65 +182,212 @@ func (server *Server) setupRouter() {
 
 	imageService := imageservice.New(server.imageStore, server.config, server.store)
 
+	moderator := moderation.Chain{moderation.NewWordListModerator(moderation.DefaultWords, nil)}
+	if server.config.ModerationURL != "" {
+		moderator = append(moderator, moderation.NewHTTPModerator(server.config.ModerationURL, server.config.ModerationAPIKey, server.config.ModerationTimeout))
+	}
+
 	generatorClient := generator.NewClient(server.config.GenBaseURL, server.config.GenAPIKey, server.config.GenSystemPrompt, server.config.GenModel)
 	generatorHandler := event.NewGenerator(generatorClient)
 
-	eventService := eventservice.New(server.store, server.config)
+	notificationService := notificationservice.New(server.store, log)
+	notificationHandler := notification.NewNotificationHandler(notificationService)
+
+	eventService := eventservice.New(server.store, moderator, notificationService, server.config)
 	eventQueryHandler := event.NewEventQueryHandler(eventService, imageService, eventConverter)
 	eventCRUDHandler := event.NewEventCRUDHandler(eventService, imageService, eventConverter)
 	eventSubscriptionHandler := event.NewEventSubscriptionHandler(eventService, eventConverter)
 
-	userService := userservice.New(server.store, server.tokenMaker, server.config)
+	calendarService := calendarservice.New(server.store, eventService, server.config)
+	calendarHandler := calendar.NewCalendarHandler(eventService, calendarService, server.config)
+
+	commentService := commentservice.New(server.store, eventService, moderator)
+	commentHandler := comment.NewCommentHandler(commentService, commentdto.NewCommentConverter(server.config.Environment, server.config.Domain))
+
+	attendeeService := attendeeservice.New(server.store, eventService)
+	attendeeHandler := attendee.NewAttendeeHandler(attendeeService, attendeedto.NewAttendeeConverter(server.config.Environment, server.config.Domain))
+
+	followHandler := follow.NewFollowHandler(followservice.New(server.store))
+
+	reviewService := reviewservice.New(server.store, eventService, moderator)
+	reviewHandler := review.NewReviewHandler(reviewService, reviewdto.NewReviewConverter(server.config.Environment, server.config.Domain))
+
+	profileService := profileservice.New(server.store, eventService, reviewService)
+	profileHandler := profile.NewProfileHandler(profileService, profiledto.NewProfileConverter(server.config.Environment, server.config.Domain, eventConverter))
+
+	userService := userservice.New(server.store, server.tokenMaker, server.mailer, moderator, server.config, log)
 	userProfileHandler := user.NewProfileHandler(userService, userService, userService, imageService, userConverter, server.config.Environment)
-	userAuthHandler := user.NewAuthHandler(userService, userService, userConverter, server.config)
+	userAuthHandler := user.NewAuthHandler(userService, userService, userService, userConverter, server.config)
 
 	tagService := tagservice.New(server.store)
 	tagHandler := tag.NewTagHandler(tagService)
 
+	searchService := searchservice.New(server.store)
+	searchHandler := search.NewSearchHandler(searchService, server.config)
+
 	geoService := geoservice.New(server.store, server.geocodeClient, server.suggestClient)
 	geoHandler := geo.NewGeoHandler(geoService)
 
 	tokenService := tokenservice.New(server.store, server.tokenMaker, server.config, log)
 	tokenHandler := token2.NewTokenHandler(tokenService, server.config)
+	sessionHandler := token2.NewSessionHandler(tokenService)
 
 	imageHandler := image2.NewImageHandler(imageService)
 
+	adminService := adminservice.New(server.store)
+	adminHandler := admin.NewAdminHandler(adminService, imageService, userConverter)
+
+	promotionService := promotionservice.New(server.store, server.config, log)
+	promotionHandler := promotion.NewPromotionHandler(promotionService)
+
+	reportService := reportservice.New(server.store, server.config, log)
+	reportHandler := report.NewReportHandler(reportService)
+
+	var reminderService *reminderservice.Service
+	reminderChannels := []delivery.Channel{delivery.NewEmailChannel(server.mailer)}
+	vapidPublicKey := ""
+	if server.pushSender != nil {
+		forgetSubscription := func(ctx context.Context, endpoint string) {
+			reminderService.ForgetPushSubscription(ctx, endpoint)
+		}
+		reminderChannels = append(reminderChannels, delivery.NewPushChannel(server.pushSender, forgetSubscription))
+		vapidPublicKey = server.pushSender.PublicKey()
+	}
+	reminderService = reminderservice.New(server.store, reminderChannels, server.config, log)
+	reminderHandler := reminder.NewReminderHandler(reminderService, vapidPublicKey)
+
+	bus := eventbus.New()
+	outboxService := outboxservice.New(server.store, bus, server.config, log)
+
+	webhookClient := webhook.NewClient(server.config.WebhookTimeout, server.config.WebhookAllowPrivate)
+	webhookService := webhookservice.New(server.store, webhookClient, server.config, log)
+	webhookHandler := webhook2.NewWebhookHandler(webhookService)
+	bus.Subscribe("webhooks", webhookService.HandleEvent, webhookservice.EventTypes...)
+
+	streamService := streamservice.New(server.store, redis.NewEventStream(server.streamClient))
+	streamHandler := stream.NewStreamHandler(eventService, streamService, server.config.StreamHeartbeat, server.config.StreamMaxConnections)
+	bus.Subscribe("event_stream", streamService.HandleEvent, streamservice.EventTypes...)
+
+	server.scheduler = scheduler.New(log)
+	server.scheduler.Every("promotions_sync", server.config.PromotionSyncInterval, promotionService.Sync)
+	server.scheduler.Every("event_reminders", server.config.NotificationsInterval, notificationService.NotifyStartingEvents)
+	server.scheduler.Every("reminders", server.config.RemindersInterval, reminderService.Run)
+	server.scheduler.Every("outbox_relay", server.config.OutboxRelayInterval, outboxService.Run)
+	server.scheduler.Every("outbox_cleanup", time.Hour, outboxService.Cleanup)
+	server.scheduler.Every("webhooks", server.config.WebhooksInterval, webhookService.Deliver)
+
 	router.POST("/users", userAuthHandler.Create)
 	router.POST("/login", userAuthHandler.Login)
+	rlStore := redis.NewRateLimitStore(server.rlClient)
+	ipLimit := IPRateLimitMiddleware(rlStore, server.config.AuthLimit, server.config.AuthWindow)
+	emailLimit := EmailRateLimitMiddleware(rlStore, server.config.AuthLimit, server.config.AuthWindow)
+
+	router.POST("/users/verify", ipLimit, userAuthHandler.VerifyEmail)
+	router.POST("/password/forgot", ipLimit, emailLimit, userAuthHandler.ForgotPassword)
+	router.POST("/password/reset", ipLimit, userAuthHandler.ResetPassword)
 	router.POST("/auth/refresh", tokenHandler.RefreshTokens)
 	router.GET("/auth", tokenHandler.Auth)
 	router.GET("/tags", tagHandler.GetTags)
 	router.GET("/events", eventCRUDHandler.List)
+	router.GET("/events/markers", eventCRUDHandler.ListMarkers)
+	router.GET("/events/clusters", eventCRUDHandler.ListClusters)
 
 	router.GET("/images/*path", imageHandler.Get)
+	router.GET("/calendar/:token", calendarHandler.Feed)
 
 	router.GET("/geocode", geoHandler.Geocode)
 	router.GET("/suggest/addresses", geoHandler.Suggest)
+	router.GET("/search/suggest", searchHandler.Suggest)
+	router.GET("/users/:id/rating", reviewHandler.OrganizerRating)
 	router.GET("/reverse-geocode", geoHandler.ReverseGeocode)
+	router.GET("/push/vapid-public-key", reminderHandler.VAPIDPublicKey)
 
 	softAuthRoutes := router.Group("/").Use(softAuthMiddleware(server.tokenMaker))
 	softAuthRoutes.GET("/events/home", eventQueryHandler.GetHome)
 	softAuthRoutes.GET("/events/:id", eventCRUDHandler.GetByID)
+	softAuthRoutes.GET("/events/:id/calendar.ics", calendarHandler.Event)
+	softAuthRoutes.GET("/events/:id/stream", streamHandler.Stream)
+	softAuthRoutes.GET("/events/:id/comments", commentHandler.List)
+	softAuthRoutes.GET("/events/:id/reviews", reviewHandler.List)
+	softAuthRoutes.GET("/users/:id", profileHandler.Get)
 
-	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
+	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker, server.store))
 	authRoutes.POST("/logout", userAuthHandler.Logout)
 
 	authRoutes.GET("/users/me", userProfileHandler.GetCurrent)
 	authRoutes.PUT("/users/me", userProfileHandler.UpdateCurrent)
 	authRoutes.DELETE("/users/me", userProfileHandler.DeleteCurrent)
 	authRoutes.PUT("users/me/tags", userProfileHandler.UpdateCurrentTags)
+	authRoutes.POST("/users/me/verification", RateLimitMiddleware(rlStore, server.config.AuthLimit, server.config.AuthWindow), userAuthHandler.SendVerification)
+	authRoutes.GET("/users/me/sessions", sessionHandler.List)
+	authRoutes.DELETE("/users/me/sessions", sessionHandler.RevokeOthers)
+	authRoutes.DELETE("/users/me/sessions/:uuid", sessionHandler.Revoke)
 
 	authRoutes.POST("/events", eventCRUDHandler.Create)
 	authRoutes.PUT("/events/:id", eventCRUDHandler.Update)
 	authRoutes.DELETE("/events/:id", eventCRUDHandler.Delete)
 	authRoutes.POST("/events/:id/subscription", eventSubscriptionHandler.Subscribe)
 	authRoutes.DELETE("/events/:id/subscription", eventSubscriptionHandler.Unsubscribe)
+	authRoutes.POST("/events/:id/waitlist", eventSubscriptionHandler.JoinWaitlist)
+	authRoutes.DELETE("/events/:id/waitlist", eventSubscriptionHandler.LeaveWaitlist)
+	authRoutes.GET("/events/:id/participants", attendeeHandler.List)
+	authRoutes.GET("/events/:id/participants.csv", attendeeHandler.Export)
+	authRoutes.DELETE("/events/:id/participants/:user_id", attendeeHandler.Remove)
+	authRoutes.PUT("/events/:id/bans/:user_id", attendeeHandler.Ban)
+	authRoutes.DELETE("/events/:id/bans/:user_id", attendeeHandler.Unban)
 	authRoutes.GET("/users/me/past-events", eventQueryHandler.GetPast)
 	authRoutes.GET("/users/me/upcoming-events", eventQueryHandler.GetUpcoming)
 	authRoutes.GET("/users/me/owned-events", eventQueryHandler.GetOwned)
+	authRoutes.GET("/users/me/feed", eventQueryHandler.GetFeed)
+	authRoutes.GET("/users/me/privacy", profileHandler.GetPrivacy)
+	authRoutes.PUT("/users/me/privacy", profileHandler.UpdatePrivacy)
+	authRoutes.GET("/users/me/notifications", notificationHandler.List)
+	authRoutes.GET("/users/me/notifications/unread-count", notificationHandler.UnreadCount)
+	authRoutes.POST("/users/me/notifications/read", notificationHandler.MarkAllRead)
+	authRoutes.POST("/users/me/notifications/:id/read", notificationHandler.MarkRead)
+	authRoutes.GET("/users/me/reminders", reminderHandler.GetPreferences)
+	authRoutes.PUT("/users/me/reminders", reminderHandler.UpdatePreferences)
+	authRoutes.POST("/users/me/push-subscriptions", reminderHandler.SubscribePush)
+	authRoutes.DELETE("/users/me/push-subscriptions", reminderHandler.UnsubscribePush)
+	authRoutes.GET("/users/me/webhooks", webhookHandler.List)
+	authRoutes.POST("/users/me/webhooks", webhookHandler.Create)
+	authRoutes.PUT("/users/me/webhooks/:id", webhookHandler.Update)
+	authRoutes.DELETE("/users/me/webhooks/:id", webhookHandler.Delete)
+	authRoutes.GET("/users/me/webhooks/:id/deliveries", webhookHandler.ListDeliveries)
+	authRoutes.POST("/users/me/webhooks/:id/deliveries/:delivery_id/replay", webhookHandler.Replay)
+	authRoutes.POST("/users/me/calendar-feed", calendarHandler.CreateFeed)
+	authRoutes.DELETE("/users/me/calendar-feed", calendarHandler.RevokeFeed)
 	authRoutes.GET("/events/:id/invite", tokenHandler.CreatePrivateEventToken)
+	authRoutes.POST("/events/:id/reports", reportHandler.ReportEvent)
+	authRoutes.POST("/events/:id/promotions", roleMiddleware(models.RoleOrganiser, models.RoleAdmin), promotionHandler.Request)
+	authRoutes.GET("/events/:id/promotions", promotionHandler.ListForEvent)
+	authRoutes.POST("/events/:id/comments", commentHandler.Create)
+	authRoutes.PUT("/events/:id/review", reviewHandler.Upsert)
+	authRoutes.DELETE("/events/:id/review", reviewHandler.Delete)
+	authRoutes.PUT("/comments/:id", commentHandler.Update)
+	authRoutes.DELETE("/comments/:id", commentHandler.Delete)
+	authRoutes.POST("/comments/:id/pin", commentHandler.Pin)
+	authRoutes.DELETE("/comments/:id/pin", commentHandler.Unpin)
+	authRoutes.POST("/users/:id/reports", reportHandler.ReportUser)
+	authRoutes.POST("/users/:id/follow", followHandler.Follow)
+	authRoutes.DELETE("/users/:id/follow", followHandler.Unfollow)
+
+	adminRoutes := router.Group("/admin").Use(authMiddleware(server.tokenMaker, server.store), adminMiddleware())
+	adminRoutes.GET("/users", adminHandler.ListUsers)
+	adminRoutes.PUT("/users/:id/role", adminHandler.UpdateUserRole)
+	adminRoutes.POST("/users/:id/block", adminHandler.BlockUser)
+	adminRoutes.DELETE("/users/:id/block", adminHandler.UnblockUser)
+	adminRoutes.DELETE("/events/:id", adminHandler.DeleteEvent)
+	adminRoutes.PUT("/events/:id/premium", adminHandler.UpdateEventPremium)
+	adminRoutes.GET("/promotions", promotionHandler.List)
+	adminRoutes.POST("/promotions/:id/approve", promotionHandler.Approve)
+	adminRoutes.POST("/promotions/:id/reject", promotionHandler.Reject)
+
+	moderationRoutes := router.Group("/moderation").Use(authMiddleware(server.tokenMaker, server.store), roleMiddleware(models.RoleModerator, models.RoleAdmin))
+	moderationRoutes.GET("/reports", reportHandler.List)
+	moderationRoutes.POST("/reports/:id/resolve", reportHandler.Resolve)
+	moderationRoutes.POST("/reports/:id/dismiss", reportHandler.Dismiss)
 
-	rlStore := redis.NewRateLimitStore(server.rlClient)
 	limitCheckHandler := user.NewLimitCheckHandler(rlStore, server.config.GenLimit, server.config.GenTimeout)
 
 	authRoutes.GET("/events/generate-desc", RateLimitMiddleware(rlStore, server.config.GenLimit, server.config.GenTimeout), generatorHandler.CreateChatCompletion)
@@ -205,5 +437,6 @@ func (server *Server) registerValidators() error {
 }
 
 func (server *Server) Start(address string) error {
+	server.scheduler.Start(context.Background())
 	return server.router.Run(address)
 }
@@ -0,0 +1,91 @@
+package adminservice
+
+import (
+	"context"
+	"fmt"
+	"treffly/api/models"
+	userservice "treffly/api/service/user"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+)
+
+type Service struct {
+	store db.Store
+}
+
+func New(store db.Store) *Service {
+	return &Service{
+		store: store,
+	}
+}
+
+func (s *Service) ListUsers(ctx context.Context, params models.ListUsersParams) ([]models.User, error) {
+	dbUsers, err := s.store.ListUsers(ctx, db.ListUsersParams{
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]models.User, len(dbUsers))
+	for i, u := range dbUsers {
+		users[i] = userservice.ConvertUser(u)
+	}
+
+	return users, nil
+}
+
+func (s *Service) SetUserBlocked(ctx context.Context, adminID, userID int32, blocked bool) (models.User, error) {
+	if adminID == userID {
+		return models.User{}, apperror.BadRequest.WithCause(fmt.Errorf("admin cannot block themselves"))
+	}
+
+	user, err := s.store.SetUserBlockedTx(ctx, db.SetUserBlockedTxParams{
+		UserID:    userID,
+		IsBlocked: blocked,
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+
+	return userservice.ConvertUser(user), nil
+}
+
+func (s *Service) UpdateUserRole(ctx context.Context, adminID, userID int32, role string) (models.User, error) {
+	if adminID == userID && role != models.RoleAdmin {
+		return models.User{}, apperror.BadRequest.WithCause(fmt.Errorf("admin cannot revoke their own role"))
+	}
+
+	user, err := s.store.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+		ID:   userID,
+		Role: role,
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+
+	return userservice.ConvertUser(user), nil
+}
+
+// DeleteEvent goes through the same transaction as an owner's delete, so
+// participants, webhooks and streams learn about moderator removals too.
+func (s *Service) DeleteEvent(ctx context.Context, eventID int32) error {
+	return s.store.DeleteEventTx(ctx, eventID)
+}
+
+func (s *Service) SetEventPremium(ctx context.Context, eventID int32, premium bool) error {
+	rows, err := s.store.UpdateEventPremium(ctx, db.UpdateEventPremiumParams{
+		ID:            eventID,
+		PremiumManual: premium,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return apperror.NotFound.WithCause(fmt.Errorf("event %d not found", eventID))
+	}
+
+	return nil
+}
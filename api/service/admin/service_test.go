@@ -0,0 +1,83 @@
+package adminservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetUserBlockedSelf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().SetUserBlockedTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := New(store).SetUserBlocked(context.Background(), 1, 1, true)
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestSetUserBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		SetUserBlockedTx(gomock.Any(), db.SetUserBlockedTxParams{UserID: 2, IsBlocked: true}).
+		Return(db.User{ID: 2, Role: models.RoleUser, IsBlocked: true}, nil)
+
+	user, err := New(store).SetUserBlocked(context.Background(), 1, 2, true)
+	require.NoError(t, err)
+	require.True(t, user.IsBlocked)
+}
+
+func TestUpdateUserRoleSelfDemotion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().UpdateUserRole(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := New(store).UpdateUserRole(context.Background(), 1, 1, models.RoleModerator)
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestSetEventPremiumNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		UpdateEventPremium(gomock.Any(), db.UpdateEventPremiumParams{ID: 7, PremiumManual: true}).
+		Return(int64(0), nil)
+
+	err := New(store).SetEventPremium(context.Background(), 7, true)
+
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
+
+func TestDeleteEventNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		DeleteEventTx(gomock.Any(), int32(7)).
+		Return(fmt.Errorf("get event error: %w", sql.ErrNoRows))
+	store.EXPECT().DeleteEvent(gomock.Any(), gomock.Any()).Times(0)
+
+	err := New(store).DeleteEvent(context.Background(), 7)
+
+	servicetest.RequireAppError(t, apperror.WrapDBError(err), apperror.NotFound)
+}
+
+func TestDeleteEventNotifies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().DeleteEventTx(gomock.Any(), int32(7)).Times(1).Return(nil)
+	store.EXPECT().DeleteEvent(gomock.Any(), gomock.Any()).Times(0)
+
+	err := New(store).DeleteEvent(context.Background(), 7)
+	require.NoError(t, err)
+}
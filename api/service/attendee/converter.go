@@ -0,0 +1,31 @@
+package attendeeservice
+
+import (
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertAttendee(r db.ListEventParticipantsRow) models.Attendee {
+	return models.Attendee{
+		UserID:        r.ID,
+		Username:      r.Username,
+		UserImagePath: r.UserImagePath.String,
+		JoinedAt:      r.JoinedAt,
+	}
+}
+
+func convertAttendees(rows []db.ListEventParticipantsRow) []models.Attendee {
+	result := make([]models.Attendee, len(rows))
+	for i, r := range rows {
+		result[i] = convertAttendee(r)
+	}
+	return result
+}
+
+func convertAllAttendees(rows []db.ListAllEventParticipantsRow) []models.Attendee {
+	result := make([]models.Attendee, len(rows))
+	for i, r := range rows {
+		result[i] = convertAttendee(db.ListEventParticipantsRow(r))
+	}
+	return result
+}
@@ -0,0 +1,51 @@
+package attendeeservice
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+	"treffly/api/models"
+)
+
+// utf8BOM makes Excel read the file as UTF-8; usernames are mostly Cyrillic.
+const utf8BOM = "\ufeff"
+
+func renderCSV(attendees []models.Attendee) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(utf8BOM)
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"user_id", "username", "joined_at"}); err != nil {
+		return nil, err
+	}
+
+	for _, a := range attendees {
+		err := w.Write([]string{
+			strconv.Itoa(int(a.UserID)),
+			escapeFormula(a.Username),
+			a.JoinedAt.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// escapeFormula keeps spreadsheets from evaluating cells as formulas:
+// usernames may start with a hyphen, and some spreadsheets also treat a
+// leading tab or carriage return as one.
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,141 @@
+package attendeeservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+)
+
+type eventProvider interface {
+	GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error)
+	RequireOwner(ctx context.Context, eventID, userID int32) error
+}
+
+// listCursor follows the ORDER BY of ListEventParticipants: earliest joined
+// first.
+type listCursor struct {
+	JoinedAt time.Time `json:"j"`
+	ID       int32     `json:"i"`
+}
+
+type Service struct {
+	store  db.Store
+	events eventProvider
+}
+
+func New(store db.Store, events eventProvider) *Service {
+	return &Service{
+		store:  store,
+		events: events,
+	}
+}
+
+func (s *Service) List(ctx context.Context, params models.ListAttendeesParams) (models.AttendeesPage, error) {
+	if err := s.events.RequireOwner(ctx, params.EventID, params.OwnerID); err != nil {
+		return models.AttendeesPage{}, err
+	}
+
+	arg := db.ListEventParticipantsParams{
+		EventID:   params.EventID,
+		PageLimit: params.Limit + 1,
+	}
+	if params.Cursor != "" {
+		var cursor listCursor
+		if err := common.DecodeCursor(params.Cursor, &cursor); err != nil {
+			return models.AttendeesPage{}, apperror.BadRequest.WithCause(err)
+		}
+		arg.HasCursor = true
+		arg.CursorJoinedAt = cursor.JoinedAt
+		arg.CursorID = cursor.ID
+	}
+
+	rows, err := s.store.ListEventParticipants(ctx, arg)
+	if err != nil {
+		return models.AttendeesPage{}, err
+	}
+
+	var nextCursor string
+	hasMore := len(rows) > int(params.Limit)
+	if hasMore {
+		rows = rows[:params.Limit]
+		last := rows[len(rows)-1]
+		nextCursor = common.EncodeCursor(listCursor{JoinedAt: last.JoinedAt, ID: last.ID})
+	}
+
+	return models.AttendeesPage{
+		Attendees:  convertAttendees(rows),
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
+	}, nil
+}
+
+// Export renders the whole attendee list as CSV.
+func (s *Service) Export(ctx context.Context, eventID, ownerID int32) ([]byte, error) {
+	if err := s.events.RequireOwner(ctx, eventID, ownerID); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.store.ListAllEventParticipants(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := renderCSV(convertAllAttendees(rows))
+	if err != nil {
+		return nil, apperror.InternalServer.WithCause(err)
+	}
+
+	return data, nil
+}
+
+// Remove takes a participant off the event. With Ban set the user is also
+// barred from joining again; banning works for users who have not joined.
+func (s *Service) Remove(ctx context.Context, params models.RemoveAttendeeParams) error {
+	if err := s.events.RequireOwner(ctx, params.EventID, params.OwnerID); err != nil {
+		return err
+	}
+
+	if params.UserID == params.OwnerID {
+		return apperror.BadRequest.WithCause(errors.New("owner cannot remove themselves"))
+	}
+
+	result, err := s.store.RemoveEventParticipantTx(ctx, db.RemoveEventParticipantTxParams{
+		EventID: params.EventID,
+		UserID:  params.UserID,
+		Ban:     params.Ban,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !result.Removed && !params.Ban {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (s *Service) Unban(ctx context.Context, eventID, ownerID, userID int32) error {
+	if err := s.events.RequireOwner(ctx, eventID, ownerID); err != nil {
+		return err
+	}
+
+	unbanned, err := s.store.UnbanEventUser(ctx, db.UnbanEventUserParams{
+		EventID: eventID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if unbanned == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package attendeeservice
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// events holds event 10, owned by user 1.
+var events = servicetest.Events{10: {ID: 10, OwnerID: 1}}
+
+func TestListOwnerOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListEventParticipants(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := New(store, events).List(context.Background(), models.ListAttendeesParams{EventID: 10, OwnerID: 2, Limit: 20})
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestListHasMore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	joined := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.EXPECT().
+		ListEventParticipants(gomock.Any(), db.ListEventParticipantsParams{EventID: 10, PageLimit: 3}).
+		Return([]db.ListEventParticipantsRow{
+			{ID: 2, Username: "anna"},
+			{ID: 3, Username: "oleg", JoinedAt: joined},
+			{ID: 4, Username: "ivan"},
+		}, nil)
+
+	page, err := New(store, events).List(context.Background(), models.ListAttendeesParams{EventID: 10, OwnerID: 1, Limit: 2})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.Len(t, page.Attendees, 2)
+	require.Equal(t, "oleg", page.Attendees[1].Username)
+
+	var cursor listCursor
+	require.NoError(t, common.DecodeCursor(page.NextCursor, &cursor))
+	require.Equal(t, listCursor{JoinedAt: joined, ID: 3}, cursor)
+}
+
+func TestListFromCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	joined := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.EXPECT().
+		ListEventParticipants(gomock.Any(), db.ListEventParticipantsParams{
+			EventID:        10,
+			HasCursor:      true,
+			CursorJoinedAt: joined,
+			CursorID:       3,
+			PageLimit:      3,
+		}).
+		Return([]db.ListEventParticipantsRow{{ID: 4, Username: "ivan"}}, nil)
+
+	cursor := common.EncodeCursor(listCursor{JoinedAt: joined, ID: 3})
+	page, err := New(store, events).List(context.Background(), models.ListAttendeesParams{EventID: 10, OwnerID: 1, Cursor: cursor, Limit: 2})
+	require.NoError(t, err)
+	require.False(t, page.HasMore)
+	require.Empty(t, page.NextCursor)
+	require.Len(t, page.Attendees, 1)
+}
+
+func TestListInvalidCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListEventParticipants(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := New(store, events).List(context.Background(), models.ListAttendeesParams{EventID: 10, OwnerID: 1, Cursor: "not-a-cursor", Limit: 2})
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestExportCSV(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	joined := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.EXPECT().
+		ListAllEventParticipants(gomock.Any(), int32(10)).
+		Return([]db.ListAllEventParticipantsRow{
+			{ID: 2, Username: "анна", JoinedAt: joined},
+			{ID: 3, Username: "-олег", JoinedAt: joined},
+			{ID: 4, Username: "\tиван", JoinedAt: joined},
+		}, nil)
+
+	data, err := New(store, events).Export(context.Background(), 10, 1)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimPrefix(string(data), utf8BOM), "\n")
+	require.Equal(t, []string{
+		"user_id,username,joined_at",
+		"2,анна,2026-05-01T12:00:00Z",
+		"3,'-олег,2026-05-01T12:00:00Z",
+		"4,'\tиван,2026-05-01T12:00:00Z",
+		"",
+	}, lines)
+}
+
+func TestRemoveNotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		RemoveEventParticipantTx(gomock.Any(), db.RemoveEventParticipantTxParams{EventID: 10, UserID: 2}).
+		Return(db.RemoveEventParticipantTxResult{}, nil)
+
+	err := New(store, events).Remove(context.Background(), models.RemoveAttendeeParams{EventID: 10, OwnerID: 1, UserID: 2})
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
+
+func TestBanNotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		RemoveEventParticipantTx(gomock.Any(), db.RemoveEventParticipantTxParams{EventID: 10, UserID: 2, Ban: true}).
+		Return(db.RemoveEventParticipantTxResult{}, nil)
+
+	err := New(store, events).Remove(context.Background(), models.RemoveAttendeeParams{EventID: 10, OwnerID: 1, UserID: 2, Ban: true})
+	require.NoError(t, err)
+}
+
+func TestRemoveByNonOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().RemoveEventParticipantTx(gomock.Any(), gomock.Any()).Times(0)
+
+	err := New(store, events).Remove(context.Background(), models.RemoveAttendeeParams{EventID: 10, OwnerID: 3, UserID: 2, Ban: true})
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestUnbanNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		UnbanEventUser(gomock.Any(), db.UnbanEventUserParams{EventID: 10, UserID: 2}).
+		Return(int64(0), nil)
+
+	err := New(store, events).Unban(context.Background(), 10, 1, 2)
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
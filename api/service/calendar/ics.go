@@ -0,0 +1,91 @@
+package calendarservice
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"treffly/api/models"
+	"unicode/utf8"
+)
+
+const (
+	icsTimeLayout   = "20060102T150405Z"
+	icsLineLimit    = 75
+	icsProductID    = "-//Treffly//Treffly Calendar//RU"
+	icsCalendarName = "Treffly"
+)
+
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+func renderCalendar(events []models.Event, domain string, stamp time.Time) []byte {
+	var buf bytes.Buffer
+
+	writeLine(&buf, "BEGIN:VCALENDAR")
+	writeLine(&buf, "VERSION:2.0")
+	writeLine(&buf, "PRODID:"+icsProductID)
+	writeLine(&buf, "CALSCALE:GREGORIAN")
+	writeLine(&buf, "METHOD:PUBLISH")
+	writeLine(&buf, "X-WR-CALNAME:"+icsCalendarName)
+
+	for _, e := range events {
+		writeEvent(&buf, e, domain, stamp)
+	}
+
+	writeLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+func writeEvent(buf *bytes.Buffer, e models.Event, domain string, stamp time.Time) {
+	writeLine(buf, "BEGIN:VEVENT")
+	writeLine(buf, fmt.Sprintf("UID:event-%d@%s", e.ID, domain))
+	writeLine(buf, "DTSTAMP:"+stamp.UTC().Format(icsTimeLayout))
+	writeLine(buf, "DTSTART:"+e.Date.UTC().Format(icsTimeLayout))
+	writeLine(buf, "SUMMARY:"+escapeText(e.Name))
+	if e.Description != "" {
+		writeLine(buf, "DESCRIPTION:"+escapeText(e.Description))
+	}
+	if e.Address != "" {
+		writeLine(buf, "LOCATION:"+escapeText(e.Address))
+	}
+	writeLine(buf, "GEO:"+formatCoordinate(e.Latitude)+";"+formatCoordinate(e.Longitude))
+	if e.IsPrivate {
+		writeLine(buf, "CLASS:PRIVATE")
+	}
+	writeLine(buf, "END:VEVENT")
+}
+
+func escapeText(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+func formatCoordinate(f float64) string {
+	return strconv.FormatFloat(f, 'f', 6, 64)
+}
+
+// writeLine folds content lines longer than 75 octets without splitting
+// multi-byte UTF-8 sequences and terminates every line with CRLF.
+func writeLine(buf *bytes.Buffer, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icsLineLimit - 1
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,55 @@
+package calendarservice
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"treffly/api/models"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCalendar(t *testing.T) {
+	stamp := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	moscow := time.FixedZone("MSK", 3*60*60)
+
+	event := models.Event{
+		ID:          42,
+		Name:        "Пробежка, утро; парк",
+		Description: "Первая строка\nвторая строка с \\ слэшем",
+		Address:     "Москва, Парк Горького",
+		Latitude:    55.729876,
+		Longitude:   37.603142,
+		Date:        time.Date(2026, 3, 2, 9, 30, 0, 0, moscow),
+		IsPrivate:   true,
+	}
+
+	ics := string(renderCalendar([]models.Event{event}, "treffly.ru", stamp))
+
+	require.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	require.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	require.NotContains(t, strings.ReplaceAll(ics, "\r\n", ""), "\n")
+
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	require.Contains(t, unfolded, "UID:event-42@treffly.ru\r\n")
+	require.Contains(t, unfolded, "DTSTAMP:20260301T100000Z\r\n")
+	require.Contains(t, unfolded, "DTSTART:20260302T063000Z\r\n")
+	require.Contains(t, unfolded, `SUMMARY:Пробежка\, утро\; парк`+"\r\n")
+	require.Contains(t, unfolded, `DESCRIPTION:Первая строка\nвторая строка с \\ слэшем`+"\r\n")
+	require.Contains(t, unfolded, `LOCATION:Москва\, Парк Горького`+"\r\n")
+	require.Contains(t, unfolded, "GEO:55.729876;37.603142\r\n")
+	require.Contains(t, unfolded, "CLASS:PRIVATE\r\n")
+}
+
+func TestWriteLineFolding(t *testing.T) {
+	long := "DESCRIPTION:" + strings.Repeat("Встреча ", 30)
+
+	ics := string(renderCalendar([]models.Event{{Description: long}}, "treffly.ru", time.Now()))
+
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), 75)
+		require.True(t, utf8.ValidString(line), line)
+	}
+	require.Contains(t, strings.ReplaceAll(ics, "\r\n ", ""), escapeText(long))
+}
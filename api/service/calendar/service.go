@@ -0,0 +1,124 @@
+package calendarservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/util"
+)
+
+type eventProvider interface {
+	GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error)
+	GetUpcomingUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error)
+	GetOwnedUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error)
+}
+
+type Service struct {
+	store  db.Store
+	events eventProvider
+	config util.Config
+}
+
+func New(store db.Store, events eventProvider, config util.Config) *Service {
+	return &Service{
+		store:  store,
+		events: events,
+		config: config,
+	}
+}
+
+func (s *Service) Render(events []models.Event) []byte {
+	return renderCalendar(events, s.config.Domain, time.Now())
+}
+
+func (s *Service) CreateFeedToken(ctx context.Context, userID int32) (string, error) {
+	token, err := util.GenerateSecureToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	arg := db.UpsertCalendarFeedParams{
+		UserID:    userID,
+		TokenHash: util.HashToken(token),
+	}
+
+	if err := s.store.UpsertCalendarFeed(ctx, arg); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *Service) RevokeFeedToken(ctx context.Context, userID int32) error {
+	return s.store.DeleteCalendarFeed(ctx, userID)
+}
+
+func (s *Service) GetFeedEvents(ctx context.Context, token string) ([]models.Event, error) {
+	userID, err := s.store.GetCalendarFeedUserID(ctx, util.HashToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound.WithCause(err)
+		}
+		return nil, err
+	}
+
+	upcoming, err := s.collect(ctx, userID, s.events.GetUpcomingUserEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	owned, err := s.collect(ctx, userID, s.events.GetOwnedUserEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int32]bool, len(upcoming)+len(owned))
+	result := make([]models.Event, 0, len(upcoming)+len(owned))
+	for _, e := range append(upcoming, owned...) {
+		if seen[e.ID] {
+			continue
+		}
+		seen[e.ID] = true
+
+		if e.IsPrivate {
+			visible, err := s.events.GetEvent(ctx, e.ID, userID, "")
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			e = visible
+		}
+
+		result = append(result, e)
+	}
+
+	return result, nil
+}
+
+func (s *Service) collect(ctx context.Context, userID int32, fetch func(context.Context, models.UserEventsParams) (models.EventsPage, error)) ([]models.Event, error) {
+	params := models.UserEventsParams{
+		UserID: userID,
+		Limit:  int32(s.config.EventsMaxPageSize),
+	}
+
+	var result []models.Event
+	for {
+		page, err := fetch(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.Events...)
+		if !page.HasMore {
+			return result, nil
+		}
+
+		params.Cursor = page.NextCursor
+	}
+}
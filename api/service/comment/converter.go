@@ -0,0 +1,59 @@
+package commentservice
+
+import (
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertComment(c db.Comment) models.Comment {
+	return models.Comment{
+		ID:        c.ID,
+		EventID:   c.EventID,
+		ParentID:  c.ParentID.Int32,
+		UserID:    c.UserID,
+		Body:      c.Body,
+		IsPinned:  c.IsPinned,
+		IsEdited:  c.EditedAt.Valid,
+		IsDeleted: c.DeletedAt.Valid,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func convertCommentRows(rows []db.ListEventCommentsRow) []models.Comment {
+	result := make([]models.Comment, len(rows))
+	for i, r := range rows {
+		result[i] = convertCommentRow(db.ListCommentRepliesRow(r))
+	}
+	return result
+}
+
+func convertReplyRows(rows []db.ListCommentRepliesRow) []models.Comment {
+	result := make([]models.Comment, len(rows))
+	for i, r := range rows {
+		result[i] = convertCommentRow(r)
+	}
+	return result
+}
+
+// convertCommentRow hides the author of deleted comments; they are only
+// listed to keep their replies in place.
+func convertCommentRow(r db.ListCommentRepliesRow) models.Comment {
+	c := models.Comment{
+		ID:        r.ID,
+		EventID:   r.EventID,
+		ParentID:  r.ParentID.Int32,
+		Body:      r.Body,
+		IsPinned:  r.IsPinned,
+		IsEdited:  r.EditedAt.Valid,
+		IsDeleted: r.DeletedAt.Valid,
+		CreatedAt: r.CreatedAt,
+	}
+
+	if !c.IsDeleted {
+		c.UserID = r.UserID
+		c.Username = r.Username
+		c.UserImagePath = r.UserImagePath.String
+	}
+
+	return c
+}
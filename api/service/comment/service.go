@@ -0,0 +1,218 @@
+package commentservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/moderation"
+)
+
+// eventProvider gives comments the same visibility as the event itself:
+// comments on private events are only readable by those who can open it.
+type eventProvider interface {
+	GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error)
+	RequireOwner(ctx context.Context, eventID, userID int32) error
+}
+
+// listCursor follows the ORDER BY of ListEventComments: pinned first, then
+// newest.
+type listCursor struct {
+	Pinned    bool      `json:"p"`
+	CreatedAt time.Time `json:"c"`
+	ID        int32     `json:"i"`
+}
+
+type Service struct {
+	store     db.Store
+	events    eventProvider
+	moderator moderation.Moderator
+}
+
+func New(store db.Store, events eventProvider, moderator moderation.Moderator) *Service {
+	return &Service{
+		store:     store,
+		events:    events,
+		moderator: moderator,
+	}
+}
+
+func (s *Service) List(ctx context.Context, params models.ListCommentsParams) (models.CommentsPage, error) {
+	if _, err := s.events.GetEvent(ctx, params.EventID, params.UserID, params.Token); err != nil {
+		return models.CommentsPage{}, err
+	}
+
+	arg := db.ListEventCommentsParams{
+		EventID:   params.EventID,
+		PageLimit: params.Limit + 1,
+	}
+	if params.Cursor != "" {
+		var cursor listCursor
+		if err := common.DecodeCursor(params.Cursor, &cursor); err != nil {
+			return models.CommentsPage{}, apperror.BadRequest.WithCause(err)
+		}
+		arg.HasCursor = true
+		arg.CursorPinned = cursor.Pinned
+		arg.CursorCreatedAt = cursor.CreatedAt
+		arg.CursorID = cursor.ID
+	}
+
+	rows, err := s.store.ListEventComments(ctx, arg)
+	if err != nil {
+		return models.CommentsPage{}, err
+	}
+
+	var nextCursor string
+	hasMore := len(rows) > int(params.Limit)
+	if hasMore {
+		rows = rows[:params.Limit]
+		last := rows[len(rows)-1]
+		nextCursor = common.EncodeCursor(listCursor{Pinned: last.IsPinned, CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	comments := convertCommentRows(rows)
+	if len(comments) == 0 {
+		return models.CommentsPage{Comments: comments}, nil
+	}
+
+	parentIDs := make([]int32, len(comments))
+	index := make(map[int32]int, len(comments))
+	for i, c := range comments {
+		parentIDs[i] = c.ID
+		index[c.ID] = i
+	}
+
+	replies, err := s.store.ListCommentReplies(ctx, parentIDs)
+	if err != nil {
+		return models.CommentsPage{}, err
+	}
+
+	for _, r := range convertReplyRows(replies) {
+		i := index[r.ParentID]
+		comments[i].Replies = append(comments[i].Replies, r)
+	}
+
+	return models.CommentsPage{Comments: comments, NextCursor: nextCursor, HasMore: hasMore}, nil
+}
+
+func (s *Service) Create(ctx context.Context, params models.CreateCommentParams) (models.Comment, error) {
+	if _, err := s.events.GetEvent(ctx, params.EventID, params.UserID, params.Token); err != nil {
+		return models.Comment{}, err
+	}
+
+	arg := db.CreateCommentParams{
+		EventID: params.EventID,
+		UserID:  params.UserID,
+		Body:    params.Body,
+	}
+
+	if params.ParentID != 0 {
+		parent, err := s.store.GetComment(ctx, params.ParentID)
+		if err != nil {
+			return models.Comment{}, err
+		}
+		if parent.EventID != params.EventID || parent.DeletedAt.Valid {
+			return models.Comment{}, apperror.BadRequest.WithCause(fmt.Errorf("comment %d cannot be replied to", parent.ID))
+		}
+		if parent.ParentID.Valid {
+			return models.Comment{}, apperror.BadRequest.WithCause(errors.New("replies cannot be nested"))
+		}
+		arg.ParentID = pgtype.Int4{Int32: parent.ID, Valid: true}
+	}
+
+	if err := common.Moderate(ctx, s.moderator, moderation.Field{Name: "body", Text: params.Body}); err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err := s.store.CreateComment(ctx, arg)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return convertComment(comment), nil
+}
+
+func (s *Service) Update(ctx context.Context, params models.UpdateCommentParams) (models.Comment, error) {
+	comment, err := s.getActiveComment(ctx, params.CommentID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if comment.UserID != params.UserID {
+		return models.Comment{}, apperror.Forbidden.WithCause(errors.New("only the author can edit a comment"))
+	}
+
+	if err := common.Moderate(ctx, s.moderator, moderation.Field{Name: "body", Text: params.Body}); err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err = s.store.UpdateCommentBody(ctx, db.UpdateCommentBodyParams{
+		ID:   params.CommentID,
+		Body: params.Body,
+	})
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return convertComment(comment), nil
+}
+
+// Delete soft-deletes a comment so its replies keep their context. The author
+// and the event owner may delete it.
+func (s *Service) Delete(ctx context.Context, commentID, userID int32) error {
+	comment, err := s.getActiveComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID {
+		if err := s.events.RequireOwner(ctx, comment.EventID, userID); err != nil {
+			return err
+		}
+	}
+
+	return s.store.SoftDeleteComment(ctx, commentID)
+}
+
+func (s *Service) SetPinned(ctx context.Context, commentID, userID int32, pinned bool) (models.Comment, error) {
+	comment, err := s.getActiveComment(ctx, commentID)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	if comment.ParentID.Valid {
+		return models.Comment{}, apperror.BadRequest.WithCause(errors.New("replies cannot be pinned"))
+	}
+
+	if err := s.events.RequireOwner(ctx, comment.EventID, userID); err != nil {
+		return models.Comment{}, err
+	}
+
+	comment, err = s.store.SetCommentPinned(ctx, db.SetCommentPinnedParams{
+		ID:       commentID,
+		IsPinned: pinned,
+	})
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	return convertComment(comment), nil
+}
+
+func (s *Service) getActiveComment(ctx context.Context, commentID int32) (db.Comment, error) {
+	comment, err := s.store.GetComment(ctx, commentID)
+	if err != nil {
+		return db.Comment{}, err
+	}
+
+	if comment.DeletedAt.Valid {
+		return db.Comment{}, apperror.NotFound.WithCause(fmt.Errorf("comment %d is deleted", commentID))
+	}
+
+	return comment, nil
+}
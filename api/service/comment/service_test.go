@@ -0,0 +1,175 @@
+package commentservice
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/moderation"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestService(store db.Store) *Service {
+	events := servicetest.Events{10: {ID: 10, OwnerID: 1}}
+	return New(store, events, moderation.NewWordListModerator(moderation.DefaultWords, nil))
+}
+
+func TestListHiddenEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListEventComments(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).List(context.Background(), models.ListCommentsParams{EventID: 11, UserID: 2, Limit: 10})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListGroupsReplies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	now := time.Now()
+	store.EXPECT().
+		ListEventComments(gomock.Any(), db.ListEventCommentsParams{EventID: 10, PageLimit: 3}).
+		Return([]db.ListEventCommentsRow{
+			{ID: 1, EventID: 10, UserID: 2, Username: "anna", Body: "Во сколько сбор?", CreatedAt: now},
+			{ID: 2, EventID: 10, UserID: 3, Username: "oleg", DeletedAt: pgtype.Timestamptz{Time: now, Valid: true}, CreatedAt: now},
+			{ID: 3, EventID: 10, UserID: 4, Username: "ivan", Body: "Будет парковка?", CreatedAt: now},
+		}, nil)
+	store.EXPECT().
+		ListCommentReplies(gomock.Any(), []int32{1, 2}).
+		Return([]db.ListCommentRepliesRow{
+			{ID: 5, EventID: 10, ParentID: pgtype.Int4{Int32: 2, Valid: true}, UserID: 1, Username: "owner", Body: "Да", CreatedAt: now},
+			{ID: 4, EventID: 10, ParentID: pgtype.Int4{Int32: 1, Valid: true}, UserID: 1, Username: "owner", Body: "В 10", CreatedAt: now},
+		}, nil)
+
+	page, err := newTestService(store).List(context.Background(), models.ListCommentsParams{EventID: 10, UserID: 2, Limit: 2})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.NotEmpty(t, page.NextCursor)
+	require.Len(t, page.Comments, 2)
+
+	require.Len(t, page.Comments[0].Replies, 1)
+	require.Equal(t, int32(4), page.Comments[0].Replies[0].ID)
+
+	deleted := page.Comments[1]
+	require.True(t, deleted.IsDeleted)
+	require.Zero(t, deleted.UserID)
+	require.Empty(t, deleted.Username)
+	require.Len(t, deleted.Replies, 1)
+}
+
+func TestListFromCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	createdAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	cursor := common.EncodeCursor(listCursor{Pinned: true, CreatedAt: createdAt, ID: 7})
+
+	store.EXPECT().
+		ListEventComments(gomock.Any(), db.ListEventCommentsParams{
+			EventID:         10,
+			HasCursor:       true,
+			CursorPinned:    true,
+			CursorCreatedAt: createdAt,
+			CursorID:        7,
+			PageLimit:       3,
+		}).
+		Return([]db.ListEventCommentsRow{}, nil)
+
+	page, err := newTestService(store).List(context.Background(), models.ListCommentsParams{EventID: 10, UserID: 2, Cursor: cursor, Limit: 2})
+	require.NoError(t, err)
+	require.False(t, page.HasMore)
+	require.Empty(t, page.NextCursor)
+}
+
+func TestListInvalidCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListEventComments(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).List(context.Background(), models.ListCommentsParams{EventID: 10, UserID: 2, Cursor: "not a cursor!", Limit: 2})
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestCreateNestedReply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetComment(gomock.Any(), int32(4)).
+		Return(db.Comment{ID: 4, EventID: 10, ParentID: pgtype.Int4{Int32: 1, Valid: true}}, nil)
+	store.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).Create(context.Background(), models.CreateCommentParams{
+		EventID:  10,
+		UserID:   2,
+		ParentID: 4,
+		Body:     "Спасибо!",
+	})
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestCreateReply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetComment(gomock.Any(), int32(1)).
+		Return(db.Comment{ID: 1, EventID: 10}, nil)
+	store.EXPECT().
+		CreateComment(gomock.Any(), db.CreateCommentParams{
+			EventID:  10,
+			UserID:   2,
+			ParentID: pgtype.Int4{Int32: 1, Valid: true},
+			Body:     "Спасибо!",
+		}).
+		Return(db.Comment{ID: 6, EventID: 10, UserID: 2, ParentID: pgtype.Int4{Int32: 1, Valid: true}, Body: "Спасибо!"}, nil)
+
+	comment, err := newTestService(store).Create(context.Background(), models.CreateCommentParams{
+		EventID:  10,
+		UserID:   2,
+		ParentID: 1,
+		Body:     "Спасибо!",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), comment.ParentID)
+}
+
+func TestUpdateNotAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetComment(gomock.Any(), int32(1)).Return(db.Comment{ID: 1, EventID: 10, UserID: 2}, nil)
+	store.EXPECT().UpdateCommentBody(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).Update(context.Background(), models.UpdateCommentParams{CommentID: 1, UserID: 3, Body: "edit"})
+
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestDeleteByEventOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetComment(gomock.Any(), int32(1)).Return(db.Comment{ID: 1, EventID: 10, UserID: 2}, nil)
+	store.EXPECT().SoftDeleteComment(gomock.Any(), int32(1)).Return(nil)
+
+	require.NoError(t, newTestService(store).Delete(context.Background(), 1, 1))
+}
+
+func TestPinNotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetComment(gomock.Any(), int32(1)).Return(db.Comment{ID: 1, EventID: 10, UserID: 2}, nil)
+	store.EXPECT().SetCommentPinned(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).SetPinned(context.Background(), 1, 2, true)
+
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
@@ -0,0 +1,49 @@
+package eventservice
+
+import (
+	"context"
+	"math"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+const (
+	mapTileSize       = 256
+	clusterCellPixels = 64
+	clusterSampleSize = 5
+)
+
+func (s *Service) ListClusters(ctx context.Context, params models.ClusterParams) ([]models.EventCluster, error) {
+	rows, err := s.store.ListEventClusters(ctx, db.ListEventClustersParams{
+		MinLat:     params.Bounds.MinLat,
+		MinLon:     params.Bounds.MinLon,
+		MaxLat:     params.Bounds.MaxLat,
+		MaxLon:     params.Bounds.MaxLon,
+		SearchTerm: params.Search,
+		TagIds:     params.Bounds.TagIDs,
+		DateRange:  params.Bounds.DateRange,
+		SampleSize: clusterSampleSize,
+		CellSize:   clusterCellSize(params.Zoom),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.EventCluster, len(rows))
+	for i, row := range rows {
+		result[i] = models.EventCluster{
+			Count:     int(row.Count),
+			Latitude:  row.Latitude,
+			Longitude: row.Longitude,
+			EventIDs:  row.EventIds,
+		}
+	}
+
+	return result, nil
+}
+
+// clusterCellSize returns the grid cell size in degrees that covers
+// clusterCellPixels on a web map tile at the given zoom level.
+func clusterCellSize(zoom int) float64 {
+	return 360.0 / mapTileSize * clusterCellPixels / math.Exp2(float64(zoom))
+}
@@ -0,0 +1,13 @@
+package eventservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterCellSize(t *testing.T) {
+	require.Equal(t, 90.0, clusterCellSize(0))
+	require.Equal(t, clusterCellSize(10)/2, clusterCellSize(11))
+	require.Less(t, clusterCellSize(20), 0.0001)
+}
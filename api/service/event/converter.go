@@ -2,11 +2,16 @@ package eventservice
 
 import (
 	"github.com/jackc/pgx/v5/pgtype"
+	"html"
+	"slices"
+	"strings"
 	"treffly/api/models"
 	db "treffly/db/sqlc"
 	"treffly/util"
 )
 
+var highlightReplacer = strings.NewReplacer("\x02", "<mark>", "\x03", "</mark>")
+
 func convertTags(dbTags []db.Tag) []models.Tag {
 	tags := make([]models.Tag, len(dbTags))
 	for i, t := range dbTags {
@@ -22,23 +27,26 @@ func ConvertGetEventRow(e db.GetEventRow, isOwner, isParticipant bool) models.Ev
 	lat, _ := util.NumericToFloat64(e.Latitude)
 	lon, _ := util.NumericToFloat64(e.Longitude)
 	base := models.Event{
-		ID:             e.ID,
-		Name:           e.Name,
-		Description:    e.Description,
-		Capacity:       e.Capacity,
-		Latitude:       lat,
-		Longitude:      lon,
-		Address:        e.Address,
-		Date:           e.Date,
-		OwnerUsername:  safeString(e.OwnerUsername),
-		Tags:           convertTags(e.Tags),
-		IsPrivate:      e.IsPrivate,
-		IsPremium:      e.IsPremium,
-		CreatedAt:      e.CreatedAt,
-		IsOwner:        isOwner,
-		IsParticipant:  isParticipant,
-		ImagePath:      safeString(e.EventImagePath),
-		OwnerImagePath: safeString(e.UserImagePath),
+		ID:               e.ID,
+		Name:             e.Name,
+		Description:      e.Description,
+		Capacity:         e.Capacity,
+		Latitude:         lat,
+		Longitude:        lon,
+		Address:          e.Address,
+		Date:             e.Date,
+		OwnerID:          e.OwnerID,
+		OwnerUsername:    safeString(e.OwnerUsername),
+		Tags:             convertTags(e.Tags),
+		IsPrivate:        e.IsPrivate,
+		IsPremium:        e.IsPremium,
+		CreatedAt:        e.CreatedAt,
+		IsOwner:          isOwner,
+		IsParticipant:    isParticipant,
+		ParticipantCount: int(e.ParticipantsCount),
+		SeriesID:         e.SeriesID.Int32,
+		ImagePath:        safeString(e.EventImagePath),
+		OwnerImagePath:   safeString(e.UserImagePath),
 	}
 
 	return base
@@ -56,6 +64,7 @@ func ConvertListEventsRow(e db.ListEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -65,11 +74,19 @@ func ConvertListEventsRow(e db.ListEventsRow) models.Event {
 		IsParticipant:  false,
 		ImagePath:      safeString(e.EventImagePath),
 		OwnerImagePath: safeString(e.UserImagePath),
+		NameHighlight:  highlight(e.NameHighlight),
+		Snippet:        highlight(e.Snippet),
 	}
 
 	return base
 }
 
+// highlight escapes a ts_headline fragment and turns the STX/ETX match
+// delimiters emitted by ListEvents into <mark> tags.
+func highlight(s string) string {
+	return highlightReplacer.Replace(html.EscapeString(s))
+}
+
 func safeString(s pgtype.Text) string {
 	if s.Valid {
 		return s.String
@@ -126,6 +143,7 @@ func convertUserRecommended(e db.GetUserRecommendedEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -150,6 +168,7 @@ func convertGuestRecommended(e db.GetGuestRecommendedEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -184,6 +203,12 @@ func convertEventType[T any](rows []T) []models.Event {
 			result[i] = convertPastEventsRow(v)
 		case db.GetOwnedUserEventsRow:
 			result[i] = convertOwnedEventsRow(v)
+		case db.GetFollowingFeedEventsRow:
+			result[i] = convertFollowingFeedRow(v)
+		case db.ListProfileUpcomingEventsRow:
+			result[i] = convertProfileUpcomingRow(v)
+		case db.ListProfileAttendedEventsRow:
+			result[i] = convertProfileAttendedRow(v)
 		}
 	}
 	return result
@@ -201,6 +226,7 @@ func convertOwnedEventsRow(e db.GetOwnedUserEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -225,6 +251,7 @@ func convertPastEventsRow(e db.GetPastUserEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -249,6 +276,7 @@ func convertUpcomingEventsRow(e db.GetUpcomingUserEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -261,6 +289,94 @@ func convertUpcomingEventsRow(e db.GetUpcomingUserEventsRow) models.Event {
 	return base
 }
 
+func convertFollowingFeedRow(e db.GetFollowingFeedEventsRow) models.Event {
+	lat, _ := util.NumericToFloat64(e.Latitude)
+	lon, _ := util.NumericToFloat64(e.Longitude)
+	base := models.Event{
+		ID:               e.ID,
+		Name:             e.Name,
+		Description:      e.Description,
+		Capacity:         e.Capacity,
+		Latitude:         lat,
+		Longitude:        lon,
+		Address:          e.Address,
+		Date:             e.Date,
+		OwnerID:          e.OwnerID,
+		OwnerUsername:    safeString(e.OwnerUsername),
+		Tags:             convertTags(e.Tags),
+		IsPrivate:        e.IsPrivate,
+		IsPremium:        e.IsPremium,
+		CreatedAt:        e.CreatedAt,
+		ImagePath:        safeString(e.EventImagePath),
+		OwnerImagePath:   safeString(e.UserImagePath),
+		ParticipantCount: int(e.ParticipantsCount),
+	}
+
+	return base
+}
+
+func convertProfileUpcomingRow(e db.ListProfileUpcomingEventsRow) models.Event {
+	lat, _ := util.NumericToFloat64(e.Latitude)
+	lon, _ := util.NumericToFloat64(e.Longitude)
+	base := models.Event{
+		ID:             e.ID,
+		Name:           e.Name,
+		Description:    e.Description,
+		Capacity:       e.Capacity,
+		Latitude:       lat,
+		Longitude:      lon,
+		Address:        e.Address,
+		Date:           e.Date,
+		OwnerID:        e.OwnerID,
+		OwnerUsername:  safeString(e.OwnerUsername),
+		Tags:           convertTags(e.Tags),
+		IsPrivate:      e.IsPrivate,
+		IsPremium:      e.IsPremium,
+		CreatedAt:      e.CreatedAt,
+		ImagePath:      safeString(e.EventImagePath),
+		OwnerImagePath: safeString(e.UserImagePath),
+	}
+
+	return base
+}
+
+func convertProfileAttendedRow(e db.ListProfileAttendedEventsRow) models.Event {
+	lat, _ := util.NumericToFloat64(e.Latitude)
+	lon, _ := util.NumericToFloat64(e.Longitude)
+	base := models.Event{
+		ID:             e.ID,
+		Name:           e.Name,
+		Description:    e.Description,
+		Capacity:       e.Capacity,
+		Latitude:       lat,
+		Longitude:      lon,
+		Address:        e.Address,
+		Date:           e.Date,
+		OwnerID:        e.OwnerID,
+		OwnerUsername:  safeString(e.OwnerUsername),
+		Tags:           convertTags(e.Tags),
+		IsPrivate:      e.IsPrivate,
+		IsPremium:      e.IsPremium,
+		CreatedAt:      e.CreatedAt,
+		ImagePath:      safeString(e.EventImagePath),
+		OwnerImagePath: safeString(e.UserImagePath),
+	}
+
+	return base
+}
+
+// excludeFollowed drops recommendations from followed organisers: their
+// events are already paged through in date order.
+func excludeFollowed(rows []db.GetUserRecommendedEventsRow, followed []int32) []db.GetUserRecommendedEventsRow {
+	result := make([]db.GetUserRecommendedEventsRow, 0, len(rows))
+	for _, row := range rows {
+		if !slices.Contains(followed, row.OwnerID) {
+			result = append(result, row)
+		}
+	}
+	return result
+}
+
 func convertPremiumEvent(e db.GetPremiumEventsRow) models.Event {
 	lat, _ := util.NumericToFloat64(e.Latitude)
 	lon, _ := util.NumericToFloat64(e.Longitude)
@@ -273,6 +389,7 @@ func convertPremiumEvent(e db.GetPremiumEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -297,6 +414,7 @@ func convertLatestEvent(e db.GetLatestEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -321,6 +439,7 @@ func convertPopularEvent(e db.GetPopularEventsRow) models.Event {
 		Longitude:      lon,
 		Address:        e.Address,
 		Date:           e.Date,
+		OwnerID:        e.OwnerID,
 		OwnerUsername:  safeString(e.OwnerUsername),
 		Tags:           convertTags(e.Tags),
 		IsPrivate:      e.IsPrivate,
@@ -332,3 +451,20 @@ func convertPopularEvent(e db.GetPopularEventsRow) models.Event {
 
 	return base
 }
+
+func convertMarkers(rows []db.ListEventMarkersRow) []models.EventMarker {
+	result := make([]models.EventMarker, len(rows))
+	for i, row := range rows {
+		lat, _ := util.NumericToFloat64(row.Latitude)
+		lon, _ := util.NumericToFloat64(row.Longitude)
+		result[i] = models.EventMarker{
+			ID:        row.ID,
+			Name:      row.Name,
+			Latitude:  lat,
+			Longitude: lon,
+			Date:      row.Date,
+			IsPremium: row.IsPremium,
+		}
+	}
+	return result
+}
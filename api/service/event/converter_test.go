@@ -0,0 +1,13 @@
+package eventservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlight(t *testing.T) {
+	require.Equal(t, "", highlight(""))
+	require.Equal(t, "Летние <mark>концерты</mark> в парке", highlight("Летние \x02концерты\x03 в парке"))
+	require.Equal(t, "&lt;script&gt; <mark>концерт</mark>", highlight("<script> \x02концерт\x03"))
+}
@@ -0,0 +1,34 @@
+package eventservice
+
+import (
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+)
+
+type listCursor struct {
+	Relevance   float64   `json:"r"`
+	MatchedTags int64     `json:"m"`
+	CreatedAt   time.Time `json:"c"`
+	Distance    float64   `json:"d"`
+	ID          int32     `json:"i"`
+}
+
+type dateCursor struct {
+	Date time.Time `json:"t"`
+	ID   int32     `json:"i"`
+}
+
+func newPage[T any](rows []T, limit int32, cursor func(T) any) models.EventsPage {
+	page := models.EventsPage{}
+
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		page.HasMore = true
+		page.NextCursor = common.EncodeCursor(cursor(rows[len(rows)-1]))
+	}
+
+	page.Events = convertEventType(rows)
+
+	return page
+}
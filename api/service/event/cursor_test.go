@@ -0,0 +1,57 @@
+package eventservice
+
+import (
+	"testing"
+	"time"
+	"treffly/api/common"
+	db "treffly/db/sqlc"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	c1 := listCursor{
+		Relevance:   0.41666666,
+		MatchedTags: 2,
+		CreatedAt:   time.Date(2026, 3, 2, 9, 30, 0, 123456000, time.UTC),
+		Distance:    1234.5678901234,
+		ID:          42,
+	}
+
+	encoded := common.EncodeCursor(c1)
+	require.NotContains(t, encoded, "=")
+
+	var c2 listCursor
+	require.NoError(t, common.DecodeCursor(encoded, &c2))
+	require.Equal(t, c1.Relevance, c2.Relevance)
+	require.Equal(t, c1.MatchedTags, c2.MatchedTags)
+	require.True(t, c1.CreatedAt.Equal(c2.CreatedAt))
+	require.Equal(t, c1.Distance, c2.Distance)
+	require.Equal(t, c1.ID, c2.ID)
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	var c dateCursor
+	require.Error(t, common.DecodeCursor("not a cursor!", &c))
+	require.Error(t, common.DecodeCursor("bm90IGpzb24", &c))
+}
+
+func TestNewPage(t *testing.T) {
+	rows := []db.GetUpcomingUserEventsRow{{ID: 1}, {ID: 2}, {ID: 3}}
+	cursor := func(row db.GetUpcomingUserEventsRow) any {
+		return dateCursor{Date: row.Date, ID: row.ID}
+	}
+
+	page := newPage(rows, 2, cursor)
+	require.True(t, page.HasMore)
+	require.Len(t, page.Events, 2)
+
+	var next dateCursor
+	require.NoError(t, common.DecodeCursor(page.NextCursor, &next))
+	require.Equal(t, int32(2), next.ID)
+
+	page = newPage(rows, 3, cursor)
+	require.False(t, page.HasMore)
+	require.Empty(t, page.NextCursor)
+	require.Len(t, page.Events, 3)
+}
@@ -5,22 +5,41 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"math"
+	"time"
+	"treffly/api/common"
 	"treffly/api/models"
 	"treffly/apperror"
 	db "treffly/db/sqlc"
+	"treffly/moderation"
+	"treffly/recurrence"
 	"treffly/util"
 )
 
+type notifier interface {
+	ParticipantJoined(ctx context.Context, ownerID, eventID, participantID int32)
+}
+
 type Service struct {
-	store  db.Store
-	config util.Config
+	store     db.Store
+	moderator moderation.Moderator
+	notifier  notifier
+	config    util.Config
 }
 
-func New(store db.Store, config util.Config) *Service {
-	return &Service{store: store, config: config}
+func New(store db.Store, moderator moderation.Moderator, notifier notifier, config util.Config) *Service {
+	return &Service{store: store, moderator: moderator, notifier: notifier, config: config}
 }
 
 func (s *Service) Create(ctx context.Context, params models.CreateParams) (models.Event, error) {
+	err := common.Moderate(ctx, s.moderator,
+		moderation.Field{Name: "name", Text: params.Name},
+		moderation.Field{Name: "description", Text: params.Description},
+	)
+	if err != nil {
+		return models.Event{}, err
+	}
+
 	eventArg := db.CreateEventTxParams{
 		Name:        params.Name,
 		Description: params.Description,
@@ -40,6 +59,10 @@ func (s *Service) Create(ctx context.Context, params models.CreateParams) (model
 		Path: params.Path,
 	}
 
+	if params.Recurrence != "" {
+		return s.createSeries(ctx, params.Recurrence, eventArg, imageArg)
+	}
+
 	event, err := s.store.CreateEventTx(ctx, eventArg, imageArg)
 	if err != nil {
 		return models.Event{}, err
@@ -50,23 +73,95 @@ func (s *Service) Create(ctx context.Context, params models.CreateParams) (model
 	return resp, nil
 }
 
-func (s *Service) List(ctx context.Context, params models.ListParams) ([]models.Event, error) {
+func (s *Service) createSeries(ctx context.Context, rrule string, eventArg db.CreateEventTxParams, imageArg db.CreateImageParams) (models.Event, error) {
+	rule, err := recurrence.Parse(rrule)
+	if err != nil {
+		return models.Event{}, apperror.BadRequest.WithCause(err)
+	}
+
+	dates, err := rule.Occurrences(eventArg.Date)
+	if err != nil {
+		return models.Event{}, apperror.BadRequest.WithCause(err)
+	}
+
+	arg := db.CreateEventSeriesTxParams{
+		Event: eventArg,
+		Rrule: rule.String(),
+		Dates: dates,
+	}
+
+	event, err := s.store.CreateEventSeriesTx(ctx, arg, imageArg)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	return ConvertGetEventRow(event, true, false), nil
+}
+
+func (s *Service) List(ctx context.Context, params models.ListParams) (models.EventsPage, error) {
+	limit := s.pageLimit(params.Limit)
+
 	arg := db.ListEventsParams{
 		UserLat:    params.Lat,
 		UserLon:    params.Lon,
 		SearchTerm: params.Search,
 		TagIds:     params.TagIDs,
 		DateRange:  params.DateRange,
+		Radius:     s.searchRadius(params.Radius),
+		PageLimit:  limit + 1,
+	}
+
+	if params.Cursor != "" {
+		var cursor listCursor
+		if err := common.DecodeCursor(params.Cursor, &cursor); err != nil {
+			return models.EventsPage{}, apperror.BadRequest.WithCause(err)
+		}
+
+		arg.HasCursor = true
+		arg.CursorRelevance = cursor.Relevance
+		arg.CursorMatchedTags = cursor.MatchedTags
+		arg.CursorCreatedAt = cursor.CreatedAt
+		arg.CursorDistance = cursor.Distance
+		arg.CursorID = cursor.ID
 	}
 
 	rows, err := s.store.ListEvents(ctx, arg)
 	if err != nil {
-		return nil, err
+		return models.EventsPage{}, err
 	}
 
-	result := convertEventType(rows)
+	page := newPage(rows, limit, func(row db.ListEventsRow) any {
+		return listCursor{
+			Relevance:   row.Relevance,
+			MatchedTags: row.MatchedTags,
+			CreatedAt:   row.CreatedAt,
+			Distance:    row.Distance,
+			ID:          row.ID,
+		}
+	})
+
+	if err := s.attachOrganizerRatings(ctx, page.Events); err != nil {
+		return models.EventsPage{}, err
+	}
+
+	return page, nil
+}
+
+func (s *Service) ListMarkers(ctx context.Context, params models.BoundsParams) ([]models.EventMarker, error) {
+	rows, err := s.store.ListEventMarkers(ctx, db.ListEventMarkersParams{
+		MinLat:      params.MinLat,
+		MinLon:      params.MinLon,
+		MaxLat:      params.MaxLat,
+		MaxLon:      params.MaxLon,
+		TagIds:      params.TagIDs,
+		DateRange:   params.DateRange,
+		MarkerLimit: int32(s.config.EventsMaxMarkers),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return result, nil
+	return convertMarkers(rows), nil
 }
 
 func (s *Service) Update(ctx context.Context, params models.UpdateParams) (models.Event, error) {
@@ -84,6 +179,14 @@ func (s *Service) Update(ctx context.Context, params models.UpdateParams) (model
 		return models.Event{}, err
 	}
 
+	err = common.Moderate(ctx, s.moderator,
+		moderation.Field{Name: "name", Text: params.Name},
+		moderation.Field{Name: "description", Text: params.Description},
+	)
+	if err != nil {
+		return models.Event{}, err
+	}
+
 	imageID := params.NewImageID
 	path := params.Path
 	if params.DeleteImage {
@@ -96,19 +199,20 @@ func (s *Service) Update(ctx context.Context, params models.UpdateParams) (model
 	}
 
 	arg := db.UpdateEventTxParams{
-		EventID:     params.EventID,
-		Name:        params.Name,
-		Description: params.Description,
-		Capacity:    params.Capacity,
-		Latitude:    params.Latitude,
-		Longitude:   params.Longitude,
-		Address:     params.Address,
-		Date:        params.Date,
-		IsPrivate:   params.IsPrivate,
-		Tags:        params.Tags,
-		NewImageID:  imageID,
-		NewPath:     path,
-		OldImageID:  params.OldImageID,
+		EventID:          params.EventID,
+		Name:             params.Name,
+		Description:      params.Description,
+		Capacity:         params.Capacity,
+		Latitude:         params.Latitude,
+		Longitude:        params.Longitude,
+		Address:          params.Address,
+		Date:             params.Date,
+		IsPrivate:        params.IsPrivate,
+		Tags:             params.Tags,
+		NewImageID:       imageID,
+		NewPath:          path,
+		OldImageID:       params.OldImageID,
+		ApplyToFollowing: params.Following,
 	}
 
 	err = s.store.UpdateEventTx(ctx, arg)
@@ -138,7 +242,7 @@ func (s *Service) Delete(ctx context.Context, params models.DeleteParams) error
 		return apperror.Forbidden.WithCause(err)
 	}
 
-	return s.store.DeleteEvent(ctx, params.EventID)
+	return s.store.DeleteEventTx(ctx, params.EventID)
 }
 
 func (s *Service) GetHomeForUser(ctx context.Context, params models.GetHomeParams) (models.HomeEvents, error) {
@@ -160,6 +264,10 @@ func (s *Service) GetHomeForUser(ctx context.Context, params models.GetHomeParam
 
 	resp := ConvertHomeEvents(premium, recommended, latest, popular)
 
+	if err := s.attachOrganizerRatings(ctx, resp.Premium, resp.Recommended, resp.Latest, resp.Popular); err != nil {
+		return models.HomeEvents{}, err
+	}
+
 	return resp, nil
 }
 
@@ -181,6 +289,10 @@ func (s *Service) GetHomeForGuest(ctx context.Context, params models.GetHomePara
 
 	resp := ConvertHomeEvents(premium, recommended, latest, popular)
 
+	if err := s.attachOrganizerRatings(ctx, resp.Premium, resp.Recommended, resp.Latest, resp.Popular); err != nil {
+		return models.HomeEvents{}, err
+	}
+
 	return resp, nil
 }
 
@@ -276,17 +388,31 @@ func (s *Service) Subscribe(ctx context.Context, params models.SubscriptionParam
 	}
 
 	if event.OwnerID == params.UserID {
-		return models.Event{}, fmt.Errorf("user is owner")
+		return models.Event{}, apperror.BadRequest.WithCause(fmt.Errorf("user is owner"))
+	}
+
+	if !event.Date.After(time.Now()) {
+		return models.Event{}, apperror.BadRequest.WithCause(fmt.Errorf("event has already taken place"))
+	}
+
+	if err := s.checkNotBanned(ctx, params.EventID, params.UserID); err != nil {
+		return models.Event{}, err
 	}
 
-	allowed, err := s.store.SubscribeToEvent(ctx, arg)
+	if event.ParticipantsCount >= int64(event.Capacity) {
+		return models.Event{}, apperror.EventFull.WithCause(fmt.Errorf("event is full"))
+	}
+
+	allowed, err := s.store.SubscribeToEventTx(ctx, arg)
 	if err != nil {
 		return models.Event{}, err
 	}
 	if allowed.Valid && !allowed.Bool {
-		return models.Event{}, fmt.Errorf("event is full")
+		return models.Event{}, apperror.EventFull.WithCause(fmt.Errorf("event is full"))
 	}
 
+	s.notifier.ParticipantJoined(ctx, event.OwnerID, params.EventID, params.UserID)
+
 	return s.GetEvent(ctx, params.EventID, params.UserID, params.Token)
 }
 
@@ -301,13 +427,85 @@ func (s *Service) Unsubscribe(ctx context.Context, params models.SubscriptionPar
 		return models.Event{}, err
 	}
 
-	if err := s.store.UnsubscribeFromEvent(ctx, arg); err != nil {
+	if _, err := s.store.UnsubscribeFromEventTx(ctx, arg); err != nil {
+		if errors.Is(err, db.ErrNotParticipant) {
+			return models.Event{}, apperror.NotFound.WithCause(err)
+		}
 		return models.Event{}, err
 	}
 
 	return event, err
 }
 
+func (s *Service) JoinWaitlist(ctx context.Context, params models.SubscriptionParams) (models.Event, error) {
+	event, err := s.GetEvent(ctx, params.EventID, params.UserID, params.Token)
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if event.IsOwner {
+		return models.Event{}, apperror.BadRequest.WithCause(fmt.Errorf("user is owner"))
+	}
+
+	if event.IsParticipant {
+		return models.Event{}, apperror.BadRequest.WithCause(fmt.Errorf("user is already a participant"))
+	}
+
+	if !event.Date.After(time.Now()) {
+		return models.Event{}, apperror.BadRequest.WithCause(fmt.Errorf("event has already taken place"))
+	}
+
+	if int32(event.ParticipantCount) < event.Capacity {
+		return models.Event{}, apperror.BadRequest.WithCause(fmt.Errorf("event has free seats"))
+	}
+
+	if err := s.checkNotBanned(ctx, params.EventID, params.UserID); err != nil {
+		return models.Event{}, err
+	}
+
+	arg := db.JoinEventWaitlistParams{
+		EventID: params.EventID,
+		UserID:  params.UserID,
+	}
+
+	if _, err := s.store.JoinEventWaitlistTx(ctx, arg); err != nil {
+		return models.Event{}, err
+	}
+
+	return s.GetEvent(ctx, params.EventID, params.UserID, params.Token)
+}
+
+// checkNotBanned rejects users the organiser banned, whatever invite link
+// they hold. SubscribeToEvent checks the ban again inside the insert.
+func (s *Service) checkNotBanned(ctx context.Context, eventID, userID int32) error {
+	banned, err := s.store.IsBannedFromEvent(ctx, db.IsBannedFromEventParams{
+		EventID: eventID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if banned {
+		return apperror.Forbidden.WithCause(fmt.Errorf("user is banned from the event"))
+	}
+
+	return nil
+}
+
+func (s *Service) LeaveWaitlist(ctx context.Context, params models.SubscriptionParams) (models.Event, error) {
+	arg := db.LeaveEventWaitlistParams{
+		EventID: params.EventID,
+		UserID:  params.UserID,
+	}
+
+	if err := s.store.LeaveEventWaitlist(ctx, arg); err != nil {
+		return models.Event{}, err
+	}
+
+	return s.GetEvent(ctx, params.EventID, params.UserID, params.Token)
+}
+
 func (s *Service) GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error) {
 	getArg := db.GetEventParams{
 		ID: eventID,
@@ -329,42 +527,286 @@ func (s *Service) GetEvent(ctx context.Context, eventID, userID int32, token str
 		return models.Event{}, err
 	}
 
+	waitlistArg := db.GetWaitlistStatusParams{
+		EventID: eventID,
+		UserID:  userID,
+	}
+
+	waitlist, err := s.store.GetWaitlistStatus(ctx, waitlistArg)
+	if err != nil {
+		return models.Event{}, err
+	}
+
 	isOwner := event.OwnerID == userID
 
 	resp := ConvertGetEventRow(event, isOwner, isParticipant)
+	resp.WaitlistCount = int(waitlist.WaitlistCount)
+	resp.WaitlistPosition = int(waitlist.Position)
 
 	return resp, nil
 }
 
-func (s *Service) GetUpcomingUserEvents(ctx context.Context, userID int32) ([]models.Event, error) {
-	rows, err := s.store.GetUpcomingUserEvents(ctx, userID)
+// RequireOwner loads the event as userID sees it and refuses anyone but its
+// owner.
+func (s *Service) RequireOwner(ctx context.Context, eventID, userID int32) error {
+	event, err := s.GetEvent(ctx, eventID, userID, "")
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	resp := convertEventType(rows)
+	if !event.IsOwner {
+		return apperror.Forbidden.WithCause(errors.New("only the event owner can do this"))
+	}
 
-	return resp, nil
+	return nil
 }
 
-func (s *Service) GetPastUserEvents(ctx context.Context, userID int32) ([]models.Event, error) {
-	rows, err := s.store.GetPastUserEvents(ctx, userID)
+func (s *Service) GetUpcomingUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error) {
+	cursor, hasCursor, err := parseDateCursor(params.Cursor)
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	limit := s.pageLimit(params.Limit)
+
+	rows, err := s.store.GetUpcomingUserEvents(ctx, db.GetUpcomingUserEventsParams{
+		UserID:     params.UserID,
+		HasCursor:  hasCursor,
+		CursorDate: cursor.Date,
+		CursorID:   cursor.ID,
+		PageLimit:  limit + 1,
+	})
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	page := newPage(rows, limit, func(row db.GetUpcomingUserEventsRow) any {
+		return dateCursor{Date: row.Date, ID: row.ID}
+	})
+
+	if err := s.attachOrganizerRatings(ctx, page.Events); err != nil {
+		return models.EventsPage{}, err
+	}
+
+	return page, nil
+}
+
+func (s *Service) GetPastUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error) {
+	cursor, hasCursor, err := parseDateCursor(params.Cursor)
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	limit := s.pageLimit(params.Limit)
+
+	rows, err := s.store.GetPastUserEvents(ctx, db.GetPastUserEventsParams{
+		UserID:     params.UserID,
+		HasCursor:  hasCursor,
+		CursorDate: cursor.Date,
+		CursorID:   cursor.ID,
+		PageLimit:  limit + 1,
+	})
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	page := newPage(rows, limit, func(row db.GetPastUserEventsRow) any {
+		return dateCursor{Date: row.Date, ID: row.ID}
+	})
+
+	if err := s.attachOrganizerRatings(ctx, page.Events); err != nil {
+		return models.EventsPage{}, err
+	}
+
+	return page, nil
+}
+
+func (s *Service) GetOwnedUserEvents(ctx context.Context, params models.UserEventsParams) (models.EventsPage, error) {
+	cursor, hasCursor, err := parseDateCursor(params.Cursor)
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	limit := s.pageLimit(params.Limit)
+
+	rows, err := s.store.GetOwnedUserEvents(ctx, db.GetOwnedUserEventsParams{
+		UserID:     params.UserID,
+		HasCursor:  hasCursor,
+		CursorDate: cursor.Date,
+		CursorID:   cursor.ID,
+		PageLimit:  limit + 1,
+	})
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	page := newPage(rows, limit, func(row db.GetOwnedUserEventsRow) any {
+		return dateCursor{Date: row.Date, ID: row.ID}
+	})
+
+	if err := s.attachOrganizerRatings(ctx, page.Events); err != nil {
+		return models.EventsPage{}, err
+	}
+
+	return page, nil
+}
+
+func (s *Service) GetProfileUpcomingEvents(ctx context.Context, ownerID, limit int32) ([]models.Event, error) {
+	rows, err := s.store.ListProfileUpcomingEvents(ctx, db.ListProfileUpcomingEventsParams{
+		OwnerID: ownerID,
+		Lim:     limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resp := convertEventType(rows)
+	events := convertEventType(rows)
+	if err := s.attachOrganizerRatings(ctx, events); err != nil {
+		return nil, err
+	}
 
-	return resp, nil
+	return events, nil
 }
 
-func (s *Service) GetOwnedUserEvents(ctx context.Context, userID int32) ([]models.Event, error) {
-	rows, err := s.store.GetOwnedUserEvents(ctx, userID)
+func (s *Service) GetProfileAttendedEvents(ctx context.Context, userID, limit int32) ([]models.Event, error) {
+	rows, err := s.store.ListProfileAttendedEvents(ctx, db.ListProfileAttendedEventsParams{
+		UserID: userID,
+		Lim:    limit,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resp := convertEventType(rows)
+	events := convertEventType(rows)
+	if err := s.attachOrganizerRatings(ctx, events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
 
-	return resp, nil
+// GetFeed pages through upcoming events of the organisers the user follows.
+// The first page is topped up with tag-based recommendations from other
+// organisers, so the feed is not empty before the user follows anyone.
+func (s *Service) GetFeed(ctx context.Context, params models.FeedParams) (models.EventsPage, error) {
+	cursor, hasCursor, err := parseDateCursor(params.Cursor)
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	limit := s.pageLimit(params.Limit)
+
+	rows, err := s.store.GetFollowingFeedEvents(ctx, db.GetFollowingFeedEventsParams{
+		FollowerID: params.UserID,
+		HasCursor:  hasCursor,
+		CursorDate: cursor.Date,
+		CursorID:   cursor.ID,
+		PageLimit:  limit + 1,
+	})
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	page := newPage(rows, limit, func(row db.GetFollowingFeedEventsRow) any {
+		return dateCursor{Date: row.Date, ID: row.ID}
+	})
+
+	// Recommendations follow the followed events, so they only come with the
+	// page that exhausts them.
+	if page.HasMore {
+		if err := s.attachOrganizerRatings(ctx, page.Events); err != nil {
+			return models.EventsPage{}, err
+		}
+		return page, nil
+	}
+
+	followed, err := s.store.ListFollowedIDs(ctx, params.UserID)
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	recommended, err := s.store.GetUserRecommendedEvents(ctx, db.GetUserRecommendedEventsParams{
+		UserID:  params.UserID,
+		UserLat: params.Lat,
+		UserLon: params.Lon,
+	})
+	if err != nil {
+		return models.EventsPage{}, err
+	}
+
+	page.Events = append(page.Events, convertRecommendedEvents(excludeFollowed(recommended, followed))...)
+
+	if err := s.attachOrganizerRatings(ctx, page.Events); err != nil {
+		return models.EventsPage{}, err
+	}
+
+	return page, nil
+}
+
+// attachOrganizerRatings fills in the organiser's rating on every event with a
+// single query for all owners on the page.
+func (s *Service) attachOrganizerRatings(ctx context.Context, lists ...[]models.Event) error {
+	var ownerIDs []int32
+	seen := make(map[int32]struct{})
+	for _, events := range lists {
+		for _, e := range events {
+			if _, ok := seen[e.OwnerID]; !ok {
+				seen[e.OwnerID] = struct{}{}
+				ownerIDs = append(ownerIDs, e.OwnerID)
+			}
+		}
+	}
+	if len(ownerIDs) == 0 {
+		return nil
+	}
+
+	rows, err := s.store.ListOrganizerRatings(ctx, ownerIDs)
+	if err != nil {
+		return err
+	}
+
+	ratings := make(map[int32]db.ListOrganizerRatingsRow, len(rows))
+	for _, r := range rows {
+		ratings[r.OwnerID] = r
+	}
+
+	for _, events := range lists {
+		for i := range events {
+			if r, ok := ratings[events[i].OwnerID]; ok {
+				events[i].OwnerRating = math.Round(r.Average*10) / 10
+				events[i].OwnerReviewsCount = int(r.ReviewsCount)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) searchRadius(requested float64) float64 {
+	if requested <= 0 {
+		return s.config.EventsDefaultRadius
+	}
+
+	return min(requested, s.config.EventsMaxRadius)
+}
+
+func (s *Service) pageLimit(requested int32) int32 {
+	if requested <= 0 {
+		return int32(s.config.EventsPageSize)
+	}
+
+	return min(requested, int32(s.config.EventsMaxPageSize))
+}
+
+func parseDateCursor(s string) (dateCursor, bool, error) {
+	var cursor dateCursor
+	if s == "" {
+		return cursor, false, nil
+	}
+
+	if err := common.DecodeCursor(s, &cursor); err != nil {
+		return cursor, false, apperror.BadRequest.WithCause(err)
+	}
+
+	return cursor, true, nil
 }
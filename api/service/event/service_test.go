@@ -0,0 +1,252 @@
+package eventservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/moderation"
+	"treffly/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type stubModerator struct {
+	err error
+}
+
+func (m stubModerator) Check(context.Context, string) error {
+	return m.err
+}
+
+type stubNotifier struct{}
+
+func (stubNotifier) ParticipantJoined(context.Context, int32, int32, int32) {}
+
+func TestCreateRejectsDescription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().CreateEventTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	service := New(store, moderation.NewWordListModerator(moderation.DefaultWords, nil), stubNotifier{}, util.Config{})
+
+	_, err := service.Create(context.Background(), models.CreateParams{
+		Name:        "Пикник",
+		Description: "будет пиздато",
+	})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.ContentRejected.HTTPCode, appErr.HTTPCode)
+	require.Equal(t, "description", appErr.Field)
+}
+
+func TestCreateModeratorUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().CreateEventTx(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	service := New(store, stubModerator{err: errors.New("timeout")}, stubNotifier{}, util.Config{})
+
+	_, err := service.Create(context.Background(), models.CreateParams{
+		Name:        "Пикник",
+		Description: "будет пиздато",
+	})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.ContentRejected.HTTPCode, appErr.HTTPCode)
+	require.Equal(t, "description", appErr.Field)
+}
+
+func TestGetFeedMergesRecommended(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetFollowingFeedEvents(gomock.Any(), gomock.Any()).
+		Return([]db.GetFollowingFeedEventsRow{{ID: 1, OwnerID: 7}}, nil)
+	store.EXPECT().ListFollowedIDs(gomock.Any(), int32(2)).Return([]int32{7}, nil)
+	store.EXPECT().
+		GetUserRecommendedEvents(gomock.Any(), gomock.Any()).
+		Return([]db.GetUserRecommendedEventsRow{{ID: 3, OwnerID: 7}, {ID: 4, OwnerID: 8}}, nil)
+	store.EXPECT().
+		ListOrganizerRatings(gomock.Any(), []int32{7, 8}).
+		Return([]db.ListOrganizerRatingsRow{{OwnerID: 8, Average: 4.25, ReviewsCount: 4}}, nil)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{EventsPageSize: 10, EventsMaxPageSize: 50})
+
+	page, err := service.GetFeed(context.Background(), models.FeedParams{UserID: 2})
+	require.NoError(t, err)
+	require.False(t, page.HasMore)
+	require.Len(t, page.Events, 2)
+	require.Equal(t, int32(1), page.Events[0].ID)
+	require.Equal(t, int32(4), page.Events[1].ID)
+	require.Zero(t, page.Events[0].OwnerRating)
+	require.Equal(t, 4.3, page.Events[1].OwnerRating)
+	require.Equal(t, 4, page.Events[1].OwnerReviewsCount)
+}
+
+func TestGetFeedMoreFollowedSkipsRecommended(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetFollowingFeedEvents(gomock.Any(), gomock.Any()).
+		Return([]db.GetFollowingFeedEventsRow{{ID: 1, OwnerID: 7}, {ID: 2, OwnerID: 7}}, nil)
+	store.EXPECT().ListFollowedIDs(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().GetUserRecommendedEvents(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().
+		ListOrganizerRatings(gomock.Any(), []int32{7}).
+		Return([]db.ListOrganizerRatingsRow{}, nil)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{EventsPageSize: 10, EventsMaxPageSize: 50})
+
+	page, err := service.GetFeed(context.Background(), models.FeedParams{UserID: 2, Limit: 1})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.Len(t, page.Events, 1)
+}
+
+func TestGetFeedLastPageMergesRecommended(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetFollowingFeedEvents(gomock.Any(), gomock.Any()).
+		Return([]db.GetFollowingFeedEventsRow{{ID: 5, OwnerID: 7, ParticipantsCount: 3}}, nil)
+	store.EXPECT().ListFollowedIDs(gomock.Any(), int32(2)).Return([]int32{7}, nil)
+	store.EXPECT().
+		GetUserRecommendedEvents(gomock.Any(), gomock.Any()).
+		Return([]db.GetUserRecommendedEventsRow{{ID: 6, OwnerID: 8}}, nil)
+	store.EXPECT().
+		ListOrganizerRatings(gomock.Any(), []int32{7, 8}).
+		Return([]db.ListOrganizerRatingsRow{}, nil)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{EventsPageSize: 10, EventsMaxPageSize: 50})
+
+	cursor := common.EncodeCursor(dateCursor{Date: time.Now(), ID: 1})
+	page, err := service.GetFeed(context.Background(), models.FeedParams{UserID: 2, Cursor: cursor})
+	require.NoError(t, err)
+	require.False(t, page.HasMore)
+	require.Len(t, page.Events, 2)
+	require.Equal(t, 3, page.Events[0].ParticipantCount)
+	require.Equal(t, int32(6), page.Events[1].ID)
+}
+
+func TestDeleteGoesThroughTx(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetEvent(gomock.Any(), db.GetEventParams{ID: 10, OwnerID: 1}).
+		Return(db.GetEventRow{ID: 10, OwnerID: 1}, nil)
+	store.EXPECT().DeleteEventTx(gomock.Any(), int32(10)).Return(nil)
+	store.EXPECT().DeleteEvent(gomock.Any(), gomock.Any()).Times(0)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{})
+
+	err := service.Delete(context.Background(), models.DeleteParams{EventID: 10, UserID: 1})
+	require.NoError(t, err)
+}
+
+func TestSubscribeBannedWithInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetEvent(gomock.Any(), db.GetEventParams{ID: 10, OwnerID: 2, Token: "invite"}).
+		Return(db.GetEventRow{ID: 10, OwnerID: 1, Capacity: 10, IsPrivate: true, Date: time.Now().Add(time.Hour)}, nil)
+	store.EXPECT().
+		IsBannedFromEvent(gomock.Any(), db.IsBannedFromEventParams{EventID: 10, UserID: 2}).
+		Return(true, nil)
+	store.EXPECT().SubscribeToEventTx(gomock.Any(), gomock.Any()).Times(0)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{})
+
+	_, err := service.Subscribe(context.Background(), models.SubscriptionParams{EventID: 10, UserID: 2, Token: "invite"})
+
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestUnsubscribeNotParticipant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetEvent(gomock.Any(), db.GetEventParams{ID: 10, OwnerID: 2}).
+		Return(db.GetEventRow{ID: 10, OwnerID: 1, Capacity: 10}, nil)
+	store.EXPECT().IsParticipant(gomock.Any(), gomock.Any()).Return(false, nil)
+	store.EXPECT().GetWaitlistStatus(gomock.Any(), gomock.Any()).Return(db.GetWaitlistStatusRow{}, nil)
+	store.EXPECT().
+		UnsubscribeFromEventTx(gomock.Any(), db.UnsubscribeFromEventParams{EventID: 10, UserID: 2}).
+		Return(nil, fmt.Errorf("transaction failed: %w", db.ErrNotParticipant))
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{})
+
+	_, err := service.Unsubscribe(context.Background(), models.SubscriptionParams{EventID: 10, UserID: 2})
+
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
+
+func TestSubscribePastEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetEvent(gomock.Any(), db.GetEventParams{ID: 10, OwnerID: 2}).
+		Return(db.GetEventRow{ID: 10, OwnerID: 1, Capacity: 10, Date: time.Now().Add(-time.Hour)}, nil)
+	store.EXPECT().IsBannedFromEvent(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().SubscribeToEventTx(gomock.Any(), gomock.Any()).Times(0)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{})
+
+	_, err := service.Subscribe(context.Background(), models.SubscriptionParams{EventID: 10, UserID: 2})
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestSubscribeMissingEventIsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		GetEvent(gomock.Any(), db.GetEventParams{ID: 10, OwnerID: 2}).
+		Return(db.GetEventRow{ID: 10, OwnerID: 1, Capacity: 10, Date: time.Now().Add(time.Hour)}, nil)
+	store.EXPECT().IsBannedFromEvent(gomock.Any(), gomock.Any()).Return(false, nil)
+	store.EXPECT().
+		SubscribeToEventTx(gomock.Any(), db.SubscribeToEventParams{EventID: 10, UserID: 2}).
+		Return(pgtype.Bool{}, fmt.Errorf("subscribe to event error: %w", pgx.ErrNoRows))
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{})
+
+	_, err := service.Subscribe(context.Background(), models.SubscriptionParams{EventID: 10, UserID: 2})
+
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	servicetest.RequireAppError(t, apperror.WrapDBError(err), apperror.NotFound)
+}
+
+func TestRequireOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Return(db.GetEventRow{ID: 10, OwnerID: 1}, nil).Times(2)
+	store.EXPECT().IsParticipant(gomock.Any(), gomock.Any()).Return(false, nil).Times(2)
+	store.EXPECT().GetWaitlistStatus(gomock.Any(), gomock.Any()).Return(db.GetWaitlistStatusRow{}, nil).Times(2)
+
+	service := New(store, stubModerator{}, stubNotifier{}, util.Config{})
+
+	require.NoError(t, service.RequireOwner(context.Background(), 10, 1))
+	servicetest.RequireAppError(t, service.RequireOwner(context.Background(), 10, 2), apperror.Forbidden)
+}
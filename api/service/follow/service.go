@@ -0,0 +1,56 @@
+package followservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+)
+
+type Service struct {
+	store db.Store
+}
+
+func New(store db.Store) *Service {
+	return &Service{
+		store: store,
+	}
+}
+
+// Follow is idempotent: following someone twice is not an error.
+func (s *Service) Follow(ctx context.Context, followerID, followeeID int32) error {
+	if followerID == followeeID {
+		return apperror.BadRequest.WithCause(errors.New("cannot follow yourself"))
+	}
+
+	followee, err := s.store.GetUser(ctx, followeeID)
+	if err != nil {
+		return err
+	}
+	if followee.IsBlocked {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	_, err = s.store.FollowUser(ctx, db.FollowUserParams{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+	return err
+}
+
+func (s *Service) Unfollow(ctx context.Context, followerID, followeeID int32) error {
+	deleted, err := s.store.UnfollowUser(ctx, db.UnfollowUserParams{
+		FollowerID: followerID,
+		FolloweeID: followeeID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
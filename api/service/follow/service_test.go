@@ -0,0 +1,54 @@
+package followservice
+
+import (
+	"context"
+	"testing"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowYourself(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().FollowUser(gomock.Any(), gomock.Any()).Times(0)
+
+	err := New(store).Follow(context.Background(), 1, 1)
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestFollowBlockedUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any(), int32(2)).Return(db.User{ID: 2, IsBlocked: true}, nil)
+	store.EXPECT().FollowUser(gomock.Any(), gomock.Any()).Times(0)
+
+	err := New(store).Follow(context.Background(), 1, 2)
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
+
+func TestFollowTwice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetUser(gomock.Any(), int32(2)).Return(db.User{ID: 2}, nil)
+	store.EXPECT().
+		FollowUser(gomock.Any(), db.FollowUserParams{FollowerID: 1, FolloweeID: 2}).
+		Return(int64(0), nil)
+
+	require.NoError(t, New(store).Follow(context.Background(), 1, 2))
+}
+
+func TestUnfollowNotFollowing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		UnfollowUser(gomock.Any(), db.UnfollowUserParams{FollowerID: 1, FolloweeID: 2}).
+		Return(int64(0), nil)
+
+	err := New(store).Unfollow(context.Background(), 1, 2)
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
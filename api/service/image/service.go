@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -61,6 +62,26 @@ func (s *Service) Delete(path string) error {
 	return s.imageStore.Delete(path)
 }
 
+func (s *Service) DeleteIfUnused(ctx context.Context, id uuid.UUID, path string) error {
+	references, err := s.store.CountImageReferences(ctx, pgtype.UUID{
+		Bytes: id,
+		Valid: id != uuid.Nil,
+	})
+	if err != nil {
+		return err
+	}
+
+	if references > 0 {
+		return nil
+	}
+
+	if err := s.store.DeleteImage(ctx, id); err != nil {
+		return err
+	}
+
+	return s.imageStore.Delete(path)
+}
+
 func isValidImageType(header *multipart.FileHeader) bool {
 	allowedTypes := map[string]bool{
 		"image/jpeg": true,
@@ -0,0 +1,23 @@
+package notificationservice
+
+import (
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertNotifications(rows []db.ListNotificationsRow) []models.Notification {
+	result := make([]models.Notification, len(rows))
+	for i, n := range rows {
+		result[i] = models.Notification{
+			ID:            n.ID,
+			Type:          n.Type,
+			EventID:       n.EventID.Int32,
+			EventName:     n.EventName,
+			ActorID:       n.ActorID.Int32,
+			ActorUsername: n.ActorUsername.String,
+			IsRead:        n.ReadAt.Valid,
+			CreatedAt:     n.CreatedAt,
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+package notificationservice
+
+import (
+	"context"
+	"database/sql"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+)
+
+type Service struct {
+	store db.Store
+	log   *zap.Logger
+}
+
+func New(store db.Store, log *zap.Logger) *Service {
+	return &Service{
+		store: store,
+		log:   log,
+	}
+}
+
+func (s *Service) List(ctx context.Context, params models.ListNotificationsParams) (models.NotificationsPage, error) {
+	rows, err := s.store.ListNotifications(ctx, db.ListNotificationsParams{
+		UserID:     params.UserID,
+		UnreadOnly: params.UnreadOnly,
+		Lim:        params.Limit + 1,
+		Off:        params.Offset,
+	})
+	if err != nil {
+		return models.NotificationsPage{}, err
+	}
+
+	unread, err := s.store.CountUnreadNotifications(ctx, params.UserID)
+	if err != nil {
+		return models.NotificationsPage{}, err
+	}
+
+	hasMore := len(rows) > int(params.Limit)
+	if hasMore {
+		rows = rows[:params.Limit]
+	}
+
+	return models.NotificationsPage{
+		Notifications: convertNotifications(rows),
+		UnreadCount:   int(unread),
+		HasMore:       hasMore,
+	}, nil
+}
+
+func (s *Service) UnreadCount(ctx context.Context, userID int32) (int, error) {
+	unread, err := s.store.CountUnreadNotifications(ctx, userID)
+	return int(unread), err
+}
+
+func (s *Service) MarkRead(ctx context.Context, notificationID, userID int32) error {
+	updated, err := s.store.MarkNotificationRead(ctx, db.MarkNotificationReadParams{
+		ID:     notificationID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (s *Service) MarkAllRead(ctx context.Context, userID int32) error {
+	_, err := s.store.MarkAllNotificationsRead(ctx, userID)
+	return err
+}
+
+// ParticipantJoined tells the owner that someone joined their event. It is
+// best effort: the subscription has already happened, so a failure is only
+// logged.
+func (s *Service) ParticipantJoined(ctx context.Context, ownerID, eventID, participantID int32) {
+	err := s.store.CreateNotification(ctx, db.CreateNotificationParams{
+		UserID:  ownerID,
+		Type:    models.NotificationParticipantJoined,
+		ActorID: pgtype.Int4{Int32: participantID, Valid: true},
+		EventID: eventID,
+	})
+	if err != nil {
+		s.log.Warn("notify owner about new participant",
+			zap.Int32("event_id", eventID),
+			zap.Int32("participant_id", participantID),
+			zap.Error(err),
+		)
+	}
+}
+
+// NotifyStartingEvents is run by the scheduler and reminds participants of
+// events starting within a day; each participant is reminded once per event.
+func (s *Service) NotifyStartingEvents(ctx context.Context) error {
+	created, err := s.store.NotifyStartingEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	if created > 0 {
+		s.log.Info("event reminders created", zap.Int64("count", created))
+	}
+
+	return nil
+}
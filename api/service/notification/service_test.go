@@ -0,0 +1,67 @@
+package notificationservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	now := time.Now()
+	store.EXPECT().
+		ListNotifications(gomock.Any(), db.ListNotificationsParams{UserID: 1, UnreadOnly: true, Lim: 2, Off: 0}).
+		Return([]db.ListNotificationsRow{
+			{ID: 3, Type: models.NotificationParticipantJoined, EventID: pgtype.Int4{Int32: 10, Valid: true}, ActorUsername: pgtype.Text{String: "anna", Valid: true}, CreatedAt: now},
+			{ID: 2, Type: models.NotificationEventCancelled, EventName: "Пикник", CreatedAt: now},
+		}, nil)
+	store.EXPECT().CountUnreadNotifications(gomock.Any(), int32(1)).Return(int64(5), nil)
+
+	page, err := New(store, zap.NewNop()).List(context.Background(), models.ListNotificationsParams{UserID: 1, UnreadOnly: true, Limit: 1})
+	require.NoError(t, err)
+	require.True(t, page.HasMore)
+	require.Equal(t, 5, page.UnreadCount)
+	require.Len(t, page.Notifications, 1)
+	require.Equal(t, "anna", page.Notifications[0].ActorUsername)
+	require.False(t, page.Notifications[0].IsRead)
+}
+
+func TestMarkReadNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		MarkNotificationRead(gomock.Any(), db.MarkNotificationReadParams{ID: 3, UserID: 1}).
+		Return(int64(0), nil)
+
+	err := New(store, zap.NewNop()).MarkRead(context.Background(), 3, 1)
+
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
+
+func TestParticipantJoinedIgnoresErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		CreateNotification(gomock.Any(), db.CreateNotificationParams{
+			UserID:  1,
+			Type:    models.NotificationParticipantJoined,
+			ActorID: pgtype.Int4{Int32: 2, Valid: true},
+			EventID: 10,
+		}).
+		Return(errors.New("connection reset"))
+
+	New(store, zap.NewNop()).ParticipantJoined(context.Background(), 1, 10, 2)
+}
@@ -0,0 +1,165 @@
+package outboxservice
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"time"
+	db "treffly/db/sqlc"
+	"treffly/eventbus"
+	"treffly/util"
+)
+
+const (
+	outboxBatchSize = 20
+	// outboxPublishTimeout bounds a single message's trip through the
+	// subscribers; the claim lease covers a whole batch of them.
+	outboxPublishTimeout = 10 * time.Second
+)
+
+type publisher interface {
+	Publish(ctx context.Context, msg eventbus.Message) error
+}
+
+type Service struct {
+	store  db.Store
+	bus    publisher
+	config util.Config
+	log    *zap.Logger
+}
+
+func New(store db.Store, bus publisher, config util.Config, log *zap.Logger) *Service {
+	return &Service{
+		store:  store,
+		bus:    bus,
+		config: config,
+		log:    log,
+	}
+}
+
+// Run is the relay job: it publishes pending outbox messages until the
+// outbox is drained or a batch has failures, which are retried with backoff.
+// Messages are leased rather than locked, so no transaction stays open while
+// subscribers do I/O.
+func (s *Service) Run(ctx context.Context) error {
+	for ctx.Err() == nil {
+		messages, err := s.store.ClaimOutboxMessages(ctx, db.ClaimOutboxMessagesParams{
+			LeaseSeconds: int32(outboxBatchSize * outboxPublishTimeout / time.Second),
+			MaxAttempts:  int32(s.config.OutboxMaxAttempts),
+			Lim:          outboxBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		for _, msg := range messages {
+			published, err := s.relay(ctx, msg)
+			if err != nil {
+				return err
+			}
+			if !published {
+				failed++
+			}
+		}
+
+		if failed > 0 {
+			s.log.Warn("outbox messages failed",
+				zap.Int("failed", failed),
+				zap.Int("claimed", len(messages)),
+			)
+			return nil
+		}
+
+		if len(messages) < outboxBatchSize {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// relay publishes msg and records the outcome. A failed message is retried
+// after a growing delay until it runs out of attempts, when it is logged and
+// left for Cleanup.
+func (s *Service) relay(ctx context.Context, msg db.ClaimOutboxMessagesRow) (bool, error) {
+	publishCtx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	pubErr := s.publish(publishCtx, msg)
+	cancel()
+
+	if pubErr == nil {
+		if err := s.store.MarkOutboxMessagePublished(ctx, msg.ID); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	attempts := int(msg.Attempts) + 1
+	err := s.store.RecordOutboxMessageFailure(ctx, db.RecordOutboxMessageFailureParams{
+		LastError:     pubErr.Error(),
+		NextAttemptAt: time.Now().Add(retryDelay(attempts)),
+		ID:            msg.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if attempts >= s.config.OutboxMaxAttempts {
+		s.log.Error("outbox message gave up",
+			zap.Int64("outbox_id", msg.ID),
+			zap.String("event_type", msg.EventType),
+			zap.Int("attempts", attempts),
+			zap.Error(pubErr),
+		)
+	}
+
+	return false, nil
+}
+
+func (s *Service) publish(ctx context.Context, msg db.ClaimOutboxMessagesRow) error {
+	event, err := eventbus.Decode(msg.EventType, msg.Payload)
+	if err != nil {
+		return err
+	}
+
+	return s.bus.Publish(ctx, eventbus.Message{
+		ID:         msg.ID,
+		OccurredAt: msg.CreatedAt,
+		Event:      event,
+	})
+}
+
+// Cleanup removes published messages and messages that ran out of attempts
+// once they are older than the retention period.
+func (s *Service) Cleanup(ctx context.Context) error {
+	deleted, err := s.store.DeleteExpiredOutboxMessages(ctx, db.DeleteExpiredOutboxMessagesParams{
+		ExpiredBefore: time.Now().Add(-s.config.OutboxRetention),
+		MaxAttempts:   int32(s.config.OutboxMaxAttempts),
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		s.log.Info("outbox cleaned up", zap.Int64("deleted", deleted))
+	}
+
+	return nil
+}
+
+// retryDelay returns the delay before retry number attempt (starting at 1):
+// 5s doubled on every attempt and capped at an hour.
+func retryDelay(attempt int) time.Duration {
+	const (
+		base    = 5 * time.Second
+		maximum = time.Hour
+	)
+
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 20 {
+		return maximum
+	}
+
+	return min(base<<(attempt-1), maximum)
+}
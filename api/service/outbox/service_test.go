@@ -0,0 +1,138 @@
+package outboxservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/eventbus"
+	"treffly/util"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type recordingBus struct {
+	messages []eventbus.Message
+	err      error
+}
+
+func (b *recordingBus) Publish(_ context.Context, msg eventbus.Message) error {
+	b.messages = append(b.messages, msg)
+	return b.err
+}
+
+func newService(store db.Store, bus publisher) *Service {
+	return New(store, bus, util.Config{OutboxMaxAttempts: 5, OutboxRetention: time.Hour}, zap.NewNop())
+}
+
+func claimParams() db.ClaimOutboxMessagesParams {
+	return db.ClaimOutboxMessagesParams{
+		LeaseSeconds: int32(outboxBatchSize * outboxPublishTimeout / time.Second),
+		MaxAttempts:  5,
+		Lim:          outboxBatchSize,
+	}
+}
+
+func fullBatch() []db.ClaimOutboxMessagesRow {
+	batch := make([]db.ClaimOutboxMessagesRow, outboxBatchSize)
+	for i := range batch {
+		batch[i] = db.ClaimOutboxMessagesRow{ID: int64(i + 1), EventType: eventbus.TypeEventDeleted, Payload: []byte(`{"event_id":1}`)}
+	}
+	return batch
+}
+
+func TestRunPublishesDecodedEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	bus := &recordingBus{}
+
+	created := time.Now()
+	store.EXPECT().
+		ClaimOutboxMessages(gomock.Any(), claimParams()).
+		Return([]db.ClaimOutboxMessagesRow{
+			{ID: 7, EventType: eventbus.TypeParticipantJoined, Payload: []byte(`{"event_id":1,"user_id":2}`), CreatedAt: created},
+		}, nil)
+	store.EXPECT().MarkOutboxMessagePublished(gomock.Any(), int64(7)).Return(nil)
+
+	require.NoError(t, newService(store, bus).Run(context.Background()))
+	require.Len(t, bus.messages, 1)
+	require.Equal(t, int64(7), bus.messages[0].ID)
+	require.Equal(t, created, bus.messages[0].OccurredAt)
+	require.Equal(t, eventbus.ParticipantJoined{EventID: 1, UserID: 2}, bus.messages[0].Event)
+}
+
+func TestRunDrainsFullBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	gomock.InOrder(
+		store.EXPECT().ClaimOutboxMessages(gomock.Any(), claimParams()).Return(fullBatch(), nil),
+		store.EXPECT().ClaimOutboxMessages(gomock.Any(), claimParams()).Return(nil, nil),
+	)
+	store.EXPECT().MarkOutboxMessagePublished(gomock.Any(), gomock.Any()).Times(outboxBatchSize).Return(nil)
+
+	require.NoError(t, newService(store, &recordingBus{}).Run(context.Background()))
+}
+
+func TestRunStopsOnFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	batch := fullBatch()
+	batch[0].EventType = "event.unknown"
+	batch[0].Attempts = 2
+	store.EXPECT().ClaimOutboxMessages(gomock.Any(), claimParams()).Times(1).Return(batch, nil)
+	store.EXPECT().MarkOutboxMessagePublished(gomock.Any(), gomock.Any()).Times(outboxBatchSize - 1).Return(nil)
+
+	before := time.Now()
+	store.EXPECT().
+		RecordOutboxMessageFailure(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.RecordOutboxMessageFailureParams) error {
+			require.Equal(t, int64(1), arg.ID)
+			require.NotEmpty(t, arg.LastError)
+			require.WithinDuration(t, before.Add(retryDelay(3)), arg.NextAttemptAt, time.Second)
+			return nil
+		})
+
+	require.NoError(t, newService(store, &recordingBus{}).Run(context.Background()))
+}
+
+func TestRunLogsExhaustedMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		ClaimOutboxMessages(gomock.Any(), gomock.Any()).
+		Return([]db.ClaimOutboxMessagesRow{{ID: 9, EventType: eventbus.TypeEventDeleted, Payload: []byte(`{"event_id":1}`), Attempts: 4}}, nil)
+	store.EXPECT().RecordOutboxMessageFailure(gomock.Any(), gomock.Any()).Return(nil)
+
+	core, logs := observer.New(zap.ErrorLevel)
+	service := New(store, &recordingBus{err: errors.New("subscriber down")}, util.Config{OutboxMaxAttempts: 5}, zap.New(core))
+
+	require.NoError(t, service.Run(context.Background()))
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, int64(9), logs.All()[0].ContextMap()["outbox_id"])
+}
+
+func TestRunStoreError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ClaimOutboxMessages(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("db down"))
+
+	require.Error(t, newService(store, &recordingBus{}).Run(context.Background()))
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, 5*time.Second, retryDelay(0))
+	require.Equal(t, 5*time.Second, retryDelay(1))
+	require.Equal(t, 40*time.Second, retryDelay(4))
+	require.Equal(t, time.Hour, retryDelay(12))
+	require.Equal(t, time.Hour, retryDelay(100))
+}
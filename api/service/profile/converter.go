@@ -0,0 +1,26 @@
+package profileservice
+
+import (
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertPublicProfile(row db.GetPublicProfileRow) models.PublicProfile {
+	tags := make([]models.Tag, len(row.Tags))
+	for i, t := range row.Tags {
+		tags[i] = models.Tag{
+			ID:   t.ID,
+			Name: t.Name,
+		}
+	}
+
+	return models.PublicProfile{
+		ID:                row.ID,
+		Username:          row.Username,
+		ImagePath:         row.ImagePath.String,
+		CreatedAt:         row.CreatedAt,
+		Tags:              tags,
+		HostedEventsCount: int(row.HostedEventsCount),
+		AttendanceHidden:  row.HideAttendance,
+	}
+}
@@ -0,0 +1,109 @@
+package profileservice
+
+import (
+	"context"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+const profileEventsLimit = 10
+
+type eventLister interface {
+	GetProfileUpcomingEvents(ctx context.Context, ownerID, limit int32) ([]models.Event, error)
+	GetProfileAttendedEvents(ctx context.Context, userID, limit int32) ([]models.Event, error)
+}
+
+type ratingProvider interface {
+	GetOrganizerRating(ctx context.Context, userID int32) (models.Rating, error)
+}
+
+type Service struct {
+	store   db.Store
+	events  eventLister
+	ratings ratingProvider
+}
+
+func New(store db.Store, events eventLister, ratings ratingProvider) *Service {
+	return &Service{
+		store:   store,
+		events:  events,
+		ratings: ratings,
+	}
+}
+
+// Get builds the public profile of userID as seen by viewerID (0 for guests).
+// Attended events are left out when the user hides their attendance, unless
+// they are looking at their own profile.
+func (s *Service) Get(ctx context.Context, userID, viewerID int32) (models.PublicProfile, error) {
+	row, err := s.store.GetPublicProfile(ctx, userID)
+	if err != nil {
+		return models.PublicProfile{}, err
+	}
+
+	profile := convertPublicProfile(row)
+
+	counts, err := s.store.GetFollowCounts(ctx, userID)
+	if err != nil {
+		return models.PublicProfile{}, err
+	}
+	profile.FollowersCount = int(counts.FollowersCount)
+	profile.FollowingCount = int(counts.FollowingCount)
+
+	if viewerID > 0 && viewerID != userID {
+		profile.IsFollowing, err = s.store.IsFollowing(ctx, db.IsFollowingParams{
+			FollowerID: viewerID,
+			FolloweeID: userID,
+		})
+		if err != nil {
+			return models.PublicProfile{}, err
+		}
+	}
+
+	profile.Rating, err = s.ratings.GetOrganizerRating(ctx, userID)
+	if err != nil {
+		return models.PublicProfile{}, err
+	}
+
+	profile.UpcomingEvents, err = s.events.GetProfileUpcomingEvents(ctx, userID, profileEventsLimit)
+	if err != nil {
+		return models.PublicProfile{}, err
+	}
+
+	if profile.AttendanceHidden && viewerID != userID {
+		return profile, nil
+	}
+
+	profile.AttendedEvents, err = s.events.GetProfileAttendedEvents(ctx, userID, profileEventsLimit)
+	if err != nil {
+		return models.PublicProfile{}, err
+	}
+
+	return profile, nil
+}
+
+func (s *Service) GetPrivacy(ctx context.Context, userID int32) (models.PrivacySettings, error) {
+	hideAttendance, err := s.store.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		return models.PrivacySettings{}, err
+	}
+
+	return models.PrivacySettings{
+		UserID:         userID,
+		HideAttendance: hideAttendance,
+	}, nil
+}
+
+func (s *Service) UpdatePrivacy(ctx context.Context, params models.PrivacySettings) (models.PrivacySettings, error) {
+	settings, err := s.store.UpsertPrivacySettings(ctx, db.UpsertPrivacySettingsParams{
+		UserID:         params.UserID,
+		HideAttendance: params.HideAttendance,
+	})
+	if err != nil {
+		return models.PrivacySettings{}, err
+	}
+
+	return models.PrivacySettings{
+		UserID:         settings.UserID,
+		HideAttendance: settings.HideAttendance,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package profileservice
+
+import (
+	"context"
+	"testing"
+	"treffly/api/models"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fakeEvents struct {
+	attendedCalls int
+}
+
+func (f *fakeEvents) GetProfileUpcomingEvents(context.Context, int32, int32) ([]models.Event, error) {
+	return []models.Event{{ID: 1}}, nil
+}
+
+func (f *fakeEvents) GetProfileAttendedEvents(context.Context, int32, int32) ([]models.Event, error) {
+	f.attendedCalls++
+	return []models.Event{{ID: 2}}, nil
+}
+
+type fakeRatings struct{}
+
+func (fakeRatings) GetOrganizerRating(context.Context, int32) (models.Rating, error) {
+	return models.Rating{Average: 4.5, ReviewsCount: 2}, nil
+}
+
+func expectProfile(store *mockdb.MockStore, hideAttendance bool) {
+	store.EXPECT().GetPublicProfile(gomock.Any(), int32(5)).
+		Return(db.GetPublicProfileRow{ID: 5, Username: "anna", HostedEventsCount: 3, HideAttendance: hideAttendance}, nil)
+	store.EXPECT().GetFollowCounts(gomock.Any(), int32(5)).
+		Return(db.GetFollowCountsRow{FollowersCount: 10, FollowingCount: 1}, nil)
+}
+
+func TestGetHiddenAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	expectProfile(store, true)
+	store.EXPECT().IsFollowing(gomock.Any(), db.IsFollowingParams{FollowerID: 7, FolloweeID: 5}).Return(true, nil)
+
+	events := &fakeEvents{}
+	profile, err := New(store, events, fakeRatings{}).Get(context.Background(), 5, 7)
+	require.NoError(t, err)
+	require.True(t, profile.IsFollowing)
+	require.True(t, profile.AttendanceHidden)
+	require.Nil(t, profile.AttendedEvents)
+	require.Zero(t, events.attendedCalls)
+	require.Equal(t, 10, profile.FollowersCount)
+	require.Equal(t, 4.5, profile.Rating.Average)
+}
+
+func TestGetOwnHiddenAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	expectProfile(store, true)
+	store.EXPECT().IsFollowing(gomock.Any(), gomock.Any()).Times(0)
+
+	events := &fakeEvents{}
+	profile, err := New(store, events, fakeRatings{}).Get(context.Background(), 5, 5)
+	require.NoError(t, err)
+	require.Len(t, profile.AttendedEvents, 1)
+}
+
+func TestGetAsGuest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	expectProfile(store, false)
+	store.EXPECT().IsFollowing(gomock.Any(), gomock.Any()).Times(0)
+
+	profile, err := New(store, &fakeEvents{}, fakeRatings{}).Get(context.Background(), 5, 0)
+	require.NoError(t, err)
+	require.False(t, profile.IsFollowing)
+	require.Len(t, profile.UpcomingEvents, 1)
+	require.Len(t, profile.AttendedEvents, 1)
+	require.Equal(t, 3, profile.HostedEventsCount)
+}
@@ -0,0 +1,60 @@
+package promotionservice
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertPromotion(p db.Promotion) models.Promotion {
+	return models.Promotion{
+		ID:          p.ID,
+		EventID:     p.EventID,
+		RequestedBy: p.RequestedBy,
+		Status:      p.Status,
+		StartsAt:    p.StartsAt,
+		EndsAt:      p.EndsAt,
+		ReviewedBy:  p.ReviewedBy.Int32,
+		ReviewedAt:  safeTime(p.ReviewedAt),
+		ActivatedAt: safeTime(p.ActivatedAt),
+		FinishedAt:  safeTime(p.FinishedAt),
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+func convertPromotions(promotions []db.Promotion) []models.Promotion {
+	result := make([]models.Promotion, len(promotions))
+	for i, p := range promotions {
+		result[i] = convertPromotion(p)
+	}
+	return result
+}
+
+func convertPromotionRows(rows []db.ListPromotionsRow) []models.Promotion {
+	result := make([]models.Promotion, len(rows))
+	for i, r := range rows {
+		result[i] = convertPromotion(db.Promotion{
+			ID:          r.ID,
+			EventID:     r.EventID,
+			RequestedBy: r.RequestedBy,
+			Status:      r.Status,
+			StartsAt:    r.StartsAt,
+			EndsAt:      r.EndsAt,
+			ReviewedBy:  r.ReviewedBy,
+			ReviewedAt:  r.ReviewedAt,
+			ActivatedAt: r.ActivatedAt,
+			FinishedAt:  r.FinishedAt,
+			CreatedAt:   r.CreatedAt,
+		})
+		result[i].EventName = r.EventName
+	}
+	return result
+}
+
+func safeTime(t pgtype.Timestamptz) time.Time {
+	if t.Valid {
+		return t.Time
+	}
+	return time.Time{}
+}
@@ -0,0 +1,169 @@
+package promotionservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"time"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/util"
+)
+
+type Service struct {
+	store  db.Store
+	config util.Config
+	log    *zap.Logger
+}
+
+func New(store db.Store, config util.Config, log *zap.Logger) *Service {
+	return &Service{
+		store:  store,
+		config: config,
+		log:    log,
+	}
+}
+
+func (s *Service) Request(ctx context.Context, params models.CreatePromotionParams) (models.Promotion, error) {
+	if err := s.validateWindow(params.StartsAt, params.EndsAt, time.Now()); err != nil {
+		return models.Promotion{}, apperror.BadRequest.WithCause(err)
+	}
+
+	if err := s.checkOwner(ctx, params.EventID, params.UserID); err != nil {
+		return models.Promotion{}, err
+	}
+
+	promotion, err := s.store.CreatePromotionTx(ctx, db.CreatePromotionParams{
+		EventID:     params.EventID,
+		RequestedBy: params.UserID,
+		StartsAt:    params.StartsAt,
+		EndsAt:      params.EndsAt,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrPromotionOverlap) {
+			return models.Promotion{}, apperror.PromotionConflict.WithCause(fmt.Errorf("overlapping promotion for event %d: %w", params.EventID, err))
+		}
+		return models.Promotion{}, err
+	}
+
+	return convertPromotion(promotion), nil
+}
+
+func (s *Service) ListForEvent(ctx context.Context, eventID, userID int32) ([]models.Promotion, error) {
+	if err := s.checkOwner(ctx, eventID, userID); err != nil {
+		return nil, err
+	}
+
+	promotions, err := s.store.ListEventPromotions(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPromotions(promotions), nil
+}
+
+func (s *Service) List(ctx context.Context, params models.ListPromotionsParams) ([]models.Promotion, error) {
+	rows, err := s.store.ListPromotions(ctx, db.ListPromotionsParams{
+		Status: params.Status,
+		Lim:    params.Limit,
+		Off:    params.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertPromotionRows(rows), nil
+}
+
+func (s *Service) Approve(ctx context.Context, promotionID, adminID int32) (models.Promotion, error) {
+	promotion, err := s.review(ctx, promotionID, adminID, models.PromotionApproved)
+	if err != nil {
+		return models.Promotion{}, err
+	}
+
+	// Activate right away when the window is already open instead of
+	// waiting for the next scheduled sync.
+	now := time.Now()
+	if !promotion.StartsAt.After(now) && promotion.EndsAt.After(now) {
+		if err := s.Sync(ctx); err != nil {
+			return models.Promotion{}, err
+		}
+		promotion.Status = models.PromotionActive
+	}
+
+	return promotion, nil
+}
+
+func (s *Service) Reject(ctx context.Context, promotionID, adminID int32) (models.Promotion, error) {
+	return s.review(ctx, promotionID, adminID, models.PromotionRejected)
+}
+
+// Sync is run periodically by the scheduler and flips is_premium on events
+// whose promotion windows opened or closed since the previous run.
+func (s *Service) Sync(ctx context.Context) error {
+	result, err := s.store.SyncPromotionsTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Activated) > 0 || len(result.Finished) > 0 {
+		s.log.Info("promotions synced",
+			zap.Int32s("activated", result.Activated),
+			zap.Int32s("finished", result.Finished),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) review(ctx context.Context, promotionID, adminID int32, status string) (models.Promotion, error) {
+	promotion, err := s.store.ReviewPromotion(ctx, db.ReviewPromotionParams{
+		Status:     status,
+		ReviewedBy: pgtype.Int4{Int32: adminID, Valid: true},
+		ID:         promotionID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Promotion{}, apperror.NotFound.WithCause(fmt.Errorf("no pending promotion %d: %w", promotionID, err))
+		}
+		return models.Promotion{}, err
+	}
+
+	return convertPromotion(promotion), nil
+}
+
+func (s *Service) checkOwner(ctx context.Context, eventID, userID int32) error {
+	event, err := s.store.GetEvent(ctx, db.GetEventParams{
+		ID:      eventID,
+		OwnerID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if event.OwnerID != userID {
+		return apperror.Forbidden.WithCause(fmt.Errorf("owner id mismatch"))
+	}
+
+	return nil
+}
+
+func (s *Service) validateWindow(startsAt, endsAt, now time.Time) error {
+	if !endsAt.After(startsAt) {
+		return errors.New("promotion must end after it starts")
+	}
+
+	if !endsAt.After(now) {
+		return errors.New("promotion window is in the past")
+	}
+
+	if endsAt.Sub(startsAt) > s.config.PromotionMaxDuration {
+		return fmt.Errorf("promotion cannot last longer than %s", s.config.PromotionMaxDuration)
+	}
+
+	return nil
+}
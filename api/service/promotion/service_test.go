@@ -0,0 +1,119 @@
+package promotionservice
+
+import (
+	"context"
+	"testing"
+	"time"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/util"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestService(store db.Store) *Service {
+	return New(store, util.Config{PromotionMaxDuration: 30 * 24 * time.Hour}, zap.NewNop())
+}
+
+func TestRequestInvalidWindow(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		startsAt time.Time
+		endsAt   time.Time
+	}{
+		{name: "EndsBeforeStart", startsAt: now.Add(2 * time.Hour), endsAt: now.Add(time.Hour)},
+		{name: "InThePast", startsAt: now.Add(-2 * time.Hour), endsAt: now.Add(-time.Hour)},
+		{name: "TooLong", startsAt: now, endsAt: now.Add(31 * 24 * time.Hour)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().CreatePromotionTx(gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := newTestService(store).Request(context.Background(), models.CreatePromotionParams{
+				EventID:  1,
+				UserID:   1,
+				StartsAt: tc.startsAt,
+				EndsAt:   tc.endsAt,
+			})
+
+			servicetest.RequireAppError(t, err, apperror.BadRequest)
+		})
+	}
+}
+
+func TestRequestNotOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Return(db.GetEventRow{ID: 1, OwnerID: 2}, nil)
+	store.EXPECT().CreatePromotionTx(gomock.Any(), gomock.Any()).Times(0)
+
+	now := time.Now()
+	_, err := newTestService(store).Request(context.Background(), models.CreatePromotionParams{
+		EventID:  1,
+		UserID:   1,
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestRequestOverlap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Return(db.GetEventRow{ID: 1, OwnerID: 1}, nil)
+	store.EXPECT().CreatePromotionTx(gomock.Any(), gomock.Any()).Return(db.Promotion{}, db.ErrPromotionOverlap)
+
+	now := time.Now()
+	_, err := newTestService(store).Request(context.Background(), models.CreatePromotionParams{
+		EventID:  1,
+		UserID:   1,
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	})
+
+	servicetest.RequireAppError(t, err, apperror.PromotionConflict)
+}
+
+func TestApproveOpenWindowSyncs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	now := time.Now()
+	store.EXPECT().
+		ReviewPromotion(gomock.Any(), gomock.Any()).
+		Return(db.Promotion{ID: 3, EventID: 1, Status: models.PromotionApproved, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)}, nil)
+	store.EXPECT().
+		SyncPromotionsTx(gomock.Any()).
+		Times(1).
+		Return(db.SyncPromotionsTxResult{Activated: []int32{1}}, nil)
+
+	promotion, err := newTestService(store).Approve(context.Background(), 3, 9)
+	require.NoError(t, err)
+	require.Equal(t, models.PromotionActive, promotion.Status)
+}
+
+func TestApproveFutureWindow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	now := time.Now()
+	store.EXPECT().
+		ReviewPromotion(gomock.Any(), gomock.Any()).
+		Return(db.Promotion{ID: 3, EventID: 1, Status: models.PromotionApproved, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}, nil)
+	store.EXPECT().SyncPromotionsTx(gomock.Any()).Times(0)
+
+	promotion, err := newTestService(store).Approve(context.Background(), 3, 9)
+	require.NoError(t, err)
+	require.Equal(t, models.PromotionApproved, promotion.Status)
+}
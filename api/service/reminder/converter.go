@@ -0,0 +1,26 @@
+package reminderservice
+
+import (
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+	"treffly/push"
+)
+
+func convertPreferences(p db.GetReminderPreferencesRow) models.ReminderPreferences {
+	return models.ReminderPreferences{
+		EmailEnabled: p.EmailEnabled,
+		PushEnabled:  p.PushEnabled,
+	}
+}
+
+func convertPushSubscriptions(rows []db.PushSubscription) []push.Subscription {
+	result := make([]push.Subscription, len(rows))
+	for i, r := range rows {
+		result[i] = push.Subscription{
+			Endpoint: r.Endpoint,
+			P256dh:   r.P256dh,
+			Auth:     r.Auth,
+		}
+	}
+	return result
+}
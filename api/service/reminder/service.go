@@ -0,0 +1,297 @@
+package reminderservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/delivery"
+	"treffly/util"
+)
+
+const (
+	statusSent    = "sent"
+	statusSkipped = "skipped"
+
+	dueRemindersBatchSize = 500
+	// reminderDeliveryLease keeps a claimed delivery from being claimed again
+	// while it is being sent.
+	reminderDeliveryLease = 5 * time.Minute
+)
+
+// reminderKind describes one reminder sent before an event. An event is due
+// when it starts within (from, to] from now.
+type reminderKind struct {
+	name  string
+	from  time.Duration
+	to    time.Duration
+	title string
+	body  string
+}
+
+var reminderKinds = []reminderKind{
+	{
+		name:  "24h",
+		from:  time.Hour,
+		to:    24 * time.Hour,
+		title: "Завтра: %s",
+		body:  "Событие «%s» начнётся меньше чем через сутки.",
+	},
+	{
+		name:  "1h",
+		from:  0,
+		to:    time.Hour,
+		title: "Скоро начало: %s",
+		body:  "Событие «%s» начнётся меньше чем через час.",
+	},
+}
+
+type Service struct {
+	store    db.Store
+	channels []delivery.Channel
+	config   util.Config
+	log      *zap.Logger
+}
+
+func New(store db.Store, channels []delivery.Channel, config util.Config, log *zap.Logger) *Service {
+	return &Service{
+		store:    store,
+		channels: channels,
+		config:   config,
+		log:      log,
+	}
+}
+
+// Run is called by the scheduler. Every delivery is claimed in
+// reminder_deliveries before it is sent, so a reminder goes out once per
+// channel even across restarts; failed deliveries are retried with backoff
+// until they run out of attempts, and are not listed while they wait.
+func (s *Service) Run(ctx context.Context) error {
+	now := time.Now()
+	for _, kind := range reminderKinds {
+		if err := s.sendDue(ctx, kind, now); err != nil {
+			return fmt.Errorf("send %s reminders: %w", kind.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) sendDue(ctx context.Context, kind reminderKind, now time.Time) error {
+	rows, err := s.store.ListDueReminders(ctx, db.ListDueRemindersParams{
+		DateFrom:    now.Add(kind.from),
+		DateTo:      now.Add(kind.to),
+		Kind:        kind.name,
+		MaxAttempts: int32(s.config.ReminderMaxAttempts),
+		Channels:    int32(len(s.channels)),
+		Lim:         dueRemindersBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		to, err := s.recipient(ctx, row)
+		if err != nil {
+			return err
+		}
+
+		msg := delivery.Message{
+			Title: fmt.Sprintf(kind.title, row.EventName),
+			Body:  fmt.Sprintf(kind.body, row.EventName),
+			URL:   common.AppURL(s.config.Environment, s.config.Domain, fmt.Sprintf("/events/%d", row.EventID)),
+		}
+
+		for _, channel := range s.channels {
+			s.deliver(ctx, channel, kind, row, to, msg)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) recipient(ctx context.Context, row db.ListDueRemindersRow) (delivery.Recipient, error) {
+	to := delivery.Recipient{
+		UserID:   row.UserID,
+		Username: row.Username,
+		Email:    row.Email,
+	}
+
+	if row.PushEnabled {
+		subs, err := s.store.ListUserPushSubscriptions(ctx, row.UserID)
+		if err != nil {
+			return delivery.Recipient{}, err
+		}
+		to.PushSubscriptions = convertPushSubscriptions(subs)
+	}
+
+	return to, nil
+}
+
+func (s *Service) deliver(ctx context.Context, channel delivery.Channel, kind reminderKind, row db.ListDueRemindersRow, to delivery.Recipient, msg delivery.Message) {
+	key := db.ClaimReminderDeliveryParams{
+		EventID:      row.EventID,
+		UserID:       row.UserID,
+		Kind:         kind.name,
+		Channel:      channel.Name(),
+		LeaseSeconds: int32(reminderDeliveryLease / time.Second),
+		MaxAttempts:  int32(s.config.ReminderMaxAttempts),
+	}
+	log := s.log.With(
+		zap.Int32("event_id", row.EventID),
+		zap.Int32("user_id", row.UserID),
+		zap.String("kind", kind.name),
+		zap.String("channel", channel.Name()),
+	)
+
+	attempts, err := s.store.ClaimReminderDelivery(ctx, key)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Warn("claim reminder delivery", zap.Error(err))
+		}
+		return
+	}
+
+	status := statusSent
+	if !channelEnabled(channel.Name(), row) {
+		status = statusSkipped
+	} else if err := channel.Deliver(ctx, to, msg); err != nil {
+		if !errors.Is(err, delivery.ErrNoAddress) {
+			s.recordFailure(ctx, log, key, int(attempts)+1, err)
+			return
+		}
+		status = statusSkipped
+	}
+
+	err = s.store.SetReminderDeliveryStatus(ctx, db.SetReminderDeliveryStatusParams{
+		Status:  status,
+		EventID: key.EventID,
+		UserID:  key.UserID,
+		Kind:    key.Kind,
+		Channel: key.Channel,
+	})
+	if err != nil {
+		log.Warn("set reminder delivery status", zap.Error(err))
+	}
+}
+
+// recordFailure schedules the next attempt of a failed delivery, or logs that
+// it gave up once the delivery runs out of attempts.
+func (s *Service) recordFailure(ctx context.Context, log *zap.Logger, key db.ClaimReminderDeliveryParams, attempts int, deliverErr error) {
+	err := s.store.RecordReminderDeliveryFailure(ctx, db.RecordReminderDeliveryFailureParams{
+		NextAttemptAt: time.Now().Add(retryDelay(attempts)),
+		EventID:       key.EventID,
+		UserID:        key.UserID,
+		Kind:          key.Kind,
+		Channel:       key.Channel,
+	})
+	if err != nil {
+		log.Error("record reminder delivery failure", zap.Error(err))
+		return
+	}
+
+	if attempts >= s.config.ReminderMaxAttempts {
+		log.Error("reminder delivery gave up", zap.Int("attempts", attempts), zap.Error(deliverErr))
+		return
+	}
+	log.Warn("deliver reminder", zap.Int("attempts", attempts), zap.Error(deliverErr))
+}
+
+// retryDelay returns the delay before retry number attempt (starting at 1):
+// a minute doubled on every attempt and capped at half an hour, since a
+// reminder is only useful before the event starts.
+func retryDelay(attempt int) time.Duration {
+	const (
+		base    = time.Minute
+		maximum = 30 * time.Minute
+	)
+
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 10 {
+		return maximum
+	}
+
+	return min(base<<(attempt-1), maximum)
+}
+
+func channelEnabled(channel string, row db.ListDueRemindersRow) bool {
+	switch channel {
+	case delivery.ChannelEmail:
+		return row.EmailEnabled
+	case delivery.ChannelPush:
+		return row.PushEnabled
+	default:
+		return true
+	}
+}
+
+func (s *Service) GetPreferences(ctx context.Context, userID int32) (models.ReminderPreferences, error) {
+	prefs, err := s.store.GetReminderPreferences(ctx, userID)
+	if err != nil {
+		return models.ReminderPreferences{}, err
+	}
+
+	return convertPreferences(prefs), nil
+}
+
+func (s *Service) UpdatePreferences(ctx context.Context, userID int32, prefs models.ReminderPreferences) (models.ReminderPreferences, error) {
+	updated, err := s.store.UpsertReminderPreferences(ctx, db.UpsertReminderPreferencesParams{
+		UserID:       userID,
+		EmailEnabled: prefs.EmailEnabled,
+		PushEnabled:  prefs.PushEnabled,
+	})
+	if err != nil {
+		return models.ReminderPreferences{}, err
+	}
+
+	return models.ReminderPreferences{
+		EmailEnabled: updated.EmailEnabled,
+		PushEnabled:  updated.PushEnabled,
+	}, nil
+}
+
+func (s *Service) SavePushSubscription(ctx context.Context, params models.PushSubscriptionParams) error {
+	_, err := s.store.UpsertPushSubscription(ctx, db.UpsertPushSubscriptionParams{
+		UserID:   params.UserID,
+		Endpoint: params.Endpoint,
+		P256dh:   params.P256dh,
+		Auth:     params.Auth,
+	})
+	return err
+}
+
+func (s *Service) DeletePushSubscription(ctx context.Context, userID int32, endpoint string) error {
+	deleted, err := s.store.DeletePushSubscription(ctx, db.DeletePushSubscriptionParams{
+		UserID:   userID,
+		Endpoint: endpoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// ForgetPushSubscription drops a subscription the push service no longer
+// accepts.
+func (s *Service) ForgetPushSubscription(ctx context.Context, endpoint string) {
+	if err := s.store.DeletePushSubscriptionByEndpoint(ctx, endpoint); err != nil {
+		s.log.Warn("delete expired push subscription", zap.Error(err))
+	}
+}
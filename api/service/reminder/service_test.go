@@ -0,0 +1,179 @@
+package reminderservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/delivery"
+	"treffly/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func dueRow(emailEnabled, pushEnabled bool) db.ListDueRemindersRow {
+	return db.ListDueRemindersRow{
+		UserID:       2,
+		Username:     "anna",
+		Email:        "anna@example.com",
+		EventID:      10,
+		EventName:    "Пикник",
+		EventDate:    time.Now().Add(12 * time.Hour),
+		EmailEnabled: emailEnabled,
+		PushEnabled:  pushEnabled,
+	}
+}
+
+func claimKey(kind, channel string) db.ClaimReminderDeliveryParams {
+	return db.ClaimReminderDeliveryParams{
+		EventID:      10,
+		UserID:       2,
+		Kind:         kind,
+		Channel:      channel,
+		LeaseSeconds: int32(reminderDeliveryLease / time.Second),
+		MaxAttempts:  3,
+	}
+}
+
+func statusParams(kind, channel, status string) db.SetReminderDeliveryStatusParams {
+	return db.SetReminderDeliveryStatusParams{Status: status, EventID: 10, UserID: 2, Kind: kind, Channel: channel}
+}
+
+// expectDue makes the 24h window return rows and the 1h window return none.
+func expectDue(store *mockdb.MockStore, channels int32, rows ...db.ListDueRemindersRow) {
+	store.EXPECT().
+		ListDueReminders(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.ListDueRemindersParams) ([]db.ListDueRemindersRow, error) {
+			if arg.Channels != channels || arg.MaxAttempts != 3 {
+				return nil, errors.New("unexpected channels count")
+			}
+			if arg.Kind == "24h" {
+				return rows, nil
+			}
+			return nil, nil
+		}).
+		Times(2)
+}
+
+func newService(store db.Store, channels ...delivery.Channel) *Service {
+	return New(store, channels, util.Config{Domain: "treffly.ru", Environment: "production", ReminderMaxAttempts: 3}, zap.NewNop())
+}
+
+func TestRunSendsReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	email := delivery.NewFakeChannel(delivery.ChannelEmail)
+
+	expectDue(store, 1, dueRow(true, false))
+	store.EXPECT().ClaimReminderDelivery(gomock.Any(), claimKey("24h", delivery.ChannelEmail)).Return(int32(0), nil)
+	store.EXPECT().SetReminderDeliveryStatus(gomock.Any(), statusParams("24h", delivery.ChannelEmail, statusSent)).Return(nil)
+
+	require.NoError(t, newService(store, email).Run(context.Background()))
+
+	delivered := email.Delivered()
+	require.Len(t, delivered, 1)
+	require.Equal(t, "anna@example.com", delivered[0].To.Email)
+	require.Contains(t, delivered[0].Msg.Title, "Пикник")
+	require.Equal(t, "https://treffly.ru/events/10", delivered[0].Msg.URL)
+}
+
+func TestRunSkipsClaimedReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	email := delivery.NewFakeChannel(delivery.ChannelEmail)
+
+	expectDue(store, 1, dueRow(true, false))
+	store.EXPECT().ClaimReminderDelivery(gomock.Any(), claimKey("24h", delivery.ChannelEmail)).Return(int32(0), pgx.ErrNoRows)
+
+	require.NoError(t, newService(store, email).Run(context.Background()))
+	require.Empty(t, email.Delivered())
+}
+
+func TestRunRespectsOptOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	email := delivery.NewFakeChannel(delivery.ChannelEmail)
+	push := delivery.NewFakeChannel(delivery.ChannelPush)
+
+	expectDue(store, 2, dueRow(false, true))
+	store.EXPECT().ListUserPushSubscriptions(gomock.Any(), int32(2)).Return([]db.PushSubscription{{Endpoint: "https://push.example/1"}}, nil)
+	store.EXPECT().ClaimReminderDelivery(gomock.Any(), claimKey("24h", delivery.ChannelEmail)).Return(int32(0), nil)
+	store.EXPECT().SetReminderDeliveryStatus(gomock.Any(), statusParams("24h", delivery.ChannelEmail, statusSkipped)).Return(nil)
+	store.EXPECT().ClaimReminderDelivery(gomock.Any(), claimKey("24h", delivery.ChannelPush)).Return(int32(0), nil)
+	store.EXPECT().SetReminderDeliveryStatus(gomock.Any(), statusParams("24h", delivery.ChannelPush, statusSent)).Return(nil)
+
+	require.NoError(t, newService(store, email, push).Run(context.Background()))
+	require.Empty(t, email.Delivered())
+	require.Len(t, push.Delivered(), 1)
+	require.Len(t, push.Delivered()[0].To.PushSubscriptions, 1)
+}
+
+func TestRunSchedulesRetryForFailedDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	email := delivery.NewFakeChannel(delivery.ChannelEmail)
+	email.Err = errors.New("smtp down")
+
+	expectDue(store, 1, dueRow(true, false))
+	store.EXPECT().ClaimReminderDelivery(gomock.Any(), claimKey("24h", delivery.ChannelEmail)).Return(int32(1), nil)
+	store.EXPECT().
+		RecordReminderDeliveryFailure(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.RecordReminderDeliveryFailureParams) error {
+			require.Equal(t, int32(10), arg.EventID)
+			require.Equal(t, delivery.ChannelEmail, arg.Channel)
+			require.WithinDuration(t, time.Now().Add(2*time.Minute), arg.NextAttemptAt, 5*time.Second)
+			return nil
+		})
+	store.EXPECT().SetReminderDeliveryStatus(gomock.Any(), gomock.Any()).Times(0)
+
+	require.NoError(t, newService(store, email).Run(context.Background()))
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, time.Minute, retryDelay(0))
+	require.Equal(t, time.Minute, retryDelay(1))
+	require.Equal(t, 8*time.Minute, retryDelay(4))
+	require.Equal(t, 30*time.Minute, retryDelay(6))
+	require.Equal(t, 30*time.Minute, retryDelay(100))
+}
+
+func TestRunMarksUnreachableSkipped(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	push := delivery.NewFakeChannel(delivery.ChannelPush)
+	push.Err = delivery.ErrNoAddress
+
+	expectDue(store, 1, dueRow(true, true))
+	store.EXPECT().ListUserPushSubscriptions(gomock.Any(), int32(2)).Return([]db.PushSubscription{}, nil)
+	store.EXPECT().ClaimReminderDelivery(gomock.Any(), claimKey("24h", delivery.ChannelPush)).Return(int32(0), nil)
+	store.EXPECT().SetReminderDeliveryStatus(gomock.Any(), statusParams("24h", delivery.ChannelPush, statusSkipped)).Return(nil)
+
+	require.NoError(t, newService(store, push).Run(context.Background()))
+}
+
+func TestRunListError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListDueReminders(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+	require.Error(t, newService(store, delivery.NewFakeChannel(delivery.ChannelEmail)).Run(context.Background()))
+}
+
+func TestDeletePushSubscriptionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		DeletePushSubscription(gomock.Any(), db.DeletePushSubscriptionParams{UserID: 2, Endpoint: "https://push.example/1"}).
+		Return(int64(0), nil)
+
+	err := newService(store).DeletePushSubscription(context.Background(), 2, "https://push.example/1")
+
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
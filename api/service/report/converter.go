@@ -0,0 +1,70 @@
+package reportservice
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertReport(r db.Report) models.Report {
+	return models.Report{
+		ID:         r.ID,
+		ReporterID: r.ReporterID,
+		TargetType: targetType(r.EventID),
+		EventID:    r.EventID.Int32,
+		UserID:     r.UserID.Int32,
+		Reason:     r.Reason,
+		Comment:    r.Comment,
+		Status:     r.Status,
+		ResolvedBy: r.ResolvedBy.Int32,
+		ResolvedAt: safeTime(r.ResolvedAt),
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func convertReports(reports []db.Report) []models.Report {
+	result := make([]models.Report, len(reports))
+	for i, r := range reports {
+		result[i] = convertReport(r)
+	}
+	return result
+}
+
+func convertReportRows(rows []db.ListReportsRow) []models.Report {
+	result := make([]models.Report, len(rows))
+	for i, r := range rows {
+		result[i] = models.Report{
+			ID:               r.ID,
+			ReporterID:       r.ReporterID,
+			ReporterUsername: r.ReporterUsername,
+			TargetType:       targetType(r.EventID),
+			EventID:          r.EventID.Int32,
+			EventName:        r.EventName.String,
+			UserID:           r.UserID.Int32,
+			TargetUsername:   r.TargetUsername.String,
+			Reason:           r.Reason,
+			Comment:          r.Comment,
+			Status:           r.Status,
+			ResolvedBy:       r.ResolvedBy.Int32,
+			ResolvedAt:       safeTime(r.ResolvedAt),
+			CreatedAt:        r.CreatedAt,
+			OpenReports:      r.OpenReports,
+		}
+	}
+	return result
+}
+
+func targetType(eventID pgtype.Int4) string {
+	if eventID.Valid {
+		return models.ReportTargetEvent
+	}
+	return models.ReportTargetUser
+}
+
+func safeTime(t pgtype.Timestamptz) time.Time {
+	if t.Valid {
+		return t.Time
+	}
+	return time.Time{}
+}
@@ -0,0 +1,119 @@
+package reportservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/util"
+)
+
+type Service struct {
+	store  db.Store
+	config util.Config
+	log    *zap.Logger
+}
+
+func New(store db.Store, config util.Config, log *zap.Logger) *Service {
+	return &Service{
+		store:  store,
+		config: config,
+		log:    log,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, params models.CreateReportParams) (models.Report, error) {
+	arg := db.CreateReportParams{
+		ReporterID: params.ReporterID,
+		Reason:     params.Reason,
+		Comment:    params.Comment,
+	}
+
+	switch params.TargetType {
+	case models.ReportTargetEvent:
+		event, err := s.store.GetEvent(ctx, db.GetEventParams{
+			ID:      params.TargetID,
+			OwnerID: params.ReporterID,
+		})
+		if err != nil {
+			return models.Report{}, err
+		}
+		if event.OwnerID == params.ReporterID {
+			return models.Report{}, apperror.BadRequest.WithCause(errors.New("cannot report own event"))
+		}
+		arg.EventID = pgtype.Int4{Int32: params.TargetID, Valid: true}
+	case models.ReportTargetUser:
+		if params.TargetID == params.ReporterID {
+			return models.Report{}, apperror.BadRequest.WithCause(errors.New("cannot report yourself"))
+		}
+		if _, err := s.store.GetUser(ctx, params.TargetID); err != nil {
+			return models.Report{}, err
+		}
+		arg.UserID = pgtype.Int4{Int32: params.TargetID, Valid: true}
+	default:
+		return models.Report{}, fmt.Errorf("unknown report target %q", params.TargetType)
+	}
+
+	result, err := s.store.CreateReportTx(ctx, db.CreateReportTxParams{
+		CreateReportParams: arg,
+		HideThreshold:      int64(s.config.ReportHideThreshold),
+	})
+	if err != nil {
+		return models.Report{}, err
+	}
+
+	if result.EventHidden {
+		s.log.Info("event hidden after reports",
+			zap.Int32("event_id", params.TargetID),
+			zap.Int("threshold", s.config.ReportHideThreshold),
+		)
+	}
+
+	return convertReport(result.Report), nil
+}
+
+func (s *Service) List(ctx context.Context, params models.ListReportsParams) ([]models.Report, error) {
+	rows, err := s.store.ListReports(ctx, db.ListReportsParams{
+		Status:     params.Status,
+		TargetType: params.TargetType,
+		Lim:        params.Limit,
+		Off:        params.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return convertReportRows(rows), nil
+}
+
+func (s *Service) Resolve(ctx context.Context, reportID, moderatorID int32) ([]models.Report, error) {
+	return s.review(ctx, reportID, moderatorID, models.ReportResolved)
+}
+
+func (s *Service) Dismiss(ctx context.Context, reportID, moderatorID int32) ([]models.Report, error) {
+	return s.review(ctx, reportID, moderatorID, models.ReportDismissed)
+}
+
+func (s *Service) review(ctx context.Context, reportID, moderatorID int32, status string) ([]models.Report, error) {
+	reports, err := s.store.ReviewReportTx(ctx, db.ReviewReportTxParams{
+		ReportID:    reportID,
+		ModeratorID: moderatorID,
+		Status:      status,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NotFound.WithCause(fmt.Errorf("no open report %d: %w", reportID, err))
+		}
+		if errors.Is(err, db.ErrProtectedUser) {
+			return nil, apperror.Forbidden.WithCause(err)
+		}
+		return nil, err
+	}
+
+	return convertReports(reports), nil
+}
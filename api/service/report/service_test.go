@@ -0,0 +1,136 @@
+package reportservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/util"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestService(store db.Store) *Service {
+	return New(store, util.Config{ReportHideThreshold: 3}, zap.NewNop())
+}
+
+func TestCreateEventReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		GetEvent(gomock.Any(), db.GetEventParams{ID: 5, OwnerID: 1}).
+		Return(db.GetEventRow{ID: 5, OwnerID: 2}, nil)
+	store.EXPECT().
+		CreateReportTx(gomock.Any(), db.CreateReportTxParams{
+			CreateReportParams: db.CreateReportParams{
+				ReporterID: 1,
+				EventID:    pgtype.Int4{Int32: 5, Valid: true},
+				Reason:     "spam",
+			},
+			HideThreshold: 3,
+		}).
+		Return(db.CreateReportTxResult{
+			Report: db.Report{
+				ID:         10,
+				ReporterID: 1,
+				EventID:    pgtype.Int4{Int32: 5, Valid: true},
+				Reason:     "spam",
+				Status:     models.ReportOpen,
+			},
+			EventHidden: true,
+		}, nil)
+
+	report, err := newTestService(store).Create(context.Background(), models.CreateReportParams{
+		ReporterID: 1,
+		TargetType: models.ReportTargetEvent,
+		TargetID:   5,
+		Reason:     "spam",
+	})
+	require.NoError(t, err)
+	require.Equal(t, models.ReportTargetEvent, report.TargetType)
+	require.Equal(t, int32(5), report.EventID)
+}
+
+func TestCreateOwnEventReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetEvent(gomock.Any(), gomock.Any()).Return(db.GetEventRow{ID: 5, OwnerID: 1}, nil)
+	store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).Create(context.Background(), models.CreateReportParams{
+		ReporterID: 1,
+		TargetType: models.ReportTargetEvent,
+		TargetID:   5,
+		Reason:     "spam",
+	})
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestCreateSelfReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().CreateReportTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := newTestService(store).Create(context.Background(), models.CreateReportParams{
+		ReporterID: 1,
+		TargetType: models.ReportTargetUser,
+		TargetID:   1,
+		Reason:     "abuse",
+	})
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestResolveNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ReviewReportTx(gomock.Any(), db.ReviewReportTxParams{ReportID: 4, ModeratorID: 9, Status: models.ReportResolved}).
+		Return(nil, pgx.ErrNoRows)
+
+	_, err := newTestService(store).Resolve(context.Background(), 4, 9)
+
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestResolveProtectedUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ReviewReportTx(gomock.Any(), db.ReviewReportTxParams{ReportID: 4, ModeratorID: 9, Status: models.ReportResolved}).
+		Return(nil, fmt.Errorf("transaction failed: %w", db.ErrProtectedUser))
+
+	_, err := newTestService(store).Resolve(context.Background(), 4, 9)
+
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestDismiss(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		ReviewReportTx(gomock.Any(), db.ReviewReportTxParams{ReportID: 4, ModeratorID: 9, Status: models.ReportDismissed}).
+		Return([]db.Report{
+			{ID: 4, UserID: pgtype.Int4{Int32: 2, Valid: true}, Status: models.ReportDismissed},
+			{ID: 6, UserID: pgtype.Int4{Int32: 2, Valid: true}, Status: models.ReportDismissed},
+		}, nil)
+
+	reports, err := newTestService(store).Dismiss(context.Background(), 4, 9)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+	for _, r := range reports {
+		require.Equal(t, models.ReportTargetUser, r.TargetType)
+		require.Equal(t, models.ReportDismissed, r.Status)
+	}
+}
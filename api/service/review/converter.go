@@ -0,0 +1,49 @@
+package reviewservice
+
+import (
+	"math"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertReview(r db.Review) models.Review {
+	return models.Review{
+		EventID:   r.EventID,
+		UserID:    r.UserID,
+		Rating:    int(r.Rating),
+		Body:      r.Body,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// convertReviewRows drops the author of reviews whose writers hide their
+// attendance; viewerID still sees their own.
+func convertReviewRows(rows []db.ListEventReviewsRow, viewerID int32) []models.Review {
+	result := make([]models.Review, len(rows))
+	for i, r := range rows {
+		result[i] = models.Review{
+			EventID:       r.EventID,
+			UserID:        r.UserID,
+			Username:      r.Username,
+			UserImagePath: r.UserImagePath.String,
+			Rating:        int(r.Rating),
+			Body:          r.Body,
+			CreatedAt:     r.CreatedAt,
+			UpdatedAt:     r.UpdatedAt,
+		}
+		if r.HideAttendance && r.UserID != viewerID {
+			result[i].UserID = 0
+			result[i].Username = ""
+			result[i].UserImagePath = ""
+		}
+	}
+	return result
+}
+
+func convertRating(average float64, count int64) models.Rating {
+	return models.Rating{
+		Average:      math.Round(average*10) / 10,
+		ReviewsCount: int(count),
+	}
+}
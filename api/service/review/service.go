@@ -0,0 +1,149 @@
+package reviewservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"treffly/api/common"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/moderation"
+)
+
+type eventProvider interface {
+	GetEvent(ctx context.Context, eventID, userID int32, token string) (models.Event, error)
+}
+
+type Service struct {
+	store     db.Store
+	events    eventProvider
+	moderator moderation.Moderator
+}
+
+func New(store db.Store, events eventProvider, moderator moderation.Moderator) *Service {
+	return &Service{
+		store:     store,
+		events:    events,
+		moderator: moderator,
+	}
+}
+
+func (s *Service) List(ctx context.Context, params models.ListReviewsParams) (models.ReviewsPage, error) {
+	if _, err := s.events.GetEvent(ctx, params.EventID, params.UserID, params.Token); err != nil {
+		return models.ReviewsPage{}, err
+	}
+
+	rating, err := s.store.GetEventRating(ctx, params.EventID)
+	if err != nil {
+		return models.ReviewsPage{}, err
+	}
+
+	rows, err := s.store.ListEventReviews(ctx, db.ListEventReviewsParams{
+		EventID: params.EventID,
+		Lim:     params.Limit + 1,
+		Off:     params.Offset,
+	})
+	if err != nil {
+		return models.ReviewsPage{}, err
+	}
+
+	hasMore := len(rows) > int(params.Limit)
+	if hasMore {
+		rows = rows[:params.Limit]
+	}
+
+	return models.ReviewsPage{
+		Rating:  convertRating(rating.Average, rating.ReviewsCount),
+		Reviews: convertReviewRows(rows, params.UserID),
+		HasMore: hasMore,
+	}, nil
+}
+
+// Upsert creates or replaces the user's review. Only participants may review,
+// only once the event has started, and never their own event.
+func (s *Service) Upsert(ctx context.Context, params models.UpsertReviewParams) (models.Review, error) {
+	event, err := s.events.GetEvent(ctx, params.EventID, params.UserID, "")
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if err := canReview(event, time.Now()); err != nil {
+		return models.Review{}, err
+	}
+
+	joinedAt, err := s.store.GetParticipantJoinedAt(ctx, db.GetParticipantJoinedAtParams{
+		EventID: params.EventID,
+		UserID:  params.UserID,
+	})
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	if !joinedAt.Before(event.Date) {
+		return models.Review{}, apperror.Forbidden.WithCause(fmt.Errorf("user joined event %d after it took place", event.ID))
+	}
+
+	if err := common.Moderate(ctx, s.moderator, moderation.Field{Name: "body", Text: params.Body}); err != nil {
+		return models.Review{}, err
+	}
+
+	review, err := s.store.UpsertReview(ctx, db.UpsertReviewParams{
+		EventID: params.EventID,
+		UserID:  params.UserID,
+		Rating:  int16(params.Rating),
+		Body:    params.Body,
+	})
+	if err != nil {
+		return models.Review{}, err
+	}
+
+	return convertReview(review), nil
+}
+
+func (s *Service) Delete(ctx context.Context, eventID, userID int32) error {
+	deleted, err := s.store.DeleteReview(ctx, db.DeleteReviewParams{
+		EventID: eventID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (s *Service) GetOrganizerRating(ctx context.Context, userID int32) (models.Rating, error) {
+	if _, err := s.store.GetUser(ctx, userID); err != nil {
+		return models.Rating{}, err
+	}
+
+	rating, err := s.store.GetOrganizerRating(ctx, userID)
+	if err != nil {
+		return models.Rating{}, err
+	}
+
+	return convertRating(rating.Average, rating.ReviewsCount), nil
+}
+
+func canReview(event models.Event, now time.Time) error {
+	if event.IsOwner {
+		return apperror.Forbidden.WithCause(errors.New("owners cannot review their own events"))
+	}
+
+	if !event.IsParticipant {
+		return apperror.Forbidden.WithCause(fmt.Errorf("user is not a participant of event %d", event.ID))
+	}
+
+	if event.Date.After(now) {
+		return apperror.BadRequest.WithCause(fmt.Errorf("event %d has not taken place yet", event.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package reviewservice
+
+import (
+	"context"
+	"testing"
+	"time"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/moderation"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestService(store db.Store, event models.Event) *Service {
+	return New(store, servicetest.Events{event.ID: event}, moderation.NewWordListModerator(moderation.DefaultWords, nil))
+}
+
+func TestUpsertRejected(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+
+	testCases := []struct {
+		name  string
+		event models.Event
+		want  apperror.ErrorTemplate
+	}{
+		{
+			name:  "Owner",
+			event: models.Event{ID: 10, OwnerID: 2, Date: past},
+			want:  apperror.Forbidden,
+		},
+		{
+			name:  "NotParticipant",
+			event: models.Event{ID: 10, Date: past},
+			want:  apperror.Forbidden,
+		},
+		{
+			name:  "NotFinished",
+			event: models.Event{ID: 10, IsParticipant: true, Date: future},
+			want:  apperror.BadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			store := mockdb.NewMockStore(ctrl)
+			store.EXPECT().UpsertReview(gomock.Any(), gomock.Any()).Times(0)
+
+			_, err := newTestService(store, tc.event).Upsert(context.Background(), models.UpsertReviewParams{
+				EventID: 10,
+				UserID:  2,
+				Rating:  5,
+			})
+			servicetest.RequireAppError(t, err, tc.want)
+		})
+	}
+}
+
+func TestUpsertOK(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	now := time.Now()
+	store.EXPECT().
+		GetParticipantJoinedAt(gomock.Any(), db.GetParticipantJoinedAtParams{EventID: 10, UserID: 2}).
+		Return(now.Add(-24*time.Hour), nil)
+	store.EXPECT().
+		UpsertReview(gomock.Any(), db.UpsertReviewParams{EventID: 10, UserID: 2, Rating: 4, Body: "Отличная прогулка"}).
+		Return(db.Review{EventID: 10, UserID: 2, Rating: 4, Body: "Отличная прогулка", CreatedAt: now, UpdatedAt: now}, nil)
+
+	event := models.Event{ID: 10, IsParticipant: true, Date: now.Add(-time.Hour)}
+	review, err := newTestService(store, event).Upsert(context.Background(), models.UpsertReviewParams{
+		EventID: 10,
+		UserID:  2,
+		Rating:  4,
+		Body:    "Отличная прогулка",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, review.Rating)
+}
+
+func TestUpsertJoinedAfterEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	now := time.Now()
+	store.EXPECT().
+		GetParticipantJoinedAt(gomock.Any(), db.GetParticipantJoinedAtParams{EventID: 10, UserID: 2}).
+		Return(now, nil)
+	store.EXPECT().UpsertReview(gomock.Any(), gomock.Any()).Times(0)
+
+	event := models.Event{ID: 10, IsParticipant: true, Date: now.Add(-time.Hour)}
+	_, err := newTestService(store, event).Upsert(context.Background(), models.UpsertReviewParams{
+		EventID: 10,
+		UserID:  2,
+		Rating:  4,
+	})
+	servicetest.RequireAppError(t, err, apperror.Forbidden)
+}
+
+func TestListRoundsAverage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().GetEventRating(gomock.Any(), int32(10)).
+		Return(db.GetEventRatingRow{Average: 4.333333, ReviewsCount: 3}, nil)
+	store.EXPECT().ListEventReviews(gomock.Any(), db.ListEventReviewsParams{EventID: 10, Lim: 3, Off: 0}).
+		Return([]db.ListEventReviewsRow{{EventID: 10, UserID: 2, Rating: 5}}, nil)
+
+	page, err := newTestService(store, models.Event{ID: 10}).List(context.Background(), models.ListReviewsParams{EventID: 10, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 4.3, page.Rating.Average)
+	require.Equal(t, 3, page.Rating.ReviewsCount)
+	require.False(t, page.HasMore)
+	require.Len(t, page.Reviews, 1)
+}
+
+func TestListHidesAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().GetEventRating(gomock.Any(), int32(10)).
+		Return(db.GetEventRatingRow{Average: 4, ReviewsCount: 2}, nil)
+	store.EXPECT().ListEventReviews(gomock.Any(), gomock.Any()).
+		Return([]db.ListEventReviewsRow{
+			{EventID: 10, UserID: 2, Username: "anna", Rating: 5, HideAttendance: true},
+			{EventID: 10, UserID: 3, Username: "boris", Rating: 3, HideAttendance: true},
+		}, nil)
+
+	page, err := newTestService(store, models.Event{ID: 10}).List(context.Background(), models.ListReviewsParams{EventID: 10, UserID: 3, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Reviews, 2)
+	require.Zero(t, page.Reviews[0].UserID)
+	require.Empty(t, page.Reviews[0].Username)
+	require.Equal(t, 5, page.Reviews[0].Rating)
+	require.Equal(t, "boris", page.Reviews[1].Username)
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().DeleteReview(gomock.Any(), db.DeleteReviewParams{EventID: 10, UserID: 2}).Return(int64(0), nil)
+
+	err := newTestService(store, models.Event{ID: 10}).Delete(context.Background(), 10, 2)
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
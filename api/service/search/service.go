@@ -0,0 +1,86 @@
+package searchservice
+
+import (
+	"context"
+	"strings"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+	"unicode/utf8"
+)
+
+const (
+	minQueryLength        = 2
+	eventSuggestLimit     = 5
+	tagSuggestLimit       = 3
+	organizerSuggestLimit = 3
+)
+
+type Service struct {
+	store db.Store
+}
+
+func New(store db.Store) *Service {
+	return &Service{
+		store: store,
+	}
+}
+
+func (s *Service) Suggest(ctx context.Context, params models.SuggestParams) ([]models.Suggestion, error) {
+	query := strings.TrimSpace(params.Query)
+	if utf8.RuneCountInString(query) < minQueryLength {
+		return []models.Suggestion{}, nil
+	}
+
+	events, err := s.store.SuggestEvents(ctx, db.SuggestEventsParams{
+		SearchTerm:  query,
+		UserLon:     params.Lon,
+		UserLat:     params.Lat,
+		ResultLimit: eventSuggestLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.store.SuggestTags(ctx, db.SuggestTagsParams{
+		SearchTerm:  query,
+		ResultLimit: tagSuggestLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	organizers, err := s.store.SuggestOrganizers(ctx, db.SuggestOrganizersParams{
+		SearchTerm:  query,
+		ResultLimit: organizerSuggestLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Suggestion, 0, len(events)+len(tags)+len(organizers))
+	for _, e := range events {
+		result = append(result, models.Suggestion{
+			Type: models.SuggestionEvent,
+			ID:   e.ID,
+			Text: e.Name,
+			Date: e.Date,
+		})
+	}
+	for _, t := range tags {
+		result = append(result, models.Suggestion{
+			Type: models.SuggestionTag,
+			ID:   t.ID,
+			Text: t.Name,
+		})
+	}
+	for _, o := range organizers {
+		result = append(result, models.Suggestion{
+			Type:      models.SuggestionOrganizer,
+			ID:        o.ID,
+			Text:      o.Username,
+			ImagePath: o.ImagePath.String,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package searchservice
+
+import (
+	"context"
+	"testing"
+	"time"
+	"treffly/api/models"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSuggestShortQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	suggestions, err := New(store).Suggest(context.Background(), models.SuggestParams{Query: " к "})
+	require.NoError(t, err)
+	require.Empty(t, suggestions)
+}
+
+func TestSuggestMixesTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	date := time.Now().Add(24 * time.Hour)
+	store.EXPECT().
+		SuggestEvents(gomock.Any(), gomock.Eq(db.SuggestEventsParams{SearchTerm: "кон", ResultLimit: eventSuggestLimit})).
+		Return([]db.SuggestEventsRow{{ID: 1, Name: "Концерт", Date: date}}, nil)
+	store.EXPECT().
+		SuggestTags(gomock.Any(), gomock.Eq(db.SuggestTagsParams{SearchTerm: "кон", ResultLimit: tagSuggestLimit})).
+		Return([]db.Tag{{ID: 2, Name: "Концерты"}}, nil)
+	store.EXPECT().
+		SuggestOrganizers(gomock.Any(), gomock.Eq(db.SuggestOrganizersParams{SearchTerm: "кон", ResultLimit: organizerSuggestLimit})).
+		Return([]db.SuggestOrganizersRow{{ID: 3, Username: "konstantin", ImagePath: pgtype.Text{String: "users/3.png", Valid: true}}}, nil)
+
+	suggestions, err := New(store).Suggest(context.Background(), models.SuggestParams{Query: "кон"})
+	require.NoError(t, err)
+	require.Equal(t, []models.Suggestion{
+		{Type: models.SuggestionEvent, ID: 1, Text: "Концерт", Date: date},
+		{Type: models.SuggestionTag, ID: 2, Text: "Концерты"},
+		{Type: models.SuggestionOrganizer, ID: 3, Text: "konstantin", ImagePath: "users/3.png"},
+	}, suggestions)
+}
@@ -0,0 +1,48 @@
+// Package servicetest holds the fakes and assertions shared by the service
+// tests.
+package servicetest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"treffly/api/models"
+	"treffly/apperror"
+)
+
+// Events stands in for eventservice where a service only asks whether the
+// caller may see an event. Events missing from the map are reported as
+// sql.ErrNoRows, the way hidden and inaccessible private events are, and
+// IsOwner is derived from OwnerID.
+type Events map[int32]models.Event
+
+func (e Events) GetEvent(_ context.Context, eventID, userID int32, _ string) (models.Event, error) {
+	event, ok := e[eventID]
+	if !ok {
+		return models.Event{}, sql.ErrNoRows
+	}
+	event.IsOwner = event.OwnerID == userID
+	return event, nil
+}
+
+func (e Events) RequireOwner(ctx context.Context, eventID, userID int32) error {
+	event, err := e.GetEvent(ctx, eventID, userID, "")
+	if err != nil {
+		return err
+	}
+	if !event.IsOwner {
+		return apperror.Forbidden.WithCause(errors.New("not the event owner"))
+	}
+	return nil
+}
+
+// RequireAppError asserts that err is an apperror with the status of want.
+func RequireAppError(t *testing.T, err error, want apperror.ErrorTemplate) {
+	t.Helper()
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, want.HTTPCode, appErr.HTTPCode)
+}
@@ -0,0 +1,123 @@
+package streamservice
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+	"treffly/eventbus"
+)
+
+// EventTypes are the domain events that change what event pages show.
+var EventTypes = []string{
+	eventbus.TypeParticipantJoined,
+	eventbus.TypeParticipantLeft,
+	eventbus.TypeEventUpdated,
+	eventbus.TypeEventDeleted,
+}
+
+type eventStream interface {
+	Publish(ctx context.Context, eventID int32, msgType string, data []byte) error
+	LastID(ctx context.Context, eventID int32) (string, error)
+	Read(ctx context.Context, eventID int32, after string, block time.Duration) ([]models.EventStreamMessage, error)
+}
+
+type Service struct {
+	store  db.Store
+	stream eventStream
+}
+
+func New(store db.Store, stream eventStream) *Service {
+	return &Service{
+		store:  store,
+		stream: stream,
+	}
+}
+
+type participantsData struct {
+	ParticipantsCount int64 `json:"participants_count"`
+}
+
+type updatedData struct {
+	EventID           int32 `json:"event_id"`
+	ParticipantsCount int64 `json:"participants_count"`
+}
+
+type cancelledData struct {
+	EventID int32 `json:"event_id"`
+}
+
+// HandleEvent is subscribed to the event bus and appends the change to the
+// event's stream. Counts are read when the message is handled, so a
+// redelivered or reordered message still publishes the current value.
+func (s *Service) HandleEvent(ctx context.Context, msg eventbus.Message) error {
+	switch e := msg.Event.(type) {
+	case eventbus.ParticipantJoined:
+		return s.publishParticipants(ctx, e.EventID)
+	case eventbus.ParticipantLeft:
+		return s.publishParticipants(ctx, e.EventID)
+	case eventbus.EventUpdated:
+		count, err := s.store.CountEventParticipants(ctx, e.EventID)
+		if err != nil {
+			return err
+		}
+		return s.publish(ctx, e.EventID, models.EventStreamUpdated, updatedData{
+			EventID:           e.EventID,
+			ParticipantsCount: count,
+		})
+	case eventbus.EventDeleted:
+		return s.publish(ctx, e.EventID, models.EventStreamCancelled, cancelledData{EventID: e.EventID})
+	}
+
+	return nil
+}
+
+func (s *Service) publishParticipants(ctx context.Context, eventID int32) error {
+	count, err := s.store.CountEventParticipants(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	return s.publish(ctx, eventID, models.EventStreamParticipants, participantsData{ParticipantsCount: count})
+}
+
+func (s *Service) publish(ctx context.Context, eventID int32, msgType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return s.stream.Publish(ctx, eventID, msgType, payload)
+}
+
+// Open starts a fresh subscription: it returns the participant count tagged
+// with the newest stream id. The id is read before the count, so a change
+// that lands in between is both in the count and replayed after the id; the
+// client may see it twice but never loses it.
+func (s *Service) Open(ctx context.Context, eventID int32) (models.EventStreamMessage, error) {
+	cursor, err := s.stream.LastID(ctx, eventID)
+	if err != nil {
+		return models.EventStreamMessage{}, err
+	}
+
+	participants, err := s.store.CountEventParticipants(ctx, eventID)
+	if err != nil {
+		return models.EventStreamMessage{}, err
+	}
+
+	payload, err := json.Marshal(participantsData{ParticipantsCount: participants})
+	if err != nil {
+		return models.EventStreamMessage{}, err
+	}
+
+	return models.EventStreamMessage{
+		ID:   cursor,
+		Type: models.EventStreamParticipants,
+		Data: payload,
+	}, nil
+}
+
+func (s *Service) Read(ctx context.Context, eventID int32, after string, block time.Duration) ([]models.EventStreamMessage, error) {
+	return s.stream.Read(ctx, eventID, after, block)
+}
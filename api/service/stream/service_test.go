@@ -0,0 +1,99 @@
+package streamservice
+
+import (
+	"context"
+	"testing"
+	"time"
+	"treffly/api/models"
+	mockdb "treffly/db/mock"
+	"treffly/eventbus"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type published struct {
+	eventID int32
+	msgType string
+	data    string
+}
+
+type fakeStream struct {
+	published []published
+	lastID    string
+}
+
+func (f *fakeStream) Publish(_ context.Context, eventID int32, msgType string, data []byte) error {
+	f.published = append(f.published, published{eventID: eventID, msgType: msgType, data: string(data)})
+	return nil
+}
+
+func (f *fakeStream) LastID(context.Context, int32) (string, error) {
+	return f.lastID, nil
+}
+
+func (f *fakeStream) Read(context.Context, int32, string, time.Duration) ([]models.EventStreamMessage, error) {
+	return nil, nil
+}
+
+func TestHandleParticipantJoined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	stream := &fakeStream{}
+
+	store.EXPECT().CountEventParticipants(gomock.Any(), int32(10)).Return(int64(4), nil)
+
+	err := New(store, stream).HandleEvent(context.Background(), eventbus.Message{
+		ID:    1,
+		Event: eventbus.ParticipantJoined{EventID: 10, UserID: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, stream.published, 1)
+	require.Equal(t, int32(10), stream.published[0].eventID)
+	require.Equal(t, models.EventStreamParticipants, stream.published[0].msgType)
+	require.JSONEq(t, `{"participants_count": 4}`, stream.published[0].data)
+}
+
+func TestHandleEventUpdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	stream := &fakeStream{}
+
+	store.EXPECT().CountEventParticipants(gomock.Any(), int32(10)).Return(int64(2), nil)
+
+	err := New(store, stream).HandleEvent(context.Background(), eventbus.Message{
+		Event: eventbus.EventUpdated{EventID: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, stream.published, 1)
+	require.Equal(t, models.EventStreamUpdated, stream.published[0].msgType)
+	require.JSONEq(t, `{"event_id": 10, "participants_count": 2}`, stream.published[0].data)
+}
+
+func TestHandleEventDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	stream := &fakeStream{}
+
+	err := New(store, stream).HandleEvent(context.Background(), eventbus.Message{
+		Event: eventbus.EventDeleted{EventID: 10, OwnerID: 1, Name: "Пикник"},
+	})
+	require.NoError(t, err)
+	require.Len(t, stream.published, 1)
+	require.Equal(t, models.EventStreamCancelled, stream.published[0].msgType)
+	require.JSONEq(t, `{"event_id": 10}`, stream.published[0].data)
+}
+
+func TestOpenReturnsSnapshotAtLastID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	stream := &fakeStream{lastID: "1700000000000-3"}
+
+	store.EXPECT().CountEventParticipants(gomock.Any(), int32(10)).Return(int64(7), nil)
+
+	snapshot, err := New(store, stream).Open(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, "1700000000000-3", snapshot.ID)
+	require.Equal(t, models.EventStreamParticipants, snapshot.Type)
+	require.JSONEq(t, `{"participants_count": 7}`, string(snapshot.Data))
+}
@@ -2,9 +2,12 @@ package tokenservice
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go.uber.org/zap"
 	"time"
+	"treffly/api/models"
+	"treffly/apperror"
 	db "treffly/db/sqlc"
 	"treffly/token"
 	"treffly/util"
@@ -26,10 +29,10 @@ func New(store db.Store, tokenMaker token.Maker, config util.Config, log *zap.Lo
 	}
 }
 
-func (s *Service) RefreshTokens(ctx context.Context, reqRefreshToken string) (accessToken string, refreshToken string, err error) {
+func (s *Service) RefreshTokens(ctx context.Context, reqRefreshToken string, meta models.SessionMeta) (accessToken string, refreshToken string, err error) {
 	reqRefreshPayload, err := s.tokenMaker.VerifyToken(reqRefreshToken)
 	if err != nil {
-		return "", "", err
+		return "", "", apperror.TokenExpired.WithCause(err)
 	}
 
 	session, err := s.store.GetSession(ctx, reqRefreshPayload.ID)
@@ -38,47 +41,63 @@ func (s *Service) RefreshTokens(ctx context.Context, reqRefreshToken string) (ac
 	}
 
 	if session.IsBlocked {
-		err := fmt.Errorf("blocked session")
-		return "", "", err
+		return "", "", apperror.SessionRevoked.WithCause(fmt.Errorf("blocked session"))
 	}
 
 	if session.UserID != reqRefreshPayload.UserID {
-		err := fmt.Errorf("incorrect session user")
-		return "", "", err
+		return "", "", apperror.TokenExpired.WithCause(fmt.Errorf("incorrect session user"))
 	}
 
 	if session.RefreshToken != reqRefreshToken {
-		err := fmt.Errorf("mismatched session token")
-		return "", "", err
+		return "", "", apperror.TokenExpired.WithCause(fmt.Errorf("mismatched session token"))
+	}
+
+	if session.RotatedAt.Valid {
+		return "", "", s.revokeSessionFamily(ctx, session, meta)
 	}
 
 	if time.Now().After(session.ExpiresAt) {
-		err := fmt.Errorf("expired session")
+		return "", "", apperror.TokenExpired.WithCause(fmt.Errorf("expired session"))
+	}
+
+	user, err := s.store.GetUser(ctx, session.UserID)
+	if err != nil {
 		return "", "", err
 	}
 
-	accessToken, _, err = s.tokenMaker.CreateToken(
+	if user.IsBlocked {
+		return "", "", apperror.UserBlocked.WithCause(fmt.Errorf("user %d is blocked", user.ID))
+	}
+
+	refreshToken, refreshPayload, err := s.tokenMaker.CreateToken(
 		reqRefreshPayload.UserID,
-		s.config.AccessTokenDuration,
+		s.config.RefreshTokenDuration,
 	)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshToken, refreshPayload, err := s.tokenMaker.CreateToken(
+	accessToken, _, err = s.tokenMaker.CreateSessionToken(
 		reqRefreshPayload.UserID,
-		s.config.RefreshTokenDuration,
+		refreshPayload.ID,
+		user.Role,
+		s.config.AccessTokenDuration,
 	)
 	if err != nil {
 		return "", "", err
 	}
 
-	err = s.store.UpdateSession(ctx, db.UpdateSessionParams{
-		OldUuid: reqRefreshPayload.ID,
-		NewUuid: refreshPayload.ID,
+	err = s.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		OldUuid:      reqRefreshPayload.ID,
+		NewUuid:      refreshPayload.ID,
 		RefreshToken: refreshToken,
-		ExpiresAt: refreshPayload.ExpiredAt,
+		ExpiresAt:    refreshPayload.ExpiredAt,
+		UserAgent:    meta.UserAgent,
+		ClientIp:     meta.ClientIP,
 	})
+	if errors.Is(err, db.ErrSessionRotated) {
+		return "", "", s.revokeSessionFamily(ctx, session, meta)
+	}
 	if err != nil {
 		return "", "", err
 	}
@@ -86,6 +105,24 @@ func (s *Service) RefreshTokens(ctx context.Context, reqRefreshToken string) (ac
 	return accessToken, refreshToken, nil
 }
 
+// revokeSessionFamily blocks every session descended from the same login once
+// a refresh token that was already rotated out is presented again.
+func (s *Service) revokeSessionFamily(ctx context.Context, session db.Session, meta models.SessionMeta) error {
+	s.log.Warn("refresh token reuse detected",
+		zap.Int32("user_id", session.UserID),
+		zap.String("family_id", session.FamilyID.String()),
+		zap.String("session_id", session.Uuid.String()),
+		zap.String("user_agent", meta.UserAgent),
+		zap.String("client_ip", meta.ClientIP),
+	)
+
+	if err := s.store.BlockSessionFamily(ctx, session.FamilyID); err != nil {
+		return err
+	}
+
+	return apperror.SessionRevoked.WithCause(fmt.Errorf("refresh token reuse"))
+}
+
 func (s *Service) ValidateSession(ctx context.Context, refreshToken string) error {
 	payload, err := s.tokenMaker.VerifyToken(refreshToken)
 	if err != nil {
@@ -112,6 +149,11 @@ func (s *Service) ValidateSession(ctx context.Context, refreshToken string) erro
 		return err
 	}
 
+	if session.RotatedAt.Valid {
+		err = fmt.Errorf("rotated session")
+		return err
+	}
+
 	if time.Now().After(session.ExpiresAt) {
 		err = fmt.Errorf("expired session")
 		return err
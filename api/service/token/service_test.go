@@ -0,0 +1,146 @@
+package tokenservice
+
+import (
+	"context"
+	"testing"
+	"time"
+	"treffly/api/models"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/token"
+	"treffly/util"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newTestService(t *testing.T) (*Service, *mockdb.MockStore, token.Maker) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	maker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	config := util.Config{
+		AccessTokenDuration:  time.Minute,
+		RefreshTokenDuration: time.Hour,
+	}
+
+	return New(store, maker, config, zap.NewNop()), store, maker
+}
+
+func TestRefreshTokensRevokedSession(t *testing.T) {
+	service, store, maker := newTestService(t)
+
+	refreshToken, payload, err := maker.CreateToken(1, time.Hour)
+	require.NoError(t, err)
+
+	store.EXPECT().GetSession(gomock.Any(), payload.ID).Return(db.Session{
+		Uuid:         payload.ID,
+		UserID:       payload.UserID,
+		RefreshToken: refreshToken,
+		ExpiresAt:    payload.ExpiredAt,
+		IsBlocked:    true,
+	}, nil)
+
+	_, _, err = service.RefreshTokens(context.Background(), refreshToken, models.SessionMeta{})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.SessionRevoked.Subtitle, appErr.Subtitle)
+}
+
+func TestRefreshTokensRotatesSession(t *testing.T) {
+	service, store, maker := newTestService(t)
+
+	refreshToken, payload, err := maker.CreateToken(1, time.Hour)
+	require.NoError(t, err)
+
+	store.EXPECT().GetSession(gomock.Any(), payload.ID).Return(db.Session{
+		Uuid:         payload.ID,
+		UserID:       payload.UserID,
+		RefreshToken: refreshToken,
+		ExpiresAt:    payload.ExpiredAt,
+	}, nil)
+
+	store.EXPECT().GetUser(gomock.Any(), payload.UserID).Return(db.User{
+		ID:   payload.UserID,
+		Role: models.RoleModerator,
+	}, nil)
+
+	var update db.RotateSessionTxParams
+	store.EXPECT().
+		RotateSessionTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.RotateSessionTxParams) error {
+			update = arg
+			return nil
+		})
+
+	meta := models.SessionMeta{UserAgent: "Mozilla/5.0", ClientIP: "10.0.0.1"}
+	accessToken, newRefreshToken, err := service.RefreshTokens(context.Background(), refreshToken, meta)
+	require.NoError(t, err)
+
+	require.Equal(t, payload.ID, update.OldUuid)
+	require.Equal(t, newRefreshToken, update.RefreshToken)
+	require.Equal(t, meta.UserAgent, update.UserAgent)
+	require.Equal(t, meta.ClientIP, update.ClientIp)
+
+	accessPayload, err := maker.VerifyToken(accessToken)
+	require.NoError(t, err)
+	require.Equal(t, update.NewUuid, accessPayload.SessionID)
+	require.Equal(t, models.RoleModerator, accessPayload.Role)
+}
+
+func TestRefreshTokensReuseRevokesFamily(t *testing.T) {
+	service, store, maker := newTestService(t)
+
+	refreshToken, payload, err := maker.CreateToken(1, time.Hour)
+	require.NoError(t, err)
+
+	familyID := uuid.New()
+	store.EXPECT().GetSession(gomock.Any(), payload.ID).Return(db.Session{
+		Uuid:         payload.ID,
+		UserID:       payload.UserID,
+		RefreshToken: refreshToken,
+		ExpiresAt:    payload.ExpiredAt,
+		FamilyID:     familyID,
+		RotatedAt:    pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}, nil)
+	store.EXPECT().BlockSessionFamily(gomock.Any(), familyID).Return(nil)
+	store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, _, err = service.RefreshTokens(context.Background(), refreshToken, models.SessionMeta{})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.SessionRevoked.Subtitle, appErr.Subtitle)
+}
+
+func TestRefreshTokensConcurrentRotationRevokesFamily(t *testing.T) {
+	service, store, maker := newTestService(t)
+
+	refreshToken, payload, err := maker.CreateToken(1, time.Hour)
+	require.NoError(t, err)
+
+	familyID := uuid.New()
+	store.EXPECT().GetSession(gomock.Any(), payload.ID).Return(db.Session{
+		Uuid:         payload.ID,
+		UserID:       payload.UserID,
+		RefreshToken: refreshToken,
+		ExpiresAt:    payload.ExpiredAt,
+		FamilyID:     familyID,
+	}, nil)
+	store.EXPECT().GetUser(gomock.Any(), payload.UserID).Return(db.User{ID: payload.UserID}, nil)
+	store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Return(db.ErrSessionRotated)
+	store.EXPECT().BlockSessionFamily(gomock.Any(), familyID).Return(nil)
+
+	_, _, err = service.RefreshTokens(context.Background(), refreshToken, models.SessionMeta{})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.SessionRevoked.Subtitle, appErr.Subtitle)
+}
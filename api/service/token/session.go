@@ -0,0 +1,54 @@
+package tokenservice
+
+import (
+	"context"
+	"database/sql"
+	"github.com/google/uuid"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+)
+
+func (s *Service) ListSessions(ctx context.Context, userID int32, currentID uuid.UUID) ([]models.Session, error) {
+	sessions, err := s.store.ListUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Session, len(sessions))
+	for i, session := range sessions {
+		result[i] = models.Session{
+			ID:         session.Uuid,
+			UserAgent:  session.UserAgent,
+			ClientIP:   session.ClientIp,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			IsCurrent:  session.Uuid == currentID,
+		}
+	}
+
+	return result, nil
+}
+
+func (s *Service) RevokeSession(ctx context.Context, userID int32, sessionID uuid.UUID) error {
+	rows, err := s.store.BlockSession(ctx, db.BlockSessionParams{
+		Uuid:   sessionID,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (s *Service) RevokeOtherSessions(ctx context.Context, userID int32, currentID uuid.UUID) error {
+	return s.store.BlockOtherUserSessions(ctx, db.BlockOtherUserSessionsParams{
+		UserID: userID,
+		Uuid:   currentID,
+	})
+}
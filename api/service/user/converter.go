@@ -30,6 +30,9 @@ func ConvertUser(dbUser db.User) models.User {
 		Username: dbUser.Username,
 		Email:    dbUser.Email,
 		CreatedAt: dbUser.CreatedAt,
+		EmailVerified: dbUser.EmailVerified,
+		Role:          dbUser.Role,
+		IsBlocked:     dbUser.IsBlocked,
 	}
 }
 
@@ -40,6 +43,7 @@ func ConvertUserWithTags(dbUser db.UserWithTagsView) models.UserWithTags {
 			Username: dbUser.Username,
 			Email: dbUser.Email,
 			CreatedAt: dbUser.CreatedAt,
+			EmailVerified: dbUser.EmailVerified,
 		},
 		Tags: convertTags(dbUser.Tags),
 		ImagePath: safeString(dbUser.ImagePath),
@@ -0,0 +1,190 @@
+package userservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"net/url"
+	"time"
+	"treffly/api/common"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/mail"
+	"treffly/util"
+)
+
+const (
+	verifyEmailPurpose   = "verify_email"
+	passwordResetPurpose = "password_reset"
+	userTokenLength      = 32
+	passwordResetTimeout = 30 * time.Second
+)
+
+const verifyEmailBody = `Привет, %s!
+
+Чтобы подтвердить почту, перейди по ссылке:
+%s
+
+Ссылка действует %s. Если ты не регистрировался в Treffly, просто проигнорируй это письмо.
+`
+
+const passwordResetBody = `Привет, %s!
+
+Мы получили запрос на сброс пароля. Чтобы задать новый пароль, перейди по ссылке:
+%s
+
+Ссылка действует %s. Если ты не запрашивал сброс, просто проигнорируй это письмо — пароль останется прежним.
+`
+
+func (s *Service) SendVerificationEmail(ctx context.Context, userID int32) error {
+	user, err := s.store.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return nil
+	}
+
+	token, err := s.issueUserToken(ctx, user.ID, verifyEmailPurpose, s.config.EmailVerifyDuration)
+	if err != nil {
+		return err
+	}
+
+	link := common.AppURL(s.config.Environment, s.config.Domain, "/verify-email?token="+url.QueryEscape(token))
+
+	return s.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Подтверждение почты в Treffly",
+		Body:    fmt.Sprintf(verifyEmailBody, user.Username, link, formatTTL(s.config.EmailVerifyDuration)),
+	})
+}
+
+func (s *Service) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := s.store.ConsumeUserToken(ctx, db.ConsumeUserTokenParams{
+		TokenHash: util.HashToken(token),
+		Purpose:   verifyEmailPurpose,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperror.InvalidLink.WithCause(err)
+		}
+		return err
+	}
+
+	return s.store.VerifyUserEmail(ctx, userID)
+}
+
+// RequestPasswordReset answers before the account is even looked up: doing
+// the work inline would make the response slower for registered emails and
+// reveal which addresses have an account.
+func (s *Service) RequestPasswordReset(ctx context.Context, email string) error {
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), passwordResetTimeout)
+		defer cancel()
+
+		if err := s.sendPasswordReset(ctx, email); err != nil {
+			s.log.Warn("send password reset", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+func (s *Service) sendPasswordReset(ctx context.Context, email string) error {
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	// The link already sent is still valid; a fresh one would only let
+	// repeated requests flood the inbox.
+	active, err := s.store.HasActiveUserToken(ctx, db.HasActiveUserTokenParams{
+		UserID:  user.ID,
+		Purpose: passwordResetPurpose,
+	})
+	if err != nil {
+		return err
+	}
+	if active {
+		return nil
+	}
+
+	token, err := s.issueUserToken(ctx, user.ID, passwordResetPurpose, s.config.PasswordResetDuration)
+	if err != nil {
+		return err
+	}
+
+	link := common.AppURL(s.config.Environment, s.config.Domain, "/reset-password?token="+url.QueryEscape(token))
+
+	return s.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля в Treffly",
+		Body:    fmt.Sprintf(passwordResetBody, user.Username, link, formatTTL(s.config.PasswordResetDuration)),
+	})
+}
+
+func (s *Service) ResetPassword(ctx context.Context, token, password string) error {
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		return apperror.InternalServer.WithCause(err)
+	}
+
+	_, err = s.store.ResetPasswordTx(ctx, db.ResetPasswordTxParams{
+		TokenHash:    util.HashToken(token),
+		Purpose:      passwordResetPurpose,
+		PasswordHash: hashedPassword,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperror.InvalidLink.WithCause(err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// issueUserToken replaces any outstanding token of the same purpose so that
+// only the most recently sent link stays valid.
+func (s *Service) issueUserToken(ctx context.Context, userID int32, purpose string, ttl time.Duration) (string, error) {
+	token, err := util.GenerateSecureToken(userTokenLength)
+	if err != nil {
+		return "", apperror.InternalServer.WithCause(err)
+	}
+
+	err = s.store.DeleteUserTokens(ctx, db.DeleteUserTokensParams{
+		UserID:  userID,
+		Purpose: purpose,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	err = s.store.CreateUserToken(ctx, db.CreateUserTokenParams{
+		TokenHash: util.HashToken(token),
+		UserID:    userID,
+		Purpose:   purpose,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func formatTTL(d time.Duration) string {
+	if d >= time.Hour && d%time.Hour == 0 {
+		return fmt.Sprintf("%d ч.", int(d.Hours()))
+	}
+	return fmt.Sprintf("%d мин.", int(d.Minutes()))
+}
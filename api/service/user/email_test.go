@@ -0,0 +1,150 @@
+package userservice
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/mail"
+	"treffly/moderation"
+	"treffly/util"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+type recordingMailer struct {
+	sent []mail.Message
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg mail.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func newTestService(t *testing.T) (*Service, *mockdb.MockStore, *recordingMailer) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	mailer := &recordingMailer{}
+	config := util.Config{
+		Domain:                "treffly.ru",
+		EmailVerifyDuration:   24 * time.Hour,
+		PasswordResetDuration: time.Hour,
+	}
+
+	return New(store, nil, mailer, moderation.NewWordListModerator(moderation.DefaultWords, nil), config, zap.NewNop()), store, mailer
+}
+
+var linkTokenRe = regexp.MustCompile(`token=(\S+)`)
+
+func TestRequestPasswordReset(t *testing.T) {
+	service, store, mailer := newTestService(t)
+
+	user := db.User{ID: 7, Username: "anna", Email: "anna@example.com"}
+	store.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Return(user, nil)
+	store.EXPECT().
+		HasActiveUserToken(gomock.Any(), db.HasActiveUserTokenParams{UserID: user.ID, Purpose: passwordResetPurpose}).
+		Return(false, nil)
+	store.EXPECT().
+		DeleteUserTokens(gomock.Any(), db.DeleteUserTokensParams{UserID: user.ID, Purpose: passwordResetPurpose}).
+		Return(nil)
+
+	var stored db.CreateUserTokenParams
+	store.EXPECT().
+		CreateUserToken(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.CreateUserTokenParams) error {
+			stored = arg
+			return nil
+		})
+
+	require.NoError(t, service.RequestPasswordReset(context.Background(), user.Email))
+	service.background.Wait()
+
+	require.Len(t, mailer.sent, 1)
+	require.Equal(t, user.Email, mailer.sent[0].To)
+	require.Contains(t, mailer.sent[0].Body, "http://treffly.ru/reset-password?token=")
+	require.Contains(t, mailer.sent[0].Body, "1 ч.")
+
+	match := linkTokenRe.FindStringSubmatch(mailer.sent[0].Body)
+	require.Len(t, match, 2)
+	token, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+
+	require.Equal(t, util.HashToken(token), stored.TokenHash)
+	require.Equal(t, user.ID, stored.UserID)
+	require.Equal(t, passwordResetPurpose, stored.Purpose)
+	require.WithinDuration(t, time.Now().Add(time.Hour), stored.ExpiresAt, time.Minute)
+}
+
+func TestRequestPasswordResetKeepsActiveLink(t *testing.T) {
+	service, store, mailer := newTestService(t)
+
+	user := db.User{ID: 7, Username: "anna", Email: "anna@example.com"}
+	store.EXPECT().GetUserByEmail(gomock.Any(), user.Email).Return(user, nil)
+	store.EXPECT().
+		HasActiveUserToken(gomock.Any(), db.HasActiveUserTokenParams{UserID: user.ID, Purpose: passwordResetPurpose}).
+		Return(true, nil)
+	store.EXPECT().DeleteUserTokens(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().CreateUserToken(gomock.Any(), gomock.Any()).Times(0)
+
+	require.NoError(t, service.RequestPasswordReset(context.Background(), user.Email))
+	service.background.Wait()
+	require.Empty(t, mailer.sent)
+}
+
+func TestRequestPasswordResetUnknownEmail(t *testing.T) {
+	service, store, mailer := newTestService(t)
+
+	store.EXPECT().GetUserByEmail(gomock.Any(), "ghost@example.com").Return(db.User{}, sql.ErrNoRows)
+
+	require.NoError(t, service.RequestPasswordReset(context.Background(), "ghost@example.com"))
+	service.background.Wait()
+	require.Empty(t, mailer.sent)
+}
+
+func TestRequestPasswordResetDoesNotWaitForLookup(t *testing.T) {
+	service, store, mailer := newTestService(t)
+
+	release := make(chan struct{})
+	store.EXPECT().
+		GetUserByEmail(gomock.Any(), "anna@example.com").
+		DoAndReturn(func(context.Context, string) (db.User, error) {
+			<-release
+			return db.User{}, sql.ErrNoRows
+		})
+
+	require.NoError(t, service.RequestPasswordReset(context.Background(), "anna@example.com"))
+
+	close(release)
+	service.background.Wait()
+	require.Empty(t, mailer.sent)
+}
+
+func TestResetPasswordInvalidToken(t *testing.T) {
+	service, store, _ := newTestService(t)
+
+	store.EXPECT().ResetPasswordTx(gomock.Any(), gomock.Any()).Return(int32(0), sql.ErrNoRows)
+
+	err := service.ResetPassword(context.Background(), "stale", "new-password")
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.InvalidLink.Title, appErr.Title)
+}
+
+func TestVerifyEmail(t *testing.T) {
+	service, store, _ := newTestService(t)
+
+	store.EXPECT().
+		ConsumeUserToken(gomock.Any(), db.ConsumeUserTokenParams{TokenHash: util.HashToken("token"), Purpose: verifyEmailPurpose}).
+		Return(int32(7), nil)
+	store.EXPECT().VerifyUserEmail(gomock.Any(), int32(7)).Return(nil)
+
+	require.NoError(t, service.VerifyEmail(context.Background(), "token"))
+}
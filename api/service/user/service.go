@@ -2,10 +2,16 @@ package userservice
 
 import (
 	"context"
+	"fmt"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sync"
+	"treffly/api/common"
 	"treffly/api/models"
 	"treffly/apperror"
 	"treffly/db/sqlc"
+	"treffly/mail"
+	"treffly/moderation"
 	"treffly/token"
 	"treffly/util"
 )
@@ -13,18 +19,31 @@ import (
 type Service struct {
 	store      db.Store
 	tokenMaker token.Maker
+	mailer     mail.Mailer
+	moderator  moderation.Moderator
 	config     util.Config
+	log        *zap.Logger
+	// background tracks password reset mails still being sent.
+	background sync.WaitGroup
 }
 
-func New(store db.Store, tokenMaker token.Maker, config util.Config) *Service {
+func New(store db.Store, tokenMaker token.Maker, mailer mail.Mailer, moderator moderation.Moderator, config util.Config, log *zap.Logger) *Service {
 	return &Service{
 		store:      store,
 		tokenMaker: tokenMaker,
+		mailer:     mailer,
+		moderator:  moderator,
 		config:     config,
+		log:        log,
 	}
 }
 
 func (s *Service) CreateUser(ctx context.Context, params models.CreateUserParams) (models.User, error) {
+	err := common.Moderate(ctx, s.moderator, moderation.Field{Name: "username", Text: params.Username})
+	if err != nil {
+		return models.User{}, err
+	}
+
 	hashedPassword, err := util.HashPassword(params.Password)
 	if err != nil {
 		return models.User{}, apperror.InternalServer.WithCause(err)
@@ -35,13 +54,20 @@ func (s *Service) CreateUser(ctx context.Context, params models.CreateUserParams
 		Email:        params.Email,
 		PasswordHash: hashedPassword,
 	})
+	if err != nil {
+		return models.User{}, err
+	}
+
+	if err := s.SendVerificationEmail(ctx, user.ID); err != nil {
+		s.log.Warn("failed to send verification email", zap.Int32("user_id", user.ID), zap.Error(err))
+	}
 
 	resp := ConvertUser(user)
 
-	return resp, err
+	return resp, nil
 }
 
-func (s *Service) LoginUser(ctx context.Context, email, password string) (models.User, string, string, error) {
+func (s *Service) LoginUser(ctx context.Context, email, password string, meta models.SessionMeta) (models.User, string, string, error) {
 	user, err := s.store.GetUserByEmail(ctx, email)
 	if err != nil {
 		return models.User{}, "", "", err
@@ -51,23 +77,11 @@ func (s *Service) LoginUser(ctx context.Context, email, password string) (models
 		return models.User{}, "", "", apperror.InvalidCredentials.WithCause(err)
 	}
 
-	accessToken, _, err := s.tokenMaker.CreateToken(user.ID, s.config.AccessTokenDuration)
-	if err != nil {
-		return models.User{}, "", "", apperror.InternalServer.WithCause(err)
+	if user.IsBlocked {
+		return models.User{}, "", "", apperror.UserBlocked.WithCause(fmt.Errorf("user %d is blocked", user.ID))
 	}
 
-	refreshToken, refreshPayload, err := s.tokenMaker.CreateToken(user.ID, s.config.RefreshTokenDuration)
-	if err != nil {
-		return models.User{}, "", "", apperror.InternalServer.WithCause(err)
-	}
-
-	err = s.store.CreateSession(ctx, db.CreateSessionParams{
-		Uuid:         refreshPayload.ID,
-		UserID:       user.ID,
-		RefreshToken: refreshToken,
-		ExpiresAt:    refreshPayload.ExpiredAt,
-		IsBlocked:    false,
-	})
+	accessToken, refreshToken, err := s.CreateAuthSession(ctx, user.ID, user.Role, meta)
 	if err != nil {
 		return models.User{}, "", "", err
 	}
@@ -77,13 +91,13 @@ func (s *Service) LoginUser(ctx context.Context, email, password string) (models
 	return resp, accessToken, refreshToken, nil
 }
 
-func (s *Service) CreateAuthSession(ctx context.Context, userID int32) (string, string, error) {
-	accessToken, _, err := s.tokenMaker.CreateToken(userID, s.config.AccessTokenDuration)
+func (s *Service) CreateAuthSession(ctx context.Context, userID int32, role string, meta models.SessionMeta) (string, string, error) {
+	refreshToken, refreshPayload, err := s.tokenMaker.CreateToken(userID, s.config.RefreshTokenDuration)
 	if err != nil {
 		return "", "", apperror.InternalServer.WithCause(err)
 	}
 
-	refreshToken, refreshPayload, err := s.tokenMaker.CreateToken(userID, s.config.RefreshTokenDuration)
+	accessToken, _, err := s.tokenMaker.CreateSessionToken(userID, refreshPayload.ID, role, s.config.AccessTokenDuration)
 	if err != nil {
 		return "", "", apperror.InternalServer.WithCause(err)
 	}
@@ -94,20 +108,55 @@ func (s *Service) CreateAuthSession(ctx context.Context, userID int32) (string,
 		RefreshToken: refreshToken,
 		ExpiresAt:    refreshPayload.ExpiredAt,
 		IsBlocked:    false,
+		UserAgent:    meta.UserAgent,
+		ClientIp:     meta.ClientIP,
+		FamilyID:     refreshPayload.ID,
 	})
 
 	return accessToken, refreshToken, err
 }
 
+func (s *Service) Logout(ctx context.Context, userID int32, sessionID uuid.UUID) error {
+	if sessionID == uuid.Nil {
+		return nil
+	}
+
+	_, err := s.store.BlockSession(ctx, db.BlockSessionParams{
+		Uuid:   sessionID,
+		UserID: userID,
+	})
+	return err
+}
+
 func (s *Service) GetUserWithTags(ctx context.Context, userID int32) (models.UserWithTags, error) {
 	user, err := s.store.GetUserWithTags(ctx, userID)
+	if err != nil {
+		return models.UserWithTags{}, err
+	}
 
 	resp := ConvertUserWithTags(user)
 
-	return resp, err
+	return s.withFollowCounts(ctx, resp)
+}
+
+func (s *Service) withFollowCounts(ctx context.Context, user models.UserWithTags) (models.UserWithTags, error) {
+	counts, err := s.store.GetFollowCounts(ctx, user.ID)
+	if err != nil {
+		return models.UserWithTags{}, err
+	}
+
+	user.FollowersCount = int(counts.FollowersCount)
+	user.FollowingCount = int(counts.FollowingCount)
+
+	return user, nil
 }
 
 func (s *Service) UpdateUser(ctx context.Context, params models.UpdateUserParams) (models.UserWithTags, error) {
+	err := common.Moderate(ctx, s.moderator, moderation.Field{Name: "username", Text: params.Username})
+	if err != nil {
+		return models.UserWithTags{}, err
+	}
+
 	user, err := s.store.GetUser(ctx, params.ID)
 	if err != nil {
 		return models.UserWithTags{}, err
@@ -138,7 +187,7 @@ func (s *Service) UpdateUser(ctx context.Context, params models.UpdateUserParams
 
 	resp := ConvertUserWithTags(updatedUser)
 
-	return resp, err
+	return s.withFollowCounts(ctx, resp)
 }
 
 func (s *Service) UpdateUserTags(ctx context.Context, params models.UpdateUserTagsParams) error {
@@ -0,0 +1,42 @@
+package userservice
+
+import (
+	"context"
+	"testing"
+	"treffly/api/models"
+	"treffly/apperror"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateUserRejectsUsername(t *testing.T) {
+	service, store, _ := newTestService(t)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().UpdateUserTx(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := service.UpdateUser(context.Background(), models.UpdateUserParams{
+		ID:       1,
+		Username: "xXx_fuck_xXx",
+	})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, apperror.ContentRejected.HTTPCode, appErr.HTTPCode)
+	require.Equal(t, "username", appErr.Field)
+}
+
+func TestCreateUserRejectsUsername(t *testing.T) {
+	service, store, _ := newTestService(t)
+	store.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := service.CreateUser(context.Background(), models.CreateUserParams{
+		Username: "cyka_blyat",
+		Email:    "user@example.com",
+		Password: "secret123",
+	})
+
+	var appErr apperror.ErrorResponse
+	require.ErrorAs(t, err, &appErr)
+	require.Equal(t, "username", appErr.Field)
+}
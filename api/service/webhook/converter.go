@@ -0,0 +1,59 @@
+package webhookservice
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"time"
+	"treffly/api/models"
+	db "treffly/db/sqlc"
+)
+
+func convertWebhook(w db.Webhook) models.Webhook {
+	return models.Webhook{
+		ID:                  w.ID,
+		URL:                 w.Url,
+		EventTypes:          w.EventTypes,
+		IsActive:            w.IsActive,
+		ConsecutiveFailures: int(w.ConsecutiveFailures),
+		DisabledAt:          safeTime(w.DisabledAt),
+		CreatedAt:           w.CreatedAt,
+	}
+}
+
+func convertWebhooks(webhooks []db.Webhook) []models.Webhook {
+	result := make([]models.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		result[i] = convertWebhook(w)
+	}
+	return result
+}
+
+func convertDelivery(d db.WebhookDelivery) models.WebhookDelivery {
+	return models.WebhookDelivery{
+		ID:             d.ID,
+		WebhookID:      d.WebhookID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       int(d.Attempts),
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: int(d.ResponseStatus.Int32),
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    safeTime(d.DeliveredAt),
+	}
+}
+
+func convertDeliveries(deliveries []db.WebhookDelivery) []models.WebhookDelivery {
+	result := make([]models.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = convertDelivery(d)
+	}
+	return result
+}
+
+func safeTime(t pgtype.Timestamptz) time.Time {
+	if t.Valid {
+		return t.Time
+	}
+	return time.Time{}
+}
@@ -0,0 +1,28 @@
+package webhookservice
+
+import "time"
+
+// webhookPayload is the body posted to organiser endpoints. ID is the outbox
+// id and stays the same across retries and replays, so receivers can use it
+// to deduplicate.
+type webhookPayload struct {
+	ID         int64       `json:"id"`
+	Type       string      `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       webhookData `json:"data"`
+}
+
+type webhookData struct {
+	Event webhookEvent `json:"event"`
+	User  *webhookUser `json:"user,omitempty"`
+}
+
+type webhookEvent struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+type webhookUser struct {
+	ID       int32  `json:"id"`
+	Username string `json:"username"`
+}
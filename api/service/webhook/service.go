@@ -0,0 +1,338 @@
+package webhookservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+	"treffly/api/models"
+	"treffly/apperror"
+	db "treffly/db/sqlc"
+	"treffly/eventbus"
+	"treffly/util"
+	"treffly/webhook"
+	"unicode/utf8"
+)
+
+const (
+	maxWebhooksPerOwner  = 10
+	deliveriesBatchSize  = 20
+	webhookSecretLength  = 32
+	webhookSecretPrefix  = "whsec_"
+	maxStoredErrorLength = 500
+)
+
+// EventTypes are the domain events organisers can subscribe to.
+var EventTypes = []string{
+	eventbus.TypeParticipantJoined,
+	eventbus.TypeParticipantLeft,
+	eventbus.TypeEventUpdated,
+	eventbus.TypeEventDeleted,
+}
+
+type sender interface {
+	Send(ctx context.Context, req webhook.Request) (int, error)
+}
+
+type Service struct {
+	store  db.Store
+	client sender
+	config util.Config
+	log    *zap.Logger
+}
+
+func New(store db.Store, client sender, config util.Config, log *zap.Logger) *Service {
+	return &Service{
+		store:  store,
+		client: client,
+		config: config,
+		log:    log,
+	}
+}
+
+func (s *Service) Create(ctx context.Context, params models.CreateWebhookParams) (models.Webhook, error) {
+	if err := s.checkURL(params.URL); err != nil {
+		return models.Webhook{}, err
+	}
+
+	existing, err := s.store.ListWebhooks(ctx, params.OwnerID)
+	if err != nil {
+		return models.Webhook{}, err
+	}
+	if len(existing) >= maxWebhooksPerOwner {
+		return models.Webhook{}, apperror.BadRequest.WithCause(fmt.Errorf("at most %d webhooks are allowed", maxWebhooksPerOwner))
+	}
+
+	secret, err := util.GenerateSecureToken(webhookSecretLength)
+	if err != nil {
+		return models.Webhook{}, apperror.InternalServer.WithCause(err)
+	}
+
+	created, err := s.store.CreateWebhook(ctx, db.CreateWebhookParams{
+		OwnerID:    params.OwnerID,
+		Url:        params.URL,
+		Secret:     webhookSecretPrefix + secret,
+		EventTypes: params.EventTypes,
+	})
+	if err != nil {
+		return models.Webhook{}, err
+	}
+
+	// The secret is only shown once, when the webhook is created.
+	result := convertWebhook(created)
+	result.Secret = created.Secret
+
+	return result, nil
+}
+
+// checkURL requires TLS in production: payloads contain participants'
+// usernames.
+func (s *Service) checkURL(url string) error {
+	if s.config.Environment == "production" && !strings.HasPrefix(url, "https://") {
+		return apperror.BadRequest.WithField("url", fmt.Errorf("webhook url must use https"))
+	}
+	return nil
+}
+
+func (s *Service) List(ctx context.Context, ownerID int32) ([]models.Webhook, error) {
+	webhooks, err := s.store.ListWebhooks(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertWebhooks(webhooks), nil
+}
+
+// Update also re-enables a webhook that was disabled after persistent
+// failures when IsActive is set.
+func (s *Service) Update(ctx context.Context, params models.UpdateWebhookParams) (models.Webhook, error) {
+	if err := s.checkURL(params.URL); err != nil {
+		return models.Webhook{}, err
+	}
+
+	updated, err := s.store.UpdateWebhook(ctx, db.UpdateWebhookParams{
+		Url:        params.URL,
+		EventTypes: params.EventTypes,
+		IsActive:   params.IsActive,
+		ID:         params.ID,
+		OwnerID:    params.OwnerID,
+	})
+	if err != nil {
+		return models.Webhook{}, err
+	}
+
+	return convertWebhook(updated), nil
+}
+
+func (s *Service) Delete(ctx context.Context, id, ownerID int32) error {
+	deleted, err := s.store.DeleteWebhook(ctx, db.DeleteWebhookParams{
+		ID:      id,
+		OwnerID: ownerID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return apperror.NotFound.WithCause(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, params models.ListWebhookDeliveriesParams) (models.WebhookDeliveriesPage, error) {
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		WebhookID: params.WebhookID,
+		OwnerID:   params.OwnerID,
+		Lim:       params.Limit + 1,
+		Off:       params.Offset,
+	})
+	if err != nil {
+		return models.WebhookDeliveriesPage{}, err
+	}
+
+	hasMore := len(deliveries) > int(params.Limit)
+	if hasMore {
+		deliveries = deliveries[:params.Limit]
+	}
+
+	return models.WebhookDeliveriesPage{
+		Deliveries: convertDeliveries(deliveries),
+		HasMore:    hasMore,
+	}, nil
+}
+
+// Replay queues a copy of a logged delivery; the original entry is kept.
+func (s *Service) Replay(ctx context.Context, deliveryID int64, webhookID, ownerID int32) (models.WebhookDelivery, error) {
+	replayed, err := s.store.ReplayWebhookDelivery(ctx, db.ReplayWebhookDeliveryParams{
+		ID:        deliveryID,
+		WebhookID: webhookID,
+		OwnerID:   ownerID,
+	})
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+
+	return convertDelivery(replayed), nil
+}
+
+// HandleEvent is subscribed to the event bus and queues a delivery for every
+// active webhook of the event's owner. The outbox id makes it idempotent, so
+// redelivered messages do not call the endpoint twice.
+func (s *Service) HandleEvent(ctx context.Context, msg eventbus.Message) error {
+	var (
+		eventID, ownerID, userID int32
+		eventName                string
+	)
+	switch e := msg.Event.(type) {
+	case eventbus.ParticipantJoined:
+		eventID, userID = e.EventID, e.UserID
+	case eventbus.ParticipantLeft:
+		eventID, userID = e.EventID, e.UserID
+	case eventbus.EventUpdated:
+		eventID = e.EventID
+	case eventbus.EventDeleted:
+		eventID, ownerID, eventName = e.EventID, e.OwnerID, e.Name
+	default:
+		return nil
+	}
+
+	if ownerID == 0 {
+		event, err := s.store.GetEventSummary(ctx, eventID)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Deleted since; organisers learn about it from event.deleted.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		ownerID, eventName = event.OwnerID, event.Name
+	}
+
+	payload := webhookPayload{
+		ID:         msg.ID,
+		Type:       msg.Event.Type(),
+		OccurredAt: msg.OccurredAt,
+		Data: webhookData{
+			Event: webhookEvent{ID: eventID, Name: eventName},
+		},
+	}
+
+	if userID != 0 {
+		user, err := s.store.GetUser(ctx, userID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil {
+			payload.Data.User = &webhookUser{ID: user.ID, Username: user.Username}
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.store.EnqueueWebhookDeliveries(ctx, db.EnqueueWebhookDeliveriesParams{
+		OutboxID:  msg.ID,
+		EventType: msg.Event.Type(),
+		Payload:   body,
+		OwnerID:   ownerID,
+	})
+	return err
+}
+
+// Deliver is run by the scheduler. Deliveries are sent one after another, so
+// the claimed batch is leased for a request timeout per delivery plus one
+// spare, and other replicas skip it until the last call is done.
+func (s *Service) Deliver(ctx context.Context) error {
+	deliveries, err := s.store.ClaimDueWebhookDeliveries(ctx, db.ClaimDueWebhookDeliveriesParams{
+		LeaseSeconds: int32((deliveriesBatchSize + 1) * s.config.WebhookTimeout / time.Second),
+		Lim:          deliveriesBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, d := range deliveries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s.deliver(ctx, d)
+	}
+
+	return nil
+}
+
+func (s *Service) deliver(ctx context.Context, d db.ClaimDueWebhookDeliveriesRow) {
+	log := s.log.With(zap.Int64("delivery_id", d.ID), zap.Int32("webhook_id", d.WebhookID))
+
+	status, sendErr := s.client.Send(ctx, webhook.Request{
+		URL:        d.Url,
+		Secret:     d.Secret,
+		DeliveryID: d.ID,
+		EventType:  d.EventType,
+		Body:       d.Payload,
+	})
+	responseStatus := pgtype.Int4{Int32: int32(status), Valid: status != 0}
+
+	if sendErr == nil {
+		err := s.store.RecordWebhookDeliverySuccess(ctx, db.RecordWebhookDeliverySuccessParams{
+			ResponseStatus: responseStatus,
+			ID:             d.ID,
+		})
+		if err != nil {
+			log.Warn("record webhook delivery", zap.Error(err))
+		}
+		if err := s.store.ResetWebhookFailures(ctx, d.WebhookID); err != nil {
+			log.Warn("reset webhook failures", zap.Error(err))
+		}
+		return
+	}
+
+	attempts := int(d.Attempts) + 1
+	deliveryStatus := models.WebhookDeliveryPending
+	if attempts >= s.config.WebhookMaxAttempts {
+		deliveryStatus = models.WebhookDeliveryFailed
+	}
+
+	err := s.store.RecordWebhookDeliveryFailure(ctx, db.RecordWebhookDeliveryFailureParams{
+		Status:         deliveryStatus,
+		ResponseStatus: responseStatus,
+		LastError:      truncate(sendErr.Error(), maxStoredErrorLength),
+		NextAttemptAt:  time.Now().Add(webhook.Backoff(attempts)),
+		ID:             d.ID,
+	})
+	if err != nil {
+		log.Warn("record webhook delivery", zap.Error(err))
+	}
+
+	active, err := s.store.RecordWebhookFailure(ctx, db.RecordWebhookFailureParams{
+		DisableThreshold: int32(s.config.WebhookDisableAfter),
+		ID:               d.WebhookID,
+	})
+	if err != nil {
+		log.Warn("record webhook failure", zap.Error(err))
+		return
+	}
+	if !active {
+		log.Info("webhook disabled after repeated failures", zap.Error(sendErr))
+	}
+}
+
+// truncate cuts s to at most n bytes without splitting a UTF-8 sequence,
+// since last_error is stored as text.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
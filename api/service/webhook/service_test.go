@@ -0,0 +1,240 @@
+package webhookservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"treffly/api/models"
+	"treffly/api/service/servicetest"
+	"treffly/apperror"
+	mockdb "treffly/db/mock"
+	db "treffly/db/sqlc"
+	"treffly/eventbus"
+	"treffly/util"
+	"treffly/webhook"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func testConfig() util.Config {
+	return util.Config{
+		Environment:         "production",
+		WebhookTimeout:      time.Second,
+		WebhookMaxAttempts:  3,
+		WebhookDisableAfter: 5,
+	}
+}
+
+func newService(store db.Store) *Service {
+	return New(store, webhook.NewClient(time.Second, true), testConfig(), zap.NewNop())
+}
+
+func TestCreateReturnsSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().ListWebhooks(gomock.Any(), int32(1)).Return([]db.Webhook{}, nil)
+	store.EXPECT().
+		CreateWebhook(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.CreateWebhookParams) (db.Webhook, error) {
+			require.True(t, strings.HasPrefix(arg.Secret, webhookSecretPrefix))
+			return db.Webhook{ID: 3, OwnerID: arg.OwnerID, Url: arg.Url, Secret: arg.Secret, EventTypes: arg.EventTypes, IsActive: true}, nil
+		})
+
+	created, err := newService(store).Create(context.Background(), models.CreateWebhookParams{
+		OwnerID:    1,
+		URL:        "https://crm.example.com/hooks",
+		EventTypes: []string{eventbus.TypeParticipantJoined},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Secret)
+	require.True(t, created.IsActive)
+}
+
+func TestCreateRejectsPlainHTTPInProduction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	_, err := newService(store).Create(context.Background(), models.CreateWebhookParams{
+		OwnerID: 1,
+		URL:     "http://crm.example.com/hooks",
+	})
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestCreateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().ListWebhooks(gomock.Any(), int32(1)).Return(make([]db.Webhook, maxWebhooksPerOwner), nil)
+
+	_, err := newService(store).Create(context.Background(), models.CreateWebhookParams{
+		OwnerID: 1,
+		URL:     "https://crm.example.com/hooks",
+	})
+
+	servicetest.RequireAppError(t, err, apperror.BadRequest)
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().DeleteWebhook(gomock.Any(), db.DeleteWebhookParams{ID: 3, OwnerID: 1}).Return(int64(0), nil)
+
+	err := newService(store).Delete(context.Background(), 3, 1)
+
+	servicetest.RequireAppError(t, err, apperror.NotFound)
+}
+
+func TestHandleParticipantJoined(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	occurred := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	store.EXPECT().GetEventSummary(gomock.Any(), int32(10)).Return(db.GetEventSummaryRow{ID: 10, Name: "Пикник", OwnerID: 1}, nil)
+	store.EXPECT().GetUser(gomock.Any(), int32(2)).Return(db.User{ID: 2, Username: "anna", Email: "anna@example.com"}, nil)
+	store.EXPECT().
+		EnqueueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.EnqueueWebhookDeliveriesParams) (int64, error) {
+			require.Equal(t, int64(7), arg.OutboxID)
+			require.Equal(t, int32(1), arg.OwnerID)
+			require.Equal(t, eventbus.TypeParticipantJoined, arg.EventType)
+			require.JSONEq(t, `{
+				"id": 7,
+				"type": "participant.joined",
+				"occurred_at": "2026-05-01T12:00:00Z",
+				"data": {"event": {"id": 10, "name": "Пикник"}, "user": {"id": 2, "username": "anna"}}
+			}`, string(arg.Payload))
+			return 1, nil
+		})
+
+	err := newService(store).HandleEvent(context.Background(), eventbus.Message{
+		ID:         7,
+		OccurredAt: occurred,
+		Event:      eventbus.ParticipantJoined{EventID: 10, UserID: 2},
+	})
+	require.NoError(t, err)
+}
+
+func TestHandleEventDeletedUsesPayload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().
+		EnqueueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.EnqueueWebhookDeliveriesParams) (int64, error) {
+			require.Equal(t, int32(1), arg.OwnerID)
+			var payload webhookPayload
+			require.NoError(t, json.Unmarshal(arg.Payload, &payload))
+			require.Equal(t, "Пикник", payload.Data.Event.Name)
+			require.Nil(t, payload.Data.User)
+			return 1, nil
+		})
+
+	err := newService(store).HandleEvent(context.Background(), eventbus.Message{
+		ID:    8,
+		Event: eventbus.EventDeleted{EventID: 10, OwnerID: 1, Name: "Пикник"},
+	})
+	require.NoError(t, err)
+}
+
+func TestHandleEventOfDeletedEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().GetEventSummary(gomock.Any(), int32(10)).Return(db.GetEventSummaryRow{}, sql.ErrNoRows)
+
+	err := newService(store).HandleEvent(context.Background(), eventbus.Message{
+		ID:    9,
+		Event: eventbus.EventUpdated{EventID: 10},
+	})
+	require.NoError(t, err)
+}
+
+func TestDeliverSignedRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	body := []byte(`{"id":7,"type":"participant.joined"}`)
+	var verified bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ := io.ReadAll(r.Body)
+		verified = webhook.Verify("whsec_test", r.Header.Get(webhook.HeaderTimestamp), r.Header.Get(webhook.HeaderSignature), received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer receiver.Close()
+
+	store.EXPECT().
+		ClaimDueWebhookDeliveries(gomock.Any(), db.ClaimDueWebhookDeliveriesParams{LeaseSeconds: deliveriesBatchSize + 1, Lim: deliveriesBatchSize}).
+		Return([]db.ClaimDueWebhookDeliveriesRow{
+			{ID: 11, WebhookID: 3, EventType: eventbus.TypeParticipantJoined, Payload: body, Url: receiver.URL, Secret: "whsec_test"},
+		}, nil)
+	store.EXPECT().
+		RecordWebhookDeliverySuccess(gomock.Any(), db.RecordWebhookDeliverySuccessParams{
+			ResponseStatus: pgtype.Int4{Int32: http.StatusOK, Valid: true},
+			ID:             11,
+		}).
+		Return(nil)
+	store.EXPECT().ResetWebhookFailures(gomock.Any(), int32(3)).Return(nil)
+
+	require.NoError(t, newService(store).Deliver(context.Background()))
+	require.True(t, verified)
+}
+
+func TestDeliverFailureSchedulesRetry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer receiver.Close()
+
+	store.EXPECT().
+		ClaimDueWebhookDeliveries(gomock.Any(), gomock.Any()).
+		Return([]db.ClaimDueWebhookDeliveriesRow{
+			{ID: 11, WebhookID: 3, Attempts: 1, Payload: []byte(`{}`), Url: receiver.URL},
+			{ID: 12, WebhookID: 3, Attempts: 2, Payload: []byte(`{}`), Url: receiver.URL},
+		}, nil)
+
+	before := time.Now()
+	store.EXPECT().
+		RecordWebhookDeliveryFailure(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.RecordWebhookDeliveryFailureParams) error {
+			require.Equal(t, int64(11), arg.ID)
+			require.Equal(t, models.WebhookDeliveryPending, arg.Status)
+			require.Equal(t, pgtype.Int4{Int32: http.StatusInternalServerError, Valid: true}, arg.ResponseStatus)
+			require.WithinDuration(t, before.Add(webhook.Backoff(2)), arg.NextAttemptAt, 5*time.Second)
+			return nil
+		})
+	store.EXPECT().
+		RecordWebhookDeliveryFailure(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, arg db.RecordWebhookDeliveryFailureParams) error {
+			require.Equal(t, int64(12), arg.ID)
+			require.Equal(t, models.WebhookDeliveryFailed, arg.Status)
+			return nil
+		})
+	store.EXPECT().
+		RecordWebhookFailure(gomock.Any(), db.RecordWebhookFailureParams{DisableThreshold: 5, ID: 3}).
+		Return(true, nil).
+		Times(2)
+
+	require.NoError(t, newService(store).Deliver(context.Background()))
+}
+
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "short", truncate("short", 10))
+	require.Equal(t, "abc", truncate("abcdef", 3))
+	// "ошибка" is two bytes per letter; cutting at 5 would split "и".
+	require.Equal(t, "ош", truncate("ошибка", 5))
+	require.Equal(t, "ош", truncate("ошибка", 4))
+	require.Equal(t, "", truncate("ё", 1))
+}
@@ -3,12 +3,15 @@ package api
 import (
 	"context"
 	"database/sql"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
+	"treffly/api/common"
+	"treffly/api/models"
 	mockdb "treffly/db/mock"
 	db "treffly/db/sqlc"
 	"treffly/token"
@@ -46,6 +49,7 @@ func randomSession(t *testing.T, tokenMaker token.Maker) db.Session {
 		RefreshToken: refreshToken,
 		ExpiresAt:    payload.ExpiredAt,
 		IsBlocked:    false,
+		FamilyID:     payload.ID,
 	}
 }
 
@@ -64,7 +68,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "OK",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -73,21 +77,20 @@ func TestRefreshTokensAPI(t *testing.T) {
 					Return(session, nil)
 
 				store.EXPECT().
-					UpdateSession(gomock.Any(), gomock.Any()).
+					GetUser(gomock.Any(), session.UserID).
 					Times(1).
-					DoAndReturn(func(ctx context.Context, arg db.UpdateSessionParams) (db.Session, error) {
+					Return(db.User{ID: session.UserID, Role: models.RoleUser}, nil)
+
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.RotateSessionTxParams) error {
 						require.Equal(t, session.Uuid, arg.OldUuid)
 						require.NotEmpty(t, arg.NewUuid)
 						require.NotEmpty(t, arg.RefreshToken)
 						require.True(t, arg.ExpiresAt.After(time.Now()), "ExpiresAt is not in the future")
 
-						return db.Session{
-							Uuid:         arg.NewUuid,
-							UserID:       session.UserID,
-							RefreshToken: arg.RefreshToken,
-							ExpiresAt:    arg.ExpiresAt,
-							IsBlocked:    false,
-						}, nil
+						return nil
 					})
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
@@ -121,7 +124,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "InvalidToken",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", "invalid_token",
-					time.Now().Add(time.Hour), refreshTokenCookiePath, cookieDomain)
+					time.Now().Add(time.Hour), common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -136,7 +139,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "BlockedSession",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				blockedSession := session
@@ -155,7 +158,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "ExpiredSession",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				expiredSession := session
@@ -174,7 +177,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "UserIDMismatch",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				invalidSession := session
@@ -193,7 +196,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "SessionNotFound",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -209,7 +212,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			name: "DatabaseErrorOnGetSession",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -222,10 +225,10 @@ func TestRefreshTokensAPI(t *testing.T) {
 			},
 		},
 		{
-			name: "DatabaseErrorOnUpdateSession",
+			name: "DatabaseErrorOnRotateSession",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -234,19 +237,53 @@ func TestRefreshTokensAPI(t *testing.T) {
 					Return(session, nil)
 
 				store.EXPECT().
-					UpdateSession(gomock.Any(), gomock.Any()).
+					GetUser(gomock.Any(), session.UserID).
 					Times(1).
-					Return(db.Session{}, sql.ErrTxDone)
+					Return(db.User{ID: session.UserID, Role: models.RoleUser}, nil)
+
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
-			name: "DatabaseErrorOnUpdateSession",
+			name: "RotatedTokenReuse",
+			setupRequest: func(t *testing.T, request *http.Request) {
+				addCookie(request, "refresh_token", session.RefreshToken,
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				rotatedSession := session
+				rotatedSession.RotatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetSession(gomock.Any(), session.Uuid).
+					Times(1).
+					Return(rotatedSession, nil)
+
+				store.EXPECT().
+					BlockSessionFamily(gomock.Any(), session.FamilyID).
+					Times(1).
+					Return(nil)
+
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Empty(t, recorder.Result().Cookies())
+			},
+		},
+		{
+			name: "ConcurrentRotation",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				addCookie(request, "refresh_token", session.RefreshToken,
-					session.ExpiresAt, refreshTokenCookiePath, cookieDomain)
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -255,21 +292,81 @@ func TestRefreshTokensAPI(t *testing.T) {
 					Return(session, nil)
 
 				store.EXPECT().
-					UpdateSession(gomock.Any(), gomock.Any()).
+					GetUser(gomock.Any(), session.UserID).
+					Times(1).
+					Return(db.User{ID: session.UserID, Role: models.RoleUser}, nil)
+
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ErrSessionRotated)
+
+				store.EXPECT().
+					BlockSessionFamily(gomock.Any(), session.FamilyID).
+					Times(1).
+					Return(nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+				require.Empty(t, recorder.Result().Cookies())
+			},
+		},
+		{
+			name: "DatabaseErrorOnBlockSessionFamily",
+			setupRequest: func(t *testing.T, request *http.Request) {
+				addCookie(request, "refresh_token", session.RefreshToken,
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				rotatedSession := session
+				rotatedSession.RotatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+				store.EXPECT().
+					GetSession(gomock.Any(), session.Uuid).
+					Times(1).
+					Return(rotatedSession, nil)
+
+				store.EXPECT().
+					BlockSessionFamily(gomock.Any(), session.FamilyID).
 					Times(1).
-					Return(db.Session{}, sql.ErrTxDone)
+					Return(sql.ErrConnDone)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "BlockedUser",
+			setupRequest: func(t *testing.T, request *http.Request) {
+				addCookie(request, "refresh_token", session.RefreshToken,
+					session.ExpiresAt, common.RefreshTokenCookiePath, common.CookieDomain)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetSession(gomock.Any(), session.Uuid).
+					Times(1).
+					Return(session, nil)
+
+				store.EXPECT().
+					GetUser(gomock.Any(), session.UserID).
+					Times(1).
+					Return(db.User{ID: session.UserID, IsBlocked: true}, nil)
+
+				store.EXPECT().
+					RotateSessionTx(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "TokenMismatch",
 			setupRequest: func(t *testing.T, request *http.Request) {
 				otherToken, _, err := tokenMaker.CreateToken(session.UserID, time.Minute)
 				require.NoError(t, err)
 				addCookie(request, "refresh_token", otherToken,
-					time.Now().Add(time.Hour), refreshTokenCookiePath, cookieDomain)
+					time.Now().Add(time.Hour), common.RefreshTokenCookiePath, common.CookieDomain)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
@@ -305,8 +402,7 @@ func TestRefreshTokensAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store)
-			server.tokenMaker = tokenMaker
+			server := newTestServer(t, store, tokenMaker)
 			recorder := httptest.NewRecorder()
 
 			request, err := http.NewRequest(http.MethodPost, "/auth/refresh", nil)
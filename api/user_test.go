@@ -16,6 +16,7 @@ import (
 	"reflect"
 	"testing"
 	"time"
+	userdto "treffly/api/dto/user"
 	mockdb "treffly/db/mock"
 	db "treffly/db/sqlc"
 	"treffly/token"
@@ -78,6 +79,18 @@ func TestCreateUserAPI(t *testing.T) {
 					CreateUser(gomock.Any(), EqCreateUserParams(arg, password)).
 					Times(1).
 					Return(user, nil)
+				store.EXPECT().
+					GetUser(gomock.Any(), user.ID).
+					Times(1).
+					Return(user, nil)
+				store.EXPECT().
+					DeleteUserTokens(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
+				store.EXPECT().
+					CreateUserToken(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(nil)
 				store.EXPECT().
 					CreateSession(gomock.Any(), gomock.Any()).
 					Times(1).
@@ -209,7 +222,7 @@ func TestCreateUserAPI(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store)
+			server := newTestServer(t, store, nil)
 			recorder := httptest.NewRecorder()
 
 			data, err := json.Marshal(tc.body)
@@ -298,7 +311,7 @@ func TestLoginUser(t *testing.T) {
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				var response loginUserResponse
+				var response userdto.UserResponse
 				err := json.Unmarshal(recorder.Body.Bytes(), &response)
 				require.NoError(t, err)
 
@@ -344,7 +357,7 @@ func TestLoginUser(t *testing.T) {
 					Return(db.User{}, sql.ErrNoRows)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusNotFound, recorder.Code)
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
 		{
@@ -403,8 +416,7 @@ func TestLoginUser(t *testing.T) {
 			store := mockdb.NewMockStore(ctrl)
 			tc.buildStubs(store)
 
-			server := newTestServer(t, store)
-			server.tokenMaker = tokenMaker
+			server := newTestServer(t, store, tokenMaker)
 			recorder := httptest.NewRecorder()
 
 			data, err := json.Marshal(tc.body)
@@ -468,22 +480,17 @@ func TestLogoutUser(t *testing.T) {
 			defer ctrl.Finish()
 
 			store := mockdb.NewMockStore(ctrl)
-
-			router := gin.New()
-			router.Use(ErrorHandler())
-			server := newTestServer(t, store)
-
-			router.POST(
-				"/logout",
-				authMiddleware(tokenMaker),
-				server.logoutUser,
-			)
+			store.EXPECT().
+				GetUserAccess(gomock.Any(), userID).
+				Return(db.GetUserAccessRow{Role: "user"}, nil).
+				AnyTimes()
+			server := newTestServer(t, store, tokenMaker)
 
 			recorder := httptest.NewRecorder()
 			request, _ := http.NewRequest("POST", "/logout", nil)
 			tc.setupRequest(t, request, tokenMaker)
 
-			router.ServeHTTP(recorder, request)
+			server.router.ServeHTTP(recorder, request)
 			tc.checkResponse(recorder)
 		})
 	}
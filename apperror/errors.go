@@ -13,6 +13,7 @@ type ErrorResponse struct {
 	HTTPCode int    `json:"-"`
 	Title    string `json:"title"`
 	Subtitle string `json:"subtitle"`
+	Field    string `json:"field,omitempty"`
 	Cause    error  `json:"-"`
 }
 
@@ -41,6 +42,12 @@ var (
 		Subtitle: "Войди снова, чтобы продолжить",
 	}
 
+	SessionRevoked = ErrorTemplate{
+		HTTPCode: http.StatusUnauthorized,
+		Title:    "Сессия завершена",
+		Subtitle: "Этот вход был завершён с другого устройства. Войди снова",
+	}
+
 	EmailTaken = ErrorTemplate{
 		HTTPCode: http.StatusBadRequest,
 		Title:    "Почта уже занята",
@@ -59,6 +66,42 @@ var (
 		Subtitle: "У тебя нет доступа к этому разделу",
 	}
 
+	UserBlocked = ErrorTemplate{
+		HTTPCode: http.StatusForbidden,
+		Title:    "Аккаунт заблокирован",
+		Subtitle: "Если считаешь, что это ошибка, напиши в поддержку",
+	}
+
+	EventFull = ErrorTemplate{
+		HTTPCode: http.StatusConflict,
+		Title:    "Мест больше нет",
+		Subtitle: "Встань в лист ожидания — мы добавим тебя, когда место освободится",
+	}
+
+	PromotionConflict = ErrorTemplate{
+		HTTPCode: http.StatusConflict,
+		Title:    "Продвижение уже запланировано",
+		Subtitle: "На это время уже есть заявка на продвижение события",
+	}
+
+	ContentRejected = ErrorTemplate{
+		HTTPCode: http.StatusBadRequest,
+		Title:    "Недопустимый текст",
+		Subtitle: "Текст не прошёл модерацию. Измени его и попробуй снова",
+	}
+
+	ReportExists = ErrorTemplate{
+		HTTPCode: http.StatusConflict,
+		Title:    "Жалоба уже отправлена",
+		Subtitle: "Модераторы рассмотрят её в ближайшее время",
+	}
+
+	InvalidLink = ErrorTemplate{
+		HTTPCode: http.StatusBadRequest,
+		Title:    "Ссылка недействительна",
+		Subtitle: "Срок действия ссылки истёк или она уже использована. Запроси новую",
+	}
+
 	InternalServer = ErrorTemplate{
 		HTTPCode: http.StatusInternalServerError,
 		Title:    "Ошибка сервера",
@@ -70,6 +113,12 @@ var (
 		Title:    "Сервер не отвечает",
 		Subtitle: "Запрос занял слишком много времени. Попробуй позже",
 	}
+
+	ServiceUnavailable = ErrorTemplate{
+		HTTPCode: http.StatusServiceUnavailable,
+		Title:    "Сервер перегружен",
+		Subtitle: "Слишком много подключений. Попробуй позже",
+	}
 )
 
 type ErrorTemplate struct {
@@ -87,6 +136,14 @@ func (t ErrorTemplate) WithCause(cause error) ErrorResponse {
 	}
 }
 
+// WithField is like WithCause but also names the request field that caused
+// the error, so the client can highlight it.
+func (t ErrorTemplate) WithField(field string, cause error) ErrorResponse {
+	resp := t.WithCause(cause)
+	resp.Field = field
+	return resp
+}
+
 func (e ErrorResponse) Error() string {
 	return fmt.Sprintf("%s: %s", e.Title, e.Subtitle)
 }
@@ -96,6 +153,11 @@ func (e ErrorResponse) Unwrap() error {
 }
 
 func WrapDBError(err error) error {
+	var appErr ErrorResponse
+	if errors.As(err, &appErr) {
+		return err
+	}
+
 	if errors.Is(err, sql.ErrNoRows) {
 		return NotFound.WithCause(err)
 	}
@@ -116,6 +178,9 @@ func WrapDBError(err error) error {
 			if pgErr.ConstraintName == "event_user_pkey" {
 				return BadRequest.WithCause(err)
 			}
+			if pgErr.ConstraintName == "reports_event_open_key" || pgErr.ConstraintName == "reports_user_open_key" {
+				return ReportExists.WithCause(err)
+			}
 		case pgerrcode.ForeignKeyViolation:
 			return BadRequest.WithCause(err)
 		}
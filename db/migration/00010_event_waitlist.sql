@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE event_waitlist (
+                                event_id   INTEGER NOT NULL,
+                                user_id    INTEGER NOT NULL,
+                                created_at timestamptz NOT NULL DEFAULT NOW(),
+                                PRIMARY KEY (event_id, user_id)
+);
+
+ALTER TABLE "event_waitlist" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "event_waitlist" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_event_waitlist_queue ON event_waitlist(event_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE event_waitlist;
+-- +goose StatementEnd
@@ -0,0 +1,113 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE event_series (
+                              id         INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                              owner_id   INTEGER NOT NULL,
+                              rrule      TEXT NOT NULL,
+                              created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "event_series" ADD FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE events ADD COLUMN series_id INTEGER;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_series_id_fkey
+        FOREIGN KEY (series_id)
+            REFERENCES event_series(id)
+            ON DELETE SET NULL;
+
+CREATE INDEX idx_events_series_date ON events(series_id, date) WHERE series_id IS NOT NULL;
+
+CREATE OR REPLACE VIEW event_with_tags_view AS
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    COALESCE(
+            JSON_AGG(
+                    json_build_object('id', t.id, 'name', t.name)
+                        ORDER BY t.name
+            ) FILTER (WHERE t.id IS NOT NULL),
+            '[]'::JSON
+    ) AS tags,
+    e.geom,
+    u.username AS owner_username,
+    (SELECT COUNT(*)
+     FROM event_user eu
+     WHERE eu.event_id = e.id) AS participants_count,
+     i_event.path AS event_image_path,
+     i_user.path AS user_image_path,
+     e.image_id,
+     e.series_id
+FROM events e
+         LEFT JOIN event_tags et ON e.id = et.event_id
+         LEFT JOIN tags t ON et.tag_id = t.id
+         LEFT JOIN users u ON e.owner_id = u.id
+         LEFT JOIN images i_event ON e.image_id = i_event.id
+         LEFT JOIN images i_user ON u.image_id = i_user.id
+GROUP BY
+    e.id,
+    u.username,
+    i_event.path,
+    i_user.path;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW IF EXISTS event_with_tags_view;
+
+ALTER TABLE events DROP COLUMN series_id;
+
+DROP TABLE event_series;
+
+CREATE OR REPLACE VIEW event_with_tags_view AS
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    COALESCE(
+            JSON_AGG(
+                    json_build_object('id', t.id, 'name', t.name)
+                        ORDER BY t.name
+            ) FILTER (WHERE t.id IS NOT NULL),
+            '[]'::JSON
+    ) AS tags,
+    e.geom,
+    u.username AS owner_username,
+    (SELECT COUNT(*)
+     FROM event_user eu
+     WHERE eu.event_id = e.id) AS participants_count,
+     i_event.path AS event_image_path,
+     i_user.path AS user_image_path,
+     e.image_id
+FROM events e
+         LEFT JOIN event_tags et ON e.id = et.event_id
+         LEFT JOIN tags t ON et.tag_id = t.id
+         LEFT JOIN users u ON e.owner_id = u.id
+         LEFT JOIN images i_event ON e.image_id = i_event.id
+         LEFT JOIN images i_user ON u.image_id = i_user.id
+GROUP BY
+    e.id,
+    u.username,
+    i_event.path,
+    i_user.path;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE calendar_feeds (
+                                user_id    INTEGER PRIMARY KEY,
+                                token_hash TEXT NOT NULL UNIQUE,
+                                created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "calendar_feeds" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE calendar_feeds;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX tag_name_trgm_idx ON tags USING GIN (name gin_trgm_ops);
+CREATE INDEX user_username_trgm_idx ON users USING GIN (username gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX tag_name_trgm_idx;
+DROP INDEX user_username_trgm_idx;
+-- +goose StatementEnd
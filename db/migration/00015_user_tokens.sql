@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN email_verified boolean NOT NULL DEFAULT false;
+
+CREATE TABLE user_tokens (
+                             token_hash text PRIMARY KEY,
+                             user_id    INTEGER NOT NULL,
+                             purpose    varchar(32) NOT NULL,
+                             expires_at timestamptz NOT NULL,
+                             used_at    timestamptz,
+                             created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "user_tokens" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_user_tokens_user_purpose ON user_tokens(user_id, purpose);
+
+CREATE OR REPLACE VIEW user_with_tags_view AS
+SELECT
+    u.id,
+    u.username,
+    u.email,
+    u.created_at,
+    COALESCE(
+            JSON_AGG(
+                    json_build_object('id', t.id, 'name', t.name)
+                        ORDER BY t.name
+            ) FILTER (WHERE t.id IS NOT NULL),
+            '[]'::JSON
+    ) AS tags,
+    i.path AS image_path,
+    u.email_verified
+FROM users u
+         LEFT JOIN user_tags ut ON u.id = ut.user_id
+         LEFT JOIN tags t ON ut.tag_id = t.id
+         LEFT JOIN images i ON u.image_id = i.id
+GROUP BY u.id, i.path;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_tokens;
+
+DROP VIEW IF EXISTS user_with_tags_view;
+
+ALTER TABLE users DROP COLUMN email_verified;
+
+CREATE VIEW user_with_tags_view AS
+SELECT
+    u.id,
+    u.username,
+    u.email,
+    u.created_at,
+    COALESCE(
+            JSON_AGG(
+                    json_build_object('id', t.id, 'name', t.name)
+                        ORDER BY t.name
+            ) FILTER (WHERE t.id IS NOT NULL),
+            '[]'::JSON
+    ) AS tags,
+    i.path AS image_path
+FROM users u
+         LEFT JOIN user_tags ut ON u.id = ut.user_id
+         LEFT JOIN tags t ON ut.tag_id = t.id
+         LEFT JOIN images i ON u.image_id = i.id
+GROUP BY u.id, i.path;
+-- +goose StatementEnd
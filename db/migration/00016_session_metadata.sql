@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sessions
+    ADD COLUMN user_agent   text        NOT NULL DEFAULT '',
+    ADD COLUMN client_ip    text        NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at timestamptz NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions
+    DROP COLUMN user_agent,
+    DROP COLUMN client_ip,
+    DROP COLUMN last_used_at;
+-- +goose StatementEnd
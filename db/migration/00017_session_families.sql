@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE sessions
+    ADD COLUMN family_id  uuid,
+    ADD COLUMN rotated_at timestamptz;
+
+UPDATE sessions SET family_id = uuid;
+
+ALTER TABLE sessions
+    ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX sessions_family_id_idx ON sessions (family_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM sessions WHERE rotated_at IS NOT NULL;
+
+DROP INDEX sessions_family_id_idx;
+
+ALTER TABLE sessions
+    DROP COLUMN family_id,
+    DROP COLUMN rotated_at;
+-- +goose StatementEnd
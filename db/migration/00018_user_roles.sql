@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN role       varchar(20) NOT NULL DEFAULT 'user',
+    ADD COLUMN is_blocked boolean     NOT NULL DEFAULT false;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_role_check CHECK (role IN ('user', 'organiser', 'moderator', 'admin'));
+
+UPDATE users SET role = 'admin' WHERE is_admin;
+
+ALTER TABLE users DROP COLUMN is_admin;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN is_admin boolean NOT NULL DEFAULT false;
+
+UPDATE users SET is_admin = true WHERE role = 'admin';
+
+ALTER TABLE users
+    DROP COLUMN role,
+    DROP COLUMN is_blocked;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE promotions (
+                            id           INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                            event_id     INTEGER     NOT NULL,
+                            requested_by INTEGER     NOT NULL,
+                            status       varchar(20) NOT NULL DEFAULT 'pending',
+                            starts_at    timestamptz NOT NULL,
+                            ends_at      timestamptz NOT NULL,
+                            reviewed_by  INTEGER,
+                            reviewed_at  timestamptz,
+                            activated_at timestamptz,
+                            finished_at  timestamptz,
+                            created_at   timestamptz NOT NULL DEFAULT NOW(),
+                            CONSTRAINT promotions_window_check CHECK (ends_at > starts_at),
+                            CONSTRAINT promotions_status_check CHECK (
+                                status IN ('pending', 'approved', 'rejected', 'active', 'finished', 'expired')
+                            )
+);
+
+ALTER TABLE "promotions" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+ALTER TABLE "promotions" ADD FOREIGN KEY ("requested_by") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "promotions" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE INDEX idx_promotions_event_id ON promotions(event_id);
+CREATE INDEX idx_promotions_status_window ON promotions(status, starts_at, ends_at);
+
+ALTER TABLE events ADD COLUMN premium_manual boolean NOT NULL DEFAULT false;
+UPDATE events SET premium_manual = is_premium;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN premium_manual;
+DROP TABLE promotions;
+-- +goose StatementEnd
@@ -0,0 +1,127 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN is_hidden boolean NOT NULL DEFAULT false;
+
+CREATE OR REPLACE VIEW event_with_tags_view AS
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    COALESCE(
+            JSON_AGG(
+                    json_build_object('id', t.id, 'name', t.name)
+                        ORDER BY t.name
+            ) FILTER (WHERE t.id IS NOT NULL),
+            '[]'::JSON
+    ) AS tags,
+    e.geom,
+    u.username AS owner_username,
+    (SELECT COUNT(*)
+     FROM event_user eu
+     WHERE eu.event_id = e.id) AS participants_count,
+     i_event.path AS event_image_path,
+     i_user.path AS user_image_path,
+     e.image_id,
+     e.series_id,
+     e.search_vector,
+     e.is_hidden
+FROM events e
+         LEFT JOIN event_tags et ON e.id = et.event_id
+         LEFT JOIN tags t ON et.tag_id = t.id
+         LEFT JOIN users u ON e.owner_id = u.id
+         LEFT JOIN images i_event ON e.image_id = i_event.id
+         LEFT JOIN images i_user ON u.image_id = i_user.id
+GROUP BY
+    e.id,
+    u.username,
+    i_event.path,
+    i_user.path;
+
+CREATE TABLE reports (
+                         id          INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                         reporter_id INTEGER     NOT NULL,
+                         event_id    INTEGER,
+                         user_id     INTEGER,
+                         reason      varchar(20) NOT NULL,
+                         comment     text        NOT NULL DEFAULT '',
+                         status      varchar(20) NOT NULL DEFAULT 'open',
+                         resolved_by INTEGER,
+                         resolved_at timestamptz,
+                         created_at  timestamptz NOT NULL DEFAULT NOW(),
+                         CONSTRAINT reports_target_check CHECK (num_nonnulls(event_id, user_id) = 1),
+                         CONSTRAINT reports_reason_check CHECK (
+                             reason IN ('spam', 'fraud', 'abuse', 'inappropriate', 'other')
+                         ),
+                         CONSTRAINT reports_status_check CHECK (status IN ('open', 'resolved', 'dismissed'))
+);
+
+ALTER TABLE "reports" ADD FOREIGN KEY ("reporter_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "reports" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+ALTER TABLE "reports" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "reports" ADD FOREIGN KEY ("resolved_by") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX reports_event_open_key ON reports(reporter_id, event_id) WHERE status = 'open' AND event_id IS NOT NULL;
+CREATE UNIQUE INDEX reports_user_open_key ON reports(reporter_id, user_id) WHERE status = 'open' AND user_id IS NOT NULL;
+CREATE INDEX idx_reports_status_created_at ON reports(status, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reports;
+
+DROP VIEW IF EXISTS event_with_tags_view;
+
+CREATE OR REPLACE VIEW event_with_tags_view AS
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    COALESCE(
+            JSON_AGG(
+                    json_build_object('id', t.id, 'name', t.name)
+                        ORDER BY t.name
+            ) FILTER (WHERE t.id IS NOT NULL),
+            '[]'::JSON
+    ) AS tags,
+    e.geom,
+    u.username AS owner_username,
+    (SELECT COUNT(*)
+     FROM event_user eu
+     WHERE eu.event_id = e.id) AS participants_count,
+     i_event.path AS event_image_path,
+     i_user.path AS user_image_path,
+     e.image_id,
+     e.series_id,
+     e.search_vector
+FROM events e
+         LEFT JOIN event_tags et ON e.id = et.event_id
+         LEFT JOIN tags t ON et.tag_id = t.id
+         LEFT JOIN users u ON e.owner_id = u.id
+         LEFT JOIN images i_event ON e.image_id = i_event.id
+         LEFT JOIN images i_user ON u.image_id = i_user.id
+GROUP BY
+    e.id,
+    u.username,
+    i_event.path,
+    i_user.path;
+
+ALTER TABLE events DROP COLUMN is_hidden;
+-- +goose StatementEnd
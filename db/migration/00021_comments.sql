@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE comments (
+                          id         INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                          event_id   INTEGER     NOT NULL,
+                          user_id    INTEGER     NOT NULL,
+                          parent_id  INTEGER,
+                          body       text        NOT NULL,
+                          is_pinned  boolean     NOT NULL DEFAULT false,
+                          edited_at  timestamptz,
+                          deleted_at timestamptz,
+                          created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "comments" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+ALTER TABLE "comments" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "comments" ADD FOREIGN KEY ("parent_id") REFERENCES "comments" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_comments_event_id_created_at ON comments(event_id, created_at) WHERE parent_id IS NULL;
+CREATE INDEX idx_comments_parent_id ON comments(parent_id) WHERE parent_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE comments;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE reviews (
+                         event_id   INTEGER     NOT NULL,
+                         user_id    INTEGER     NOT NULL,
+                         rating     SMALLINT    NOT NULL,
+                         body       text        NOT NULL DEFAULT '',
+                         created_at timestamptz NOT NULL DEFAULT NOW(),
+                         updated_at timestamptz NOT NULL DEFAULT NOW(),
+                         PRIMARY KEY (event_id, user_id),
+                         CONSTRAINT reviews_rating_check CHECK (rating BETWEEN 1 AND 5)
+);
+
+ALTER TABLE "reviews" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+ALTER TABLE "reviews" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reviews;
+-- +goose StatementEnd
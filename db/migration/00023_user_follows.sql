@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_follows (
+                              follower_id INTEGER     NOT NULL,
+                              followee_id INTEGER     NOT NULL,
+                              created_at  timestamptz NOT NULL DEFAULT NOW(),
+                              PRIMARY KEY (follower_id, followee_id),
+                              CONSTRAINT user_follows_self_check CHECK (follower_id <> followee_id)
+);
+
+ALTER TABLE "user_follows" ADD FOREIGN KEY ("follower_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "user_follows" ADD FOREIGN KEY ("followee_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_user_follows_followee_id ON user_follows (followee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_follows;
+-- +goose StatementEnd
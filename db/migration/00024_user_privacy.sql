@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_privacy (
+                              user_id         INTEGER     PRIMARY KEY,
+                              hide_attendance boolean     NOT NULL DEFAULT false,
+                              updated_at      timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "user_privacy" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_privacy;
+-- +goose StatementEnd
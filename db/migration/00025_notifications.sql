@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notifications (
+                               id         INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                               user_id    INTEGER     NOT NULL,
+                               type       varchar     NOT NULL,
+                               event_id   INTEGER,
+                               event_name varchar     NOT NULL DEFAULT '',
+                               actor_id   INTEGER,
+                               read_at    timestamptz,
+                               created_at timestamptz NOT NULL DEFAULT NOW(),
+                               CONSTRAINT notifications_type_check CHECK (type IN (
+                                   'event_updated',
+                                   'event_cancelled',
+                                   'participant_joined',
+                                   'waitlist_promoted',
+                                   'event_starting'
+                               ))
+);
+
+ALTER TABLE "notifications" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "notifications" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE SET NULL;
+ALTER TABLE "notifications" ADD FOREIGN KEY ("actor_id") REFERENCES "users" ("id") ON DELETE SET NULL;
+
+CREATE INDEX idx_notifications_user_id ON notifications (user_id, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications (user_id) WHERE read_at IS NULL;
+
+-- One reminder per participant and event, however often the job runs.
+CREATE UNIQUE INDEX notifications_event_starting_key ON notifications (user_id, event_id) WHERE type = 'event_starting';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE notifications;
+-- +goose StatementEnd
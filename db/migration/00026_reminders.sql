@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE push_subscriptions (
+                                    id         INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                    user_id    INTEGER     NOT NULL,
+                                    endpoint   text        NOT NULL UNIQUE,
+                                    p256dh     varchar     NOT NULL,
+                                    auth       varchar     NOT NULL,
+                                    created_at timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "push_subscriptions" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_push_subscriptions_user_id ON push_subscriptions (user_id);
+
+CREATE TABLE reminder_preferences (
+                                      user_id       INTEGER PRIMARY KEY,
+                                      email_enabled boolean     NOT NULL DEFAULT true,
+                                      push_enabled  boolean     NOT NULL DEFAULT true,
+                                      updated_at    timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "reminder_preferences" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+-- A row is claimed before a reminder goes out, which is what keeps it from
+-- being sent twice across restarts or concurrent instances.
+CREATE TABLE reminder_deliveries (
+                                     event_id   INTEGER     NOT NULL,
+                                     user_id    INTEGER     NOT NULL,
+                                     kind       varchar     NOT NULL,
+                                     channel    varchar     NOT NULL,
+                                     status     varchar     NOT NULL DEFAULT 'pending',
+                                     created_at timestamptz NOT NULL DEFAULT NOW(),
+                                     PRIMARY KEY (event_id, user_id, kind, channel),
+                                     CONSTRAINT reminder_deliveries_kind_check CHECK (kind IN ('24h', '1h')),
+                                     CONSTRAINT reminder_deliveries_status_check CHECK (status IN ('pending', 'sent', 'skipped'))
+);
+
+ALTER TABLE "reminder_deliveries" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+ALTER TABLE "reminder_deliveries" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reminder_deliveries;
+DROP TABLE reminder_preferences;
+DROP TABLE push_subscriptions;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbox (
+                        id              BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                        event_type      varchar     NOT NULL,
+                        payload         jsonb       NOT NULL,
+                        attempts        INTEGER     NOT NULL DEFAULT 0,
+                        next_attempt_at timestamptz NOT NULL DEFAULT NOW(),
+                        last_error      TEXT        NOT NULL DEFAULT '',
+                        created_at      timestamptz NOT NULL DEFAULT NOW(),
+                        published_at    timestamptz
+);
+
+CREATE INDEX idx_outbox_pending ON outbox (next_attempt_at) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE outbox;
+-- +goose StatementEnd
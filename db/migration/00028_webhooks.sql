@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhooks (
+                          id                   INTEGER GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                          owner_id             INTEGER     NOT NULL,
+                          url                  varchar     NOT NULL,
+                          secret               varchar     NOT NULL,
+                          event_types          varchar[]   NOT NULL,
+                          is_active            boolean     NOT NULL DEFAULT true,
+                          consecutive_failures INTEGER     NOT NULL DEFAULT 0,
+                          disabled_at          timestamptz,
+                          created_at           timestamptz NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE "webhooks" ADD FOREIGN KEY ("owner_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX idx_webhooks_owner_id ON webhooks (owner_id);
+
+CREATE TABLE webhook_deliveries (
+                                    id              BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                    webhook_id      INTEGER     NOT NULL,
+                                    outbox_id       BIGINT,
+                                    event_type      varchar     NOT NULL,
+                                    payload         jsonb       NOT NULL,
+                                    status          varchar     NOT NULL DEFAULT 'pending',
+                                    attempts        INTEGER     NOT NULL DEFAULT 0,
+                                    next_attempt_at timestamptz NOT NULL DEFAULT NOW(),
+                                    response_status INTEGER,
+                                    last_error      TEXT        NOT NULL DEFAULT '',
+                                    created_at      timestamptz NOT NULL DEFAULT NOW(),
+                                    delivered_at    timestamptz,
+                                    CONSTRAINT webhook_deliveries_status_check CHECK (status IN ('pending', 'succeeded', 'failed'))
+);
+
+ALTER TABLE "webhook_deliveries" ADD FOREIGN KEY ("webhook_id") REFERENCES "webhooks" ("id") ON DELETE CASCADE;
+
+-- The relay delivers at least once; a redelivered outbox message must not
+-- produce a second webhook call. Replays have no outbox_id.
+CREATE UNIQUE INDEX webhook_deliveries_outbox_key ON webhook_deliveries (webhook_id, outbox_id);
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id, id DESC);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+-- +goose StatementEnd
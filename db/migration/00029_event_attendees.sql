@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE event_user ADD COLUMN joined_at timestamptz NOT NULL DEFAULT NOW();
+
+-- A ban outlives the participation it ended: banned users cannot come back,
+-- not even with an invite link.
+CREATE TABLE event_bans (
+                            event_id   INTEGER     NOT NULL,
+                            user_id    INTEGER     NOT NULL,
+                            created_at timestamptz NOT NULL DEFAULT NOW(),
+                            PRIMARY KEY (event_id, user_id)
+);
+
+ALTER TABLE "event_bans" ADD FOREIGN KEY ("event_id") REFERENCES "events" ("id") ON DELETE CASCADE;
+ALTER TABLE "event_bans" ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE event_bans;
+ALTER TABLE event_user DROP COLUMN joined_at;
+-- +goose StatementEnd
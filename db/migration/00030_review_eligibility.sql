@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Reviews require joining before the event. Participants who joined before
+-- event_user.joined_at existed were stamped with the migration time, which
+-- locks them out of reviewing past events; the event's creation time is the
+-- closest known bound for them.
+UPDATE event_user eu
+SET joined_at = e.created_at
+FROM events e
+WHERE e.id = eu.event_id
+  AND eu.joined_at >= e.date;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 1;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A failed delivery stays claimed and is retried after next_attempt_at, so a
+-- broken channel neither resends immediately nor holds up other reminders.
+ALTER TABLE reminder_deliveries ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE reminder_deliveries ADD COLUMN next_attempt_at timestamptz NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reminder_deliveries DROP COLUMN next_attempt_at;
+ALTER TABLE reminder_deliveries DROP COLUMN attempts;
+-- +goose StatementEnd
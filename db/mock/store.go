@@ -12,9 +12,11 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 	db "treffly/db/sqlc"
 
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,180 +44,2307 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
-// AddUserTag mocks base method.
-func (m *MockStore) AddUserTag(ctx context.Context, arg db.AddUserTagParams) (db.UserTag, error) {
+// ActivatePromotions mocks base method.
+func (m *MockStore) ActivatePromotions(ctx context.Context) ([]int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUserTag", ctx, arg)
-	ret0, _ := ret[0].(db.UserTag)
+	ret := m.ctrl.Call(m, "ActivatePromotions", ctx)
+	ret0, _ := ret[0].([]int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddUserTag indicates an expected call of AddUserTag.
-func (mr *MockStoreMockRecorder) AddUserTag(ctx, arg any) *gomock.Call {
+// ActivatePromotions indicates an expected call of ActivatePromotions.
+func (mr *MockStoreMockRecorder) ActivatePromotions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTag", reflect.TypeOf((*MockStore)(nil).AddUserTag), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivatePromotions", reflect.TypeOf((*MockStore)(nil).ActivatePromotions), ctx)
+}
+
+// AddEventParticipant mocks base method.
+func (m *MockStore) AddEventParticipant(ctx context.Context, arg db.AddEventParticipantParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventParticipant", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddEventParticipant indicates an expected call of AddEventParticipant.
+func (mr *MockStoreMockRecorder) AddEventParticipant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventParticipant", reflect.TypeOf((*MockStore)(nil).AddEventParticipant), ctx, arg)
+}
+
+// AddEventTag mocks base method.
+func (m *MockStore) AddEventTag(ctx context.Context, arg db.AddEventTagParams) (db.EventTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEventTag", ctx, arg)
+	ret0, _ := ret[0].(db.EventTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEventTag indicates an expected call of AddEventTag.
+func (mr *MockStoreMockRecorder) AddEventTag(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEventTag", reflect.TypeOf((*MockStore)(nil).AddEventTag), ctx, arg)
+}
+
+// AddUserTags mocks base method.
+func (m *MockStore) AddUserTags(ctx context.Context, arg db.AddUserTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserTags", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserTags indicates an expected call of AddUserTags.
+func (mr *MockStoreMockRecorder) AddUserTags(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserTags", reflect.TypeOf((*MockStore)(nil).AddUserTags), ctx, arg)
+}
+
+// BanEventUser mocks base method.
+func (m *MockStore) BanEventUser(ctx context.Context, arg db.BanEventUserParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanEventUser", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanEventUser indicates an expected call of BanEventUser.
+func (mr *MockStoreMockRecorder) BanEventUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanEventUser", reflect.TypeOf((*MockStore)(nil).BanEventUser), ctx, arg)
+}
+
+// BlockOtherUserSessions mocks base method.
+func (m *MockStore) BlockOtherUserSessions(ctx context.Context, arg db.BlockOtherUserSessionsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOtherUserSessions", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockOtherUserSessions indicates an expected call of BlockOtherUserSessions.
+func (mr *MockStoreMockRecorder) BlockOtherUserSessions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOtherUserSessions", reflect.TypeOf((*MockStore)(nil).BlockOtherUserSessions), ctx, arg)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(ctx context.Context, arg db.BlockSessionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), ctx, arg)
+}
+
+// BlockSessionFamily mocks base method.
+func (m *MockStore) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessionFamily", ctx, familyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockSessionFamily indicates an expected call of BlockSessionFamily.
+func (mr *MockStoreMockRecorder) BlockSessionFamily(ctx, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessionFamily", reflect.TypeOf((*MockStore)(nil).BlockSessionFamily), ctx, familyID)
+}
+
+// BlockUserSessions mocks base method.
+func (m *MockStore) BlockUserSessions(ctx context.Context, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUserSessions indicates an expected call of BlockUserSessions.
+func (mr *MockStoreMockRecorder) BlockUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUserSessions", reflect.TypeOf((*MockStore)(nil).BlockUserSessions), ctx, userID)
+}
+
+// ClaimDueWebhookDeliveries mocks base method.
+func (m *MockStore) ClaimDueWebhookDeliveries(ctx context.Context, arg db.ClaimDueWebhookDeliveriesParams) ([]db.ClaimDueWebhookDeliveriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]db.ClaimDueWebhookDeliveriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueWebhookDeliveries indicates an expected call of ClaimDueWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ClaimDueWebhookDeliveries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ClaimDueWebhookDeliveries), ctx, arg)
+}
+
+// ClaimOutboxMessages mocks base method.
+func (m *MockStore) ClaimOutboxMessages(ctx context.Context, arg db.ClaimOutboxMessagesParams) ([]db.ClaimOutboxMessagesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimOutboxMessages", ctx, arg)
+	ret0, _ := ret[0].([]db.ClaimOutboxMessagesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimOutboxMessages indicates an expected call of ClaimOutboxMessages.
+func (mr *MockStoreMockRecorder) ClaimOutboxMessages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimOutboxMessages", reflect.TypeOf((*MockStore)(nil).ClaimOutboxMessages), ctx, arg)
+}
+
+// ClaimReminderDelivery mocks base method.
+func (m *MockStore) ClaimReminderDelivery(ctx context.Context, arg db.ClaimReminderDeliveryParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimReminderDelivery", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimReminderDelivery indicates an expected call of ClaimReminderDelivery.
+func (mr *MockStoreMockRecorder) ClaimReminderDelivery(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimReminderDelivery", reflect.TypeOf((*MockStore)(nil).ClaimReminderDelivery), ctx, arg)
+}
+
+// ConsumeUserToken mocks base method.
+func (m *MockStore) ConsumeUserToken(ctx context.Context, arg db.ConsumeUserTokenParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeUserToken", ctx, arg)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeUserToken indicates an expected call of ConsumeUserToken.
+func (mr *MockStoreMockRecorder) ConsumeUserToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeUserToken", reflect.TypeOf((*MockStore)(nil).ConsumeUserToken), ctx, arg)
+}
+
+// CountEventParticipants mocks base method.
+func (m *MockStore) CountEventParticipants(ctx context.Context, eventID int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountEventParticipants", ctx, eventID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountEventParticipants indicates an expected call of CountEventParticipants.
+func (mr *MockStoreMockRecorder) CountEventParticipants(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountEventParticipants", reflect.TypeOf((*MockStore)(nil).CountEventParticipants), ctx, eventID)
+}
+
+// CountImageReferences mocks base method.
+func (m *MockStore) CountImageReferences(ctx context.Context, id pgtype.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountImageReferences", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountImageReferences indicates an expected call of CountImageReferences.
+func (mr *MockStoreMockRecorder) CountImageReferences(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountImageReferences", reflect.TypeOf((*MockStore)(nil).CountImageReferences), ctx, id)
+}
+
+// CountUnreadNotifications mocks base method.
+func (m *MockStore) CountUnreadNotifications(ctx context.Context, userID int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications.
+func (mr *MockStoreMockRecorder) CountUnreadNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockStore)(nil).CountUnreadNotifications), ctx, userID)
+}
+
+// CreateComment mocks base method.
+func (m *MockStore) CreateComment(ctx context.Context, arg db.CreateCommentParams) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, arg)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockStoreMockRecorder) CreateComment(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockStore)(nil).CreateComment), ctx, arg)
+}
+
+// CreateEvent mocks base method.
+func (m *MockStore) CreateEvent(ctx context.Context, arg db.CreateEventParams) (db.CreateEventRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, arg)
+	ret0, _ := ret[0].(db.CreateEventRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockStoreMockRecorder) CreateEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockStore)(nil).CreateEvent), ctx, arg)
+}
+
+// CreateEventSeries mocks base method.
+func (m *MockStore) CreateEventSeries(ctx context.Context, arg db.CreateEventSeriesParams) (db.EventSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventSeries", ctx, arg)
+	ret0, _ := ret[0].(db.EventSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventSeries indicates an expected call of CreateEventSeries.
+func (mr *MockStoreMockRecorder) CreateEventSeries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSeries", reflect.TypeOf((*MockStore)(nil).CreateEventSeries), ctx, arg)
+}
+
+// CreateEventSeriesTx mocks base method.
+func (m *MockStore) CreateEventSeriesTx(ctx context.Context, arg db.CreateEventSeriesTxParams, imageParams db.CreateImageParams) (db.GetEventRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventSeriesTx", ctx, arg, imageParams)
+	ret0, _ := ret[0].(db.GetEventRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventSeriesTx indicates an expected call of CreateEventSeriesTx.
+func (mr *MockStoreMockRecorder) CreateEventSeriesTx(ctx, arg, imageParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSeriesTx", reflect.TypeOf((*MockStore)(nil).CreateEventSeriesTx), ctx, arg, imageParams)
+}
+
+// CreateEventTx mocks base method.
+func (m *MockStore) CreateEventTx(ctx context.Context, eventParams db.CreateEventTxParams, imageParams db.CreateImageParams) (db.GetEventRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventTx", ctx, eventParams, imageParams)
+	ret0, _ := ret[0].(db.GetEventRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventTx indicates an expected call of CreateEventTx.
+func (mr *MockStoreMockRecorder) CreateEventTx(ctx, eventParams, imageParams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventTx", reflect.TypeOf((*MockStore)(nil).CreateEventTx), ctx, eventParams, imageParams)
+}
+
+// CreateImage mocks base method.
+func (m *MockStore) CreateImage(ctx context.Context, arg db.CreateImageParams) (db.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImage", ctx, arg)
+	ret0, _ := ret[0].(db.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImage indicates an expected call of CreateImage.
+func (mr *MockStoreMockRecorder) CreateImage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockStore)(nil).CreateImage), ctx, arg)
+}
+
+// CreateNotification mocks base method.
+func (m *MockStore) CreateNotification(ctx context.Context, arg db.CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockStoreMockRecorder) CreateNotification(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockStore)(nil).CreateNotification), ctx, arg)
+}
+
+// CreateOutboxMessage mocks base method.
+func (m *MockStore) CreateOutboxMessage(ctx context.Context, arg db.CreateOutboxMessageParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxMessage", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOutboxMessage indicates an expected call of CreateOutboxMessage.
+func (mr *MockStoreMockRecorder) CreateOutboxMessage(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxMessage", reflect.TypeOf((*MockStore)(nil).CreateOutboxMessage), ctx, arg)
+}
+
+// CreatePrivateEventToken mocks base method.
+func (m *MockStore) CreatePrivateEventToken(ctx context.Context, arg db.CreatePrivateEventTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateEventToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePrivateEventToken indicates an expected call of CreatePrivateEventToken.
+func (mr *MockStoreMockRecorder) CreatePrivateEventToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateEventToken", reflect.TypeOf((*MockStore)(nil).CreatePrivateEventToken), ctx, arg)
+}
+
+// CreatePromotion mocks base method.
+func (m *MockStore) CreatePromotion(ctx context.Context, arg db.CreatePromotionParams) (db.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, arg)
+	ret0, _ := ret[0].(db.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockStoreMockRecorder) CreatePromotion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockStore)(nil).CreatePromotion), ctx, arg)
+}
+
+// CreatePromotionTx mocks base method.
+func (m *MockStore) CreatePromotionTx(ctx context.Context, arg db.CreatePromotionParams) (db.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotionTx", ctx, arg)
+	ret0, _ := ret[0].(db.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePromotionTx indicates an expected call of CreatePromotionTx.
+func (mr *MockStoreMockRecorder) CreatePromotionTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotionTx", reflect.TypeOf((*MockStore)(nil).CreatePromotionTx), ctx, arg)
+}
+
+// CreateReport mocks base method.
+func (m *MockStore) CreateReport(ctx context.Context, arg db.CreateReportParams) (db.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, arg)
+	ret0, _ := ret[0].(db.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockStoreMockRecorder) CreateReport(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockStore)(nil).CreateReport), ctx, arg)
+}
+
+// CreateReportTx mocks base method.
+func (m *MockStore) CreateReportTx(ctx context.Context, params db.CreateReportTxParams) (db.CreateReportTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReportTx", ctx, params)
+	ret0, _ := ret[0].(db.CreateReportTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportTx indicates an expected call of CreateReportTx.
+func (mr *MockStoreMockRecorder) CreateReportTx(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportTx", reflect.TypeOf((*MockStore)(nil).CreateReportTx), ctx, params)
+}
+
+// CreateRotatedSession mocks base method.
+func (m *MockStore) CreateRotatedSession(ctx context.Context, arg db.CreateRotatedSessionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRotatedSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRotatedSession indicates an expected call of CreateRotatedSession.
+func (mr *MockStoreMockRecorder) CreateRotatedSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRotatedSession", reflect.TypeOf((*MockStore)(nil).CreateRotatedSession), ctx, arg)
 }
 
 // CreateSession mocks base method.
 func (m *MockStore) CreateSession(ctx context.Context, arg db.CreateSessionParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), ctx, arg)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+}
+
+// CreateUserToken mocks base method.
+func (m *MockStore) CreateUserToken(ctx context.Context, arg db.CreateUserTokenParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserToken", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserToken indicates an expected call of CreateUserToken.
+func (mr *MockStoreMockRecorder) CreateUserToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserToken", reflect.TypeOf((*MockStore)(nil).CreateUserToken), ctx, arg)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockStore) CreateWebhook(ctx context.Context, arg db.CreateWebhookParams) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockStoreMockRecorder) CreateWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockStore)(nil).CreateWebhook), ctx, arg)
+}
+
+// DeleteAllEventTags mocks base method.
+func (m *MockStore) DeleteAllEventTags(ctx context.Context, eventID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllEventTags", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllEventTags indicates an expected call of DeleteAllEventTags.
+func (mr *MockStoreMockRecorder) DeleteAllEventTags(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllEventTags", reflect.TypeOf((*MockStore)(nil).DeleteAllEventTags), ctx, eventID)
+}
+
+// DeleteCalendarFeed mocks base method.
+func (m *MockStore) DeleteCalendarFeed(ctx context.Context, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendarFeed", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendarFeed indicates an expected call of DeleteCalendarFeed.
+func (mr *MockStoreMockRecorder) DeleteCalendarFeed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendarFeed", reflect.TypeOf((*MockStore)(nil).DeleteCalendarFeed), ctx, userID)
+}
+
+// DeleteEvent mocks base method.
+func (m *MockStore) DeleteEvent(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEvent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEvent indicates an expected call of DeleteEvent.
+func (mr *MockStoreMockRecorder) DeleteEvent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockStore)(nil).DeleteEvent), ctx, id)
+}
+
+// DeleteEventTx mocks base method.
+func (m *MockStore) DeleteEventTx(ctx context.Context, eventID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventTx", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventTx indicates an expected call of DeleteEventTx.
+func (mr *MockStoreMockRecorder) DeleteEventTx(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventTx", reflect.TypeOf((*MockStore)(nil).DeleteEventTx), ctx, eventID)
+}
+
+// DeleteExpiredOutboxMessages mocks base method.
+func (m *MockStore) DeleteExpiredOutboxMessages(ctx context.Context, arg db.DeleteExpiredOutboxMessagesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredOutboxMessages", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredOutboxMessages indicates an expected call of DeleteExpiredOutboxMessages.
+func (mr *MockStoreMockRecorder) DeleteExpiredOutboxMessages(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredOutboxMessages", reflect.TypeOf((*MockStore)(nil).DeleteExpiredOutboxMessages), ctx, arg)
+}
+
+// DeleteImage mocks base method.
+func (m *MockStore) DeleteImage(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockStoreMockRecorder) DeleteImage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockStore)(nil).DeleteImage), ctx, id)
+}
+
+// DeletePushSubscription mocks base method.
+func (m *MockStore) DeletePushSubscription(ctx context.Context, arg db.DeletePushSubscriptionParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePushSubscription", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePushSubscription indicates an expected call of DeletePushSubscription.
+func (mr *MockStoreMockRecorder) DeletePushSubscription(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePushSubscription", reflect.TypeOf((*MockStore)(nil).DeletePushSubscription), ctx, arg)
+}
+
+// DeletePushSubscriptionByEndpoint mocks base method.
+func (m *MockStore) DeletePushSubscriptionByEndpoint(ctx context.Context, endpoint string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePushSubscriptionByEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePushSubscriptionByEndpoint indicates an expected call of DeletePushSubscriptionByEndpoint.
+func (mr *MockStoreMockRecorder) DeletePushSubscriptionByEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePushSubscriptionByEndpoint", reflect.TypeOf((*MockStore)(nil).DeletePushSubscriptionByEndpoint), ctx, endpoint)
+}
+
+// DeleteReview mocks base method.
+func (m *MockStore) DeleteReview(ctx context.Context, arg db.DeleteReviewParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockStoreMockRecorder) DeleteReview(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockStore)(nil).DeleteReview), ctx, arg)
+}
+
+// DeleteUser mocks base method.
+func (m *MockStore) DeleteUser(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockStoreMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, id)
+}
+
+// DeleteUserTags mocks base method.
+func (m *MockStore) DeleteUserTags(ctx context.Context, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTags", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserTags indicates an expected call of DeleteUserTags.
+func (mr *MockStoreMockRecorder) DeleteUserTags(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTags", reflect.TypeOf((*MockStore)(nil).DeleteUserTags), ctx, userID)
+}
+
+// DeleteUserTokens mocks base method.
+func (m *MockStore) DeleteUserTokens(ctx context.Context, arg db.DeleteUserTokensParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserTokens", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserTokens indicates an expected call of DeleteUserTokens.
+func (mr *MockStoreMockRecorder) DeleteUserTokens(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTokens", reflect.TypeOf((*MockStore)(nil).DeleteUserTokens), ctx, arg)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockStore) DeleteWebhook(ctx context.Context, arg db.DeleteWebhookParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockStoreMockRecorder) DeleteWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockStore)(nil).DeleteWebhook), ctx, arg)
+}
+
+// EnqueueWebhookDeliveries mocks base method.
+func (m *MockStore) EnqueueWebhookDeliveries(ctx context.Context, arg db.EnqueueWebhookDeliveriesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueWebhookDeliveries indicates an expected call of EnqueueWebhookDeliveries.
+func (mr *MockStoreMockRecorder) EnqueueWebhookDeliveries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).EnqueueWebhookDeliveries), ctx, arg)
+}
+
+// FinishPromotions mocks base method.
+func (m *MockStore) FinishPromotions(ctx context.Context) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPromotions", ctx)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishPromotions indicates an expected call of FinishPromotions.
+func (mr *MockStoreMockRecorder) FinishPromotions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPromotions", reflect.TypeOf((*MockStore)(nil).FinishPromotions), ctx)
+}
+
+// FollowUser mocks base method.
+func (m *MockStore) FollowUser(ctx context.Context, arg db.FollowUserParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockStoreMockRecorder) FollowUser(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockStore)(nil).FollowUser), ctx, arg)
+}
+
+// GetAllUserTags mocks base method.
+func (m *MockStore) GetAllUserTags(ctx context.Context, id int32) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUserTags", ctx, id)
+	ret0, _ := ret[0].([]db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUserTags indicates an expected call of GetAllUserTags.
+func (mr *MockStoreMockRecorder) GetAllUserTags(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUserTags", reflect.TypeOf((*MockStore)(nil).GetAllUserTags), ctx, id)
+}
+
+// GetCalendarFeedUserID mocks base method.
+func (m *MockStore) GetCalendarFeedUserID(ctx context.Context, tokenHash string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarFeedUserID", ctx, tokenHash)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarFeedUserID indicates an expected call of GetCalendarFeedUserID.
+func (mr *MockStoreMockRecorder) GetCalendarFeedUserID(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarFeedUserID", reflect.TypeOf((*MockStore)(nil).GetCalendarFeedUserID), ctx, tokenHash)
+}
+
+// GetComment mocks base method.
+func (m *MockStore) GetComment(ctx context.Context, id int32) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComment", ctx, id)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComment indicates an expected call of GetComment.
+func (mr *MockStoreMockRecorder) GetComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComment", reflect.TypeOf((*MockStore)(nil).GetComment), ctx, id)
+}
+
+// GetEvent mocks base method.
+func (m *MockStore) GetEvent(ctx context.Context, arg db.GetEventParams) (db.GetEventRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvent", ctx, arg)
+	ret0, _ := ret[0].(db.GetEventRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvent indicates an expected call of GetEvent.
+func (mr *MockStoreMockRecorder) GetEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvent", reflect.TypeOf((*MockStore)(nil).GetEvent), ctx, arg)
+}
+
+// GetEventCapacityForUpdate mocks base method.
+func (m *MockStore) GetEventCapacityForUpdate(ctx context.Context, id int32) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventCapacityForUpdate", ctx, id)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventCapacityForUpdate indicates an expected call of GetEventCapacityForUpdate.
+func (mr *MockStoreMockRecorder) GetEventCapacityForUpdate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCapacityForUpdate", reflect.TypeOf((*MockStore)(nil).GetEventCapacityForUpdate), ctx, id)
+}
+
+// GetEventRating mocks base method.
+func (m *MockStore) GetEventRating(ctx context.Context, eventID int32) (db.GetEventRatingRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventRating", ctx, eventID)
+	ret0, _ := ret[0].(db.GetEventRatingRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventRating indicates an expected call of GetEventRating.
+func (mr *MockStoreMockRecorder) GetEventRating(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventRating", reflect.TypeOf((*MockStore)(nil).GetEventRating), ctx, eventID)
+}
+
+// GetEventSummary mocks base method.
+func (m *MockStore) GetEventSummary(ctx context.Context, id int32) (db.GetEventSummaryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSummary", ctx, id)
+	ret0, _ := ret[0].(db.GetEventSummaryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventSummary indicates an expected call of GetEventSummary.
+func (mr *MockStoreMockRecorder) GetEventSummary(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSummary", reflect.TypeOf((*MockStore)(nil).GetEventSummary), ctx, id)
+}
+
+// GetFollowCounts mocks base method.
+func (m *MockStore) GetFollowCounts(ctx context.Context, userID int32) (db.GetFollowCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowCounts", ctx, userID)
+	ret0, _ := ret[0].(db.GetFollowCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowCounts indicates an expected call of GetFollowCounts.
+func (mr *MockStoreMockRecorder) GetFollowCounts(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowCounts", reflect.TypeOf((*MockStore)(nil).GetFollowCounts), ctx, userID)
+}
+
+// GetFollowingFeedEvents mocks base method.
+func (m *MockStore) GetFollowingFeedEvents(ctx context.Context, arg db.GetFollowingFeedEventsParams) ([]db.GetFollowingFeedEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowingFeedEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.GetFollowingFeedEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowingFeedEvents indicates an expected call of GetFollowingFeedEvents.
+func (mr *MockStoreMockRecorder) GetFollowingFeedEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowingFeedEvents", reflect.TypeOf((*MockStore)(nil).GetFollowingFeedEvents), ctx, arg)
+}
+
+// GetGuestRecommendedEvents mocks base method.
+func (m *MockStore) GetGuestRecommendedEvents(ctx context.Context, arg db.GetGuestRecommendedEventsParams) ([]db.GetGuestRecommendedEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestRecommendedEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.GetGuestRecommendedEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGuestRecommendedEvents indicates an expected call of GetGuestRecommendedEvents.
+func (mr *MockStoreMockRecorder) GetGuestRecommendedEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestRecommendedEvents", reflect.TypeOf((*MockStore)(nil).GetGuestRecommendedEvents), ctx, arg)
+}
+
+// GetImageByEventID mocks base method.
+func (m *MockStore) GetImageByEventID(ctx context.Context, id int32) (db.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageByEventID", ctx, id)
+	ret0, _ := ret[0].(db.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageByEventID indicates an expected call of GetImageByEventID.
+func (mr *MockStoreMockRecorder) GetImageByEventID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageByEventID", reflect.TypeOf((*MockStore)(nil).GetImageByEventID), ctx, id)
+}
+
+// GetImageByUserID mocks base method.
+func (m *MockStore) GetImageByUserID(ctx context.Context, id int32) (db.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageByUserID", ctx, id)
+	ret0, _ := ret[0].(db.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageByUserID indicates an expected call of GetImageByUserID.
+func (mr *MockStoreMockRecorder) GetImageByUserID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageByUserID", reflect.TypeOf((*MockStore)(nil).GetImageByUserID), ctx, id)
+}
+
+// GetLatestEvents mocks base method.
+func (m *MockStore) GetLatestEvents(ctx context.Context) ([]db.GetLatestEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEvents", ctx)
+	ret0, _ := ret[0].([]db.GetLatestEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEvents indicates an expected call of GetLatestEvents.
+func (mr *MockStoreMockRecorder) GetLatestEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEvents", reflect.TypeOf((*MockStore)(nil).GetLatestEvents), ctx)
+}
+
+// GetOrganizerRating mocks base method.
+func (m *MockStore) GetOrganizerRating(ctx context.Context, ownerID int32) (db.GetOrganizerRatingRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizerRating", ctx, ownerID)
+	ret0, _ := ret[0].(db.GetOrganizerRatingRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrganizerRating indicates an expected call of GetOrganizerRating.
+func (mr *MockStoreMockRecorder) GetOrganizerRating(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizerRating", reflect.TypeOf((*MockStore)(nil).GetOrganizerRating), ctx, ownerID)
+}
+
+// GetOwnedUserEvents mocks base method.
+func (m *MockStore) GetOwnedUserEvents(ctx context.Context, arg db.GetOwnedUserEventsParams) ([]db.GetOwnedUserEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnedUserEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.GetOwnedUserEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnedUserEvents indicates an expected call of GetOwnedUserEvents.
+func (mr *MockStoreMockRecorder) GetOwnedUserEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnedUserEvents", reflect.TypeOf((*MockStore)(nil).GetOwnedUserEvents), ctx, arg)
+}
+
+// GetParticipantJoinedAt mocks base method.
+func (m *MockStore) GetParticipantJoinedAt(ctx context.Context, arg db.GetParticipantJoinedAtParams) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParticipantJoinedAt", ctx, arg)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParticipantJoinedAt indicates an expected call of GetParticipantJoinedAt.
+func (mr *MockStoreMockRecorder) GetParticipantJoinedAt(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantJoinedAt", reflect.TypeOf((*MockStore)(nil).GetParticipantJoinedAt), ctx, arg)
+}
+
+// GetPastUserEvents mocks base method.
+func (m *MockStore) GetPastUserEvents(ctx context.Context, arg db.GetPastUserEventsParams) ([]db.GetPastUserEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPastUserEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.GetPastUserEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPastUserEvents indicates an expected call of GetPastUserEvents.
+func (mr *MockStoreMockRecorder) GetPastUserEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPastUserEvents", reflect.TypeOf((*MockStore)(nil).GetPastUserEvents), ctx, arg)
+}
+
+// GetPopularEvents mocks base method.
+func (m *MockStore) GetPopularEvents(ctx context.Context) ([]db.GetPopularEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPopularEvents", ctx)
+	ret0, _ := ret[0].([]db.GetPopularEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPopularEvents indicates an expected call of GetPopularEvents.
+func (mr *MockStoreMockRecorder) GetPopularEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularEvents", reflect.TypeOf((*MockStore)(nil).GetPopularEvents), ctx)
+}
+
+// GetPremiumEvents mocks base method.
+func (m *MockStore) GetPremiumEvents(ctx context.Context) ([]db.GetPremiumEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPremiumEvents", ctx)
+	ret0, _ := ret[0].([]db.GetPremiumEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPremiumEvents indicates an expected call of GetPremiumEvents.
+func (mr *MockStoreMockRecorder) GetPremiumEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPremiumEvents", reflect.TypeOf((*MockStore)(nil).GetPremiumEvents), ctx)
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockStore) GetPrivacySettings(ctx context.Context, userID int32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockStoreMockRecorder) GetPrivacySettings(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockStore)(nil).GetPrivacySettings), ctx, userID)
+}
+
+// GetPublicProfile mocks base method.
+func (m *MockStore) GetPublicProfile(ctx context.Context, userID int32) (db.GetPublicProfileRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicProfile", ctx, userID)
+	ret0, _ := ret[0].(db.GetPublicProfileRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicProfile indicates an expected call of GetPublicProfile.
+func (mr *MockStoreMockRecorder) GetPublicProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicProfile", reflect.TypeOf((*MockStore)(nil).GetPublicProfile), ctx, userID)
+}
+
+// GetReminderPreferences mocks base method.
+func (m *MockStore) GetReminderPreferences(ctx context.Context, userID int32) (db.GetReminderPreferencesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminderPreferences", ctx, userID)
+	ret0, _ := ret[0].(db.GetReminderPreferencesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminderPreferences indicates an expected call of GetReminderPreferences.
+func (mr *MockStoreMockRecorder) GetReminderPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderPreferences", reflect.TypeOf((*MockStore)(nil).GetReminderPreferences), ctx, userID)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(ctx context.Context, argUuid uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, argUuid)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(ctx, argUuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, argUuid)
+}
+
+// GetTags mocks base method.
+func (m *MockStore) GetTags(ctx context.Context) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret0, _ := ret[0].([]db.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockStoreMockRecorder) GetTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockStore)(nil).GetTags), ctx)
+}
+
+// GetUpcomingUserEvents mocks base method.
+func (m *MockStore) GetUpcomingUserEvents(ctx context.Context, arg db.GetUpcomingUserEventsParams) ([]db.GetUpcomingUserEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingUserEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.GetUpcomingUserEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingUserEvents indicates an expected call of GetUpcomingUserEvents.
+func (mr *MockStoreMockRecorder) GetUpcomingUserEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingUserEvents", reflect.TypeOf((*MockStore)(nil).GetUpcomingUserEvents), ctx, arg)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(ctx context.Context, id int32) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, id)
+}
+
+// GetUserAccess mocks base method.
+func (m *MockStore) GetUserAccess(ctx context.Context, id int32) (db.GetUserAccessRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAccess", ctx, id)
+	ret0, _ := ret[0].(db.GetUserAccessRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAccess indicates an expected call of GetUserAccess.
+func (mr *MockStoreMockRecorder) GetUserAccess(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAccess", reflect.TypeOf((*MockStore)(nil).GetUserAccess), ctx, id)
+}
+
+// GetUserByEmail mocks base method.
+func (m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByEmail indicates an expected call of GetUserByEmail.
+func (mr *MockStoreMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserRecommendedEvents mocks base method.
+func (m *MockStore) GetUserRecommendedEvents(ctx context.Context, arg db.GetUserRecommendedEventsParams) ([]db.GetUserRecommendedEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRecommendedEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.GetUserRecommendedEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRecommendedEvents indicates an expected call of GetUserRecommendedEvents.
+func (mr *MockStoreMockRecorder) GetUserRecommendedEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecommendedEvents", reflect.TypeOf((*MockStore)(nil).GetUserRecommendedEvents), ctx, arg)
+}
+
+// GetUserWithTags mocks base method.
+func (m *MockStore) GetUserWithTags(ctx context.Context, id int32) (db.UserWithTagsView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWithTags", ctx, id)
+	ret0, _ := ret[0].(db.UserWithTagsView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWithTags indicates an expected call of GetUserWithTags.
+func (mr *MockStoreMockRecorder) GetUserWithTags(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWithTags", reflect.TypeOf((*MockStore)(nil).GetUserWithTags), ctx, id)
+}
+
+// GetWaitlistStatus mocks base method.
+func (m *MockStore) GetWaitlistStatus(ctx context.Context, arg db.GetWaitlistStatusParams) (db.GetWaitlistStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWaitlistStatus", ctx, arg)
+	ret0, _ := ret[0].(db.GetWaitlistStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWaitlistStatus indicates an expected call of GetWaitlistStatus.
+func (mr *MockStoreMockRecorder) GetWaitlistStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistStatus", reflect.TypeOf((*MockStore)(nil).GetWaitlistStatus), ctx, arg)
+}
+
+// HasActiveUserToken mocks base method.
+func (m *MockStore) HasActiveUserToken(ctx context.Context, arg db.HasActiveUserTokenParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasActiveUserToken", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasActiveUserToken indicates an expected call of HasActiveUserToken.
+func (mr *MockStoreMockRecorder) HasActiveUserToken(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasActiveUserToken", reflect.TypeOf((*MockStore)(nil).HasActiveUserToken), ctx, arg)
+}
+
+// HasOverlappingPromotion mocks base method.
+func (m *MockStore) HasOverlappingPromotion(ctx context.Context, arg db.HasOverlappingPromotionParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOverlappingPromotion", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOverlappingPromotion indicates an expected call of HasOverlappingPromotion.
+func (mr *MockStoreMockRecorder) HasOverlappingPromotion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOverlappingPromotion", reflect.TypeOf((*MockStore)(nil).HasOverlappingPromotion), ctx, arg)
+}
+
+// HideReportedEvent mocks base method.
+func (m *MockStore) HideReportedEvent(ctx context.Context, arg db.HideReportedEventParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideReportedEvent", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideReportedEvent indicates an expected call of HideReportedEvent.
+func (mr *MockStoreMockRecorder) HideReportedEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideReportedEvent", reflect.TypeOf((*MockStore)(nil).HideReportedEvent), ctx, arg)
+}
+
+// IsBannedFromEvent mocks base method.
+func (m *MockStore) IsBannedFromEvent(ctx context.Context, arg db.IsBannedFromEventParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBannedFromEvent", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBannedFromEvent indicates an expected call of IsBannedFromEvent.
+func (mr *MockStoreMockRecorder) IsBannedFromEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBannedFromEvent", reflect.TypeOf((*MockStore)(nil).IsBannedFromEvent), ctx, arg)
+}
+
+// IsFollowing mocks base method.
+func (m *MockStore) IsFollowing(ctx context.Context, arg db.IsFollowingParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFollowing", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFollowing indicates an expected call of IsFollowing.
+func (mr *MockStoreMockRecorder) IsFollowing(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFollowing", reflect.TypeOf((*MockStore)(nil).IsFollowing), ctx, arg)
+}
+
+// IsParticipant mocks base method.
+func (m *MockStore) IsParticipant(ctx context.Context, arg db.IsParticipantParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsParticipant", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsParticipant indicates an expected call of IsParticipant.
+func (mr *MockStoreMockRecorder) IsParticipant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsParticipant", reflect.TypeOf((*MockStore)(nil).IsParticipant), ctx, arg)
+}
+
+// JoinEventWaitlist mocks base method.
+func (m *MockStore) JoinEventWaitlist(ctx context.Context, arg db.JoinEventWaitlistParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinEventWaitlist", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinEventWaitlist indicates an expected call of JoinEventWaitlist.
+func (mr *MockStoreMockRecorder) JoinEventWaitlist(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinEventWaitlist", reflect.TypeOf((*MockStore)(nil).JoinEventWaitlist), ctx, arg)
+}
+
+// JoinEventWaitlistTx mocks base method.
+func (m *MockStore) JoinEventWaitlistTx(ctx context.Context, arg db.JoinEventWaitlistParams) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinEventWaitlistTx", ctx, arg)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinEventWaitlistTx indicates an expected call of JoinEventWaitlistTx.
+func (mr *MockStoreMockRecorder) JoinEventWaitlistTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinEventWaitlistTx", reflect.TypeOf((*MockStore)(nil).JoinEventWaitlistTx), ctx, arg)
+}
+
+// LeaveEventWaitlist mocks base method.
+func (m *MockStore) LeaveEventWaitlist(ctx context.Context, arg db.LeaveEventWaitlistParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveEventWaitlist", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveEventWaitlist indicates an expected call of LeaveEventWaitlist.
+func (mr *MockStoreMockRecorder) LeaveEventWaitlist(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveEventWaitlist", reflect.TypeOf((*MockStore)(nil).LeaveEventWaitlist), ctx, arg)
+}
+
+// ListAllEventParticipants mocks base method.
+func (m *MockStore) ListAllEventParticipants(ctx context.Context, eventID int32) ([]db.ListAllEventParticipantsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllEventParticipants", ctx, eventID)
+	ret0, _ := ret[0].([]db.ListAllEventParticipantsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllEventParticipants indicates an expected call of ListAllEventParticipants.
+func (mr *MockStoreMockRecorder) ListAllEventParticipants(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllEventParticipants", reflect.TypeOf((*MockStore)(nil).ListAllEventParticipants), ctx, eventID)
+}
+
+// ListCommentReplies mocks base method.
+func (m *MockStore) ListCommentReplies(ctx context.Context, parentIds []int32) ([]db.ListCommentRepliesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCommentReplies", ctx, parentIds)
+	ret0, _ := ret[0].([]db.ListCommentRepliesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCommentReplies indicates an expected call of ListCommentReplies.
+func (mr *MockStoreMockRecorder) ListCommentReplies(ctx, parentIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommentReplies", reflect.TypeOf((*MockStore)(nil).ListCommentReplies), ctx, parentIds)
+}
+
+// ListDueReminders mocks base method.
+func (m *MockStore) ListDueReminders(ctx context.Context, arg db.ListDueRemindersParams) ([]db.ListDueRemindersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueReminders", ctx, arg)
+	ret0, _ := ret[0].([]db.ListDueRemindersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueReminders indicates an expected call of ListDueReminders.
+func (mr *MockStoreMockRecorder) ListDueReminders(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueReminders", reflect.TypeOf((*MockStore)(nil).ListDueReminders), ctx, arg)
+}
+
+// ListEventClusters mocks base method.
+func (m *MockStore) ListEventClusters(ctx context.Context, arg db.ListEventClustersParams) ([]db.ListEventClustersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventClusters", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEventClustersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventClusters indicates an expected call of ListEventClusters.
+func (mr *MockStoreMockRecorder) ListEventClusters(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventClusters", reflect.TypeOf((*MockStore)(nil).ListEventClusters), ctx, arg)
+}
+
+// ListEventComments mocks base method.
+func (m *MockStore) ListEventComments(ctx context.Context, arg db.ListEventCommentsParams) ([]db.ListEventCommentsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventComments", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEventCommentsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventComments indicates an expected call of ListEventComments.
+func (mr *MockStoreMockRecorder) ListEventComments(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventComments", reflect.TypeOf((*MockStore)(nil).ListEventComments), ctx, arg)
+}
+
+// ListEventMarkers mocks base method.
+func (m *MockStore) ListEventMarkers(ctx context.Context, arg db.ListEventMarkersParams) ([]db.ListEventMarkersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventMarkers", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEventMarkersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventMarkers indicates an expected call of ListEventMarkers.
+func (mr *MockStoreMockRecorder) ListEventMarkers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventMarkers", reflect.TypeOf((*MockStore)(nil).ListEventMarkers), ctx, arg)
+}
+
+// ListEventParticipants mocks base method.
+func (m *MockStore) ListEventParticipants(ctx context.Context, arg db.ListEventParticipantsParams) ([]db.ListEventParticipantsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventParticipants", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEventParticipantsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventParticipants indicates an expected call of ListEventParticipants.
+func (mr *MockStoreMockRecorder) ListEventParticipants(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventParticipants", reflect.TypeOf((*MockStore)(nil).ListEventParticipants), ctx, arg)
+}
+
+// ListEventPromotions mocks base method.
+func (m *MockStore) ListEventPromotions(ctx context.Context, eventID int32) ([]db.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventPromotions", ctx, eventID)
+	ret0, _ := ret[0].([]db.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventPromotions indicates an expected call of ListEventPromotions.
+func (mr *MockStoreMockRecorder) ListEventPromotions(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventPromotions", reflect.TypeOf((*MockStore)(nil).ListEventPromotions), ctx, eventID)
+}
+
+// ListEventReviews mocks base method.
+func (m *MockStore) ListEventReviews(ctx context.Context, arg db.ListEventReviewsParams) ([]db.ListEventReviewsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventReviews", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEventReviewsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventReviews indicates an expected call of ListEventReviews.
+func (mr *MockStoreMockRecorder) ListEventReviews(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventReviews", reflect.TypeOf((*MockStore)(nil).ListEventReviews), ctx, arg)
+}
+
+// ListEvents mocks base method.
+func (m *MockStore) ListEvents(ctx context.Context, arg db.ListEventsParams) ([]db.ListEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.ListEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockStoreMockRecorder) ListEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockStore)(nil).ListEvents), ctx, arg)
+}
+
+// ListFollowedIDs mocks base method.
+func (m *MockStore) ListFollowedIDs(ctx context.Context, followerID int32) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFollowedIDs", ctx, followerID)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowedIDs indicates an expected call of ListFollowedIDs.
+func (mr *MockStoreMockRecorder) ListFollowedIDs(ctx, followerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowedIDs", reflect.TypeOf((*MockStore)(nil).ListFollowedIDs), ctx, followerID)
+}
+
+// ListFollowingSeriesEvents mocks base method.
+func (m *MockStore) ListFollowingSeriesEvents(ctx context.Context, id int32) ([]db.ListFollowingSeriesEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFollowingSeriesEvents", ctx, id)
+	ret0, _ := ret[0].([]db.ListFollowingSeriesEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowingSeriesEvents indicates an expected call of ListFollowingSeriesEvents.
+func (mr *MockStoreMockRecorder) ListFollowingSeriesEvents(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowingSeriesEvents", reflect.TypeOf((*MockStore)(nil).ListFollowingSeriesEvents), ctx, id)
+}
+
+// ListNotifications mocks base method.
+func (m *MockStore) ListNotifications(ctx context.Context, arg db.ListNotificationsParams) ([]db.ListNotificationsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotifications", ctx, arg)
+	ret0, _ := ret[0].([]db.ListNotificationsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockStoreMockRecorder) ListNotifications(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockStore)(nil).ListNotifications), ctx, arg)
+}
+
+// ListOrganizerRatings mocks base method.
+func (m *MockStore) ListOrganizerRatings(ctx context.Context, ownerIds []int32) ([]db.ListOrganizerRatingsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizerRatings", ctx, ownerIds)
+	ret0, _ := ret[0].([]db.ListOrganizerRatingsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizerRatings indicates an expected call of ListOrganizerRatings.
+func (mr *MockStoreMockRecorder) ListOrganizerRatings(ctx, ownerIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizerRatings", reflect.TypeOf((*MockStore)(nil).ListOrganizerRatings), ctx, ownerIds)
+}
+
+// ListProfileAttendedEvents mocks base method.
+func (m *MockStore) ListProfileAttendedEvents(ctx context.Context, arg db.ListProfileAttendedEventsParams) ([]db.ListProfileAttendedEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfileAttendedEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.ListProfileAttendedEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfileAttendedEvents indicates an expected call of ListProfileAttendedEvents.
+func (mr *MockStoreMockRecorder) ListProfileAttendedEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfileAttendedEvents", reflect.TypeOf((*MockStore)(nil).ListProfileAttendedEvents), ctx, arg)
+}
+
+// ListProfileUpcomingEvents mocks base method.
+func (m *MockStore) ListProfileUpcomingEvents(ctx context.Context, arg db.ListProfileUpcomingEventsParams) ([]db.ListProfileUpcomingEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfileUpcomingEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.ListProfileUpcomingEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProfileUpcomingEvents indicates an expected call of ListProfileUpcomingEvents.
+func (mr *MockStoreMockRecorder) ListProfileUpcomingEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfileUpcomingEvents", reflect.TypeOf((*MockStore)(nil).ListProfileUpcomingEvents), ctx, arg)
+}
+
+// ListPromotions mocks base method.
+func (m *MockStore) ListPromotions(ctx context.Context, arg db.ListPromotionsParams) ([]db.ListPromotionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPromotions", ctx, arg)
+	ret0, _ := ret[0].([]db.ListPromotionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPromotions indicates an expected call of ListPromotions.
+func (mr *MockStoreMockRecorder) ListPromotions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPromotions", reflect.TypeOf((*MockStore)(nil).ListPromotions), ctx, arg)
+}
+
+// ListReports mocks base method.
+func (m *MockStore) ListReports(ctx context.Context, arg db.ListReportsParams) ([]db.ListReportsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReports", ctx, arg)
+	ret0, _ := ret[0].([]db.ListReportsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockStoreMockRecorder) ListReports(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockStore)(nil).ListReports), ctx, arg)
+}
+
+// ListUserPushSubscriptions mocks base method.
+func (m *MockStore) ListUserPushSubscriptions(ctx context.Context, userID int32) ([]db.PushSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserPushSubscriptions", ctx, userID)
+	ret0, _ := ret[0].([]db.PushSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserPushSubscriptions indicates an expected call of ListUserPushSubscriptions.
+func (mr *MockStoreMockRecorder) ListUserPushSubscriptions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserPushSubscriptions", reflect.TypeOf((*MockStore)(nil).ListUserPushSubscriptions), ctx, userID)
+}
+
+// ListUserSessions mocks base method.
+func (m *MockStore) ListUserSessions(ctx context.Context, userID int32) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserSessions", ctx, userID)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserSessions indicates an expected call of ListUserSessions.
+func (mr *MockStoreMockRecorder) ListUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserSessions", reflect.TypeOf((*MockStore)(nil).ListUserSessions), ctx, userID)
+}
+
+// ListUsers mocks base method.
+func (m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, arg)
+	ret0, _ := ret[0].([]db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockStoreMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), ctx, arg)
+}
+
+// ListWebhookDeliveries mocks base method.
+func (m *MockStore) ListWebhookDeliveries(ctx context.Context, arg db.ListWebhookDeliveriesParams) ([]db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveries", ctx, arg)
+	ret0, _ := ret[0].([]db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveries indicates an expected call of ListWebhookDeliveries.
+func (mr *MockStoreMockRecorder) ListWebhookDeliveries(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockStore)(nil).ListWebhookDeliveries), ctx, arg)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockStore) ListWebhooks(ctx context.Context, ownerID int32) ([]db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx, ownerID)
+	ret0, _ := ret[0].([]db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockStoreMockRecorder) ListWebhooks(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockStore)(nil).ListWebhooks), ctx, ownerID)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockStore) MarkAllNotificationsRead(ctx context.Context, userID int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockStoreMockRecorder) MarkAllNotificationsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockStore)(nil).MarkAllNotificationsRead), ctx, userID)
+}
+
+// MarkNotificationRead mocks base method.
+func (m *MockStore) MarkNotificationRead(ctx context.Context, arg db.MarkNotificationReadParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationRead", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockStoreMockRecorder) MarkNotificationRead(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockStore)(nil).MarkNotificationRead), ctx, arg)
+}
+
+// MarkOutboxMessagePublished mocks base method.
+func (m *MockStore) MarkOutboxMessagePublished(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkOutboxMessagePublished", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkOutboxMessagePublished indicates an expected call of MarkOutboxMessagePublished.
+func (mr *MockStoreMockRecorder) MarkOutboxMessagePublished(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkOutboxMessagePublished", reflect.TypeOf((*MockStore)(nil).MarkOutboxMessagePublished), ctx, id)
+}
+
+// NotifyEventOwner mocks base method.
+func (m *MockStore) NotifyEventOwner(ctx context.Context, arg db.NotifyEventOwnerParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyEventOwner", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyEventOwner indicates an expected call of NotifyEventOwner.
+func (mr *MockStoreMockRecorder) NotifyEventOwner(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyEventOwner", reflect.TypeOf((*MockStore)(nil).NotifyEventOwner), ctx, arg)
+}
+
+// NotifyEventParticipants mocks base method.
+func (m *MockStore) NotifyEventParticipants(ctx context.Context, arg db.NotifyEventParticipantsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyEventParticipants", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyEventParticipants indicates an expected call of NotifyEventParticipants.
+func (mr *MockStoreMockRecorder) NotifyEventParticipants(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyEventParticipants", reflect.TypeOf((*MockStore)(nil).NotifyEventParticipants), ctx, arg)
+}
+
+// NotifyStartingEvents mocks base method.
+func (m *MockStore) NotifyStartingEvents(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyStartingEvents", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyStartingEvents indicates an expected call of NotifyStartingEvents.
+func (mr *MockStoreMockRecorder) NotifyStartingEvents(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyStartingEvents", reflect.TypeOf((*MockStore)(nil).NotifyStartingEvents), ctx)
+}
+
+// PopEventWaitlist mocks base method.
+func (m *MockStore) PopEventWaitlist(ctx context.Context, eventID int32) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopEventWaitlist", ctx, eventID)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopEventWaitlist indicates an expected call of PopEventWaitlist.
+func (mr *MockStoreMockRecorder) PopEventWaitlist(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopEventWaitlist", reflect.TypeOf((*MockStore)(nil).PopEventWaitlist), ctx, eventID)
+}
+
+// RecordOutboxMessageFailure mocks base method.
+func (m *MockStore) RecordOutboxMessageFailure(ctx context.Context, arg db.RecordOutboxMessageFailureParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOutboxMessageFailure", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOutboxMessageFailure indicates an expected call of RecordOutboxMessageFailure.
+func (mr *MockStoreMockRecorder) RecordOutboxMessageFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOutboxMessageFailure", reflect.TypeOf((*MockStore)(nil).RecordOutboxMessageFailure), ctx, arg)
+}
+
+// RecordReminderDeliveryFailure mocks base method.
+func (m *MockStore) RecordReminderDeliveryFailure(ctx context.Context, arg db.RecordReminderDeliveryFailureParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordReminderDeliveryFailure", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordReminderDeliveryFailure indicates an expected call of RecordReminderDeliveryFailure.
+func (mr *MockStoreMockRecorder) RecordReminderDeliveryFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordReminderDeliveryFailure", reflect.TypeOf((*MockStore)(nil).RecordReminderDeliveryFailure), ctx, arg)
+}
+
+// RecordWebhookDeliveryFailure mocks base method.
+func (m *MockStore) RecordWebhookDeliveryFailure(ctx context.Context, arg db.RecordWebhookDeliveryFailureParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookDeliveryFailure", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWebhookDeliveryFailure indicates an expected call of RecordWebhookDeliveryFailure.
+func (mr *MockStoreMockRecorder) RecordWebhookDeliveryFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookDeliveryFailure", reflect.TypeOf((*MockStore)(nil).RecordWebhookDeliveryFailure), ctx, arg)
+}
+
+// RecordWebhookDeliverySuccess mocks base method.
+func (m *MockStore) RecordWebhookDeliverySuccess(ctx context.Context, arg db.RecordWebhookDeliverySuccessParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookDeliverySuccess", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordWebhookDeliverySuccess indicates an expected call of RecordWebhookDeliverySuccess.
+func (mr *MockStoreMockRecorder) RecordWebhookDeliverySuccess(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookDeliverySuccess", reflect.TypeOf((*MockStore)(nil).RecordWebhookDeliverySuccess), ctx, arg)
+}
+
+// RecordWebhookFailure mocks base method.
+func (m *MockStore) RecordWebhookFailure(ctx context.Context, arg db.RecordWebhookFailureParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordWebhookFailure", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordWebhookFailure indicates an expected call of RecordWebhookFailure.
+func (mr *MockStoreMockRecorder) RecordWebhookFailure(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordWebhookFailure", reflect.TypeOf((*MockStore)(nil).RecordWebhookFailure), ctx, arg)
+}
+
+// RemoveEventParticipant mocks base method.
+func (m *MockStore) RemoveEventParticipant(ctx context.Context, arg db.RemoveEventParticipantParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEventParticipant", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEventParticipant indicates an expected call of RemoveEventParticipant.
+func (mr *MockStoreMockRecorder) RemoveEventParticipant(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEventParticipant", reflect.TypeOf((*MockStore)(nil).RemoveEventParticipant), ctx, arg)
+}
+
+// RemoveEventParticipantTx mocks base method.
+func (m *MockStore) RemoveEventParticipantTx(ctx context.Context, arg db.RemoveEventParticipantTxParams) (db.RemoveEventParticipantTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEventParticipantTx", ctx, arg)
+	ret0, _ := ret[0].(db.RemoveEventParticipantTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEventParticipantTx indicates an expected call of RemoveEventParticipantTx.
+func (mr *MockStoreMockRecorder) RemoveEventParticipantTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEventParticipantTx", reflect.TypeOf((*MockStore)(nil).RemoveEventParticipantTx), ctx, arg)
+}
+
+// ReplayWebhookDelivery mocks base method.
+func (m *MockStore) ReplayWebhookDelivery(ctx context.Context, arg db.ReplayWebhookDeliveryParams) (db.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayWebhookDelivery", ctx, arg)
+	ret0, _ := ret[0].(db.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayWebhookDelivery indicates an expected call of ReplayWebhookDelivery.
+func (mr *MockStoreMockRecorder) ReplayWebhookDelivery(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayWebhookDelivery", reflect.TypeOf((*MockStore)(nil).ReplayWebhookDelivery), ctx, arg)
+}
+
+// ResetPasswordTx mocks base method.
+func (m *MockStore) ResetPasswordTx(ctx context.Context, params db.ResetPasswordTxParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordTx", ctx, params)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordTx indicates an expected call of ResetPasswordTx.
+func (mr *MockStoreMockRecorder) ResetPasswordTx(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordTx", reflect.TypeOf((*MockStore)(nil).ResetPasswordTx), ctx, params)
+}
+
+// ResetWebhookFailures mocks base method.
+func (m *MockStore) ResetWebhookFailures(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetWebhookFailures", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetWebhookFailures indicates an expected call of ResetWebhookFailures.
+func (mr *MockStoreMockRecorder) ResetWebhookFailures(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetWebhookFailures", reflect.TypeOf((*MockStore)(nil).ResetWebhookFailures), ctx, id)
+}
+
+// ReviewPromotion mocks base method.
+func (m *MockStore) ReviewPromotion(ctx context.Context, arg db.ReviewPromotionParams) (db.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewPromotion", ctx, arg)
+	ret0, _ := ret[0].(db.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewPromotion indicates an expected call of ReviewPromotion.
+func (mr *MockStoreMockRecorder) ReviewPromotion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewPromotion", reflect.TypeOf((*MockStore)(nil).ReviewPromotion), ctx, arg)
+}
+
+// ReviewReportTx mocks base method.
+func (m *MockStore) ReviewReportTx(ctx context.Context, params db.ReviewReportTxParams) ([]db.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewReportTx", ctx, params)
+	ret0, _ := ret[0].([]db.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewReportTx indicates an expected call of ReviewReportTx.
+func (mr *MockStoreMockRecorder) ReviewReportTx(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewReportTx", reflect.TypeOf((*MockStore)(nil).ReviewReportTx), ctx, params)
+}
+
+// ReviewReports mocks base method.
+func (m *MockStore) ReviewReports(ctx context.Context, arg db.ReviewReportsParams) ([]db.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewReports", ctx, arg)
+	ret0, _ := ret[0].([]db.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewReports indicates an expected call of ReviewReports.
+func (mr *MockStoreMockRecorder) ReviewReports(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewReports", reflect.TypeOf((*MockStore)(nil).ReviewReports), ctx, arg)
+}
+
+// RotateSession mocks base method.
+func (m *MockStore) RotateSession(ctx context.Context, argUuid uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSession", ctx, argUuid)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSession indicates an expected call of RotateSession.
+func (mr *MockStoreMockRecorder) RotateSession(ctx, argUuid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSession", reflect.TypeOf((*MockStore)(nil).RotateSession), ctx, argUuid)
+}
+
+// RotateSessionTx mocks base method.
+func (m *MockStore) RotateSessionTx(ctx context.Context, params db.RotateSessionTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSessionTx", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(ctx, arg any) *gomock.Call {
+// RotateSessionTx indicates an expected call of RotateSessionTx.
+func (mr *MockStoreMockRecorder) RotateSessionTx(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSessionTx", reflect.TypeOf((*MockStore)(nil).RotateSessionTx), ctx, params)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error) {
+// SetCommentPinned mocks base method.
+func (m *MockStore) SetCommentPinned(ctx context.Context, arg db.SetCommentPinnedParams) (db.Comment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, arg)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "SetCommentPinned", ctx, arg)
+	ret0, _ := ret[0].(db.Comment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(ctx, arg any) *gomock.Call {
+// SetCommentPinned indicates an expected call of SetCommentPinned.
+func (mr *MockStoreMockRecorder) SetCommentPinned(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommentPinned", reflect.TypeOf((*MockStore)(nil).SetCommentPinned), ctx, arg)
 }
 
-// DeleteUser mocks base method.
-func (m *MockStore) DeleteUser(ctx context.Context, id int32) error {
+// SetEventHidden mocks base method.
+func (m *MockStore) SetEventHidden(ctx context.Context, arg db.SetEventHiddenParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUser", ctx, id)
+	ret := m.ctrl.Call(m, "SetEventHidden", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUser indicates an expected call of DeleteUser.
-func (mr *MockStoreMockRecorder) DeleteUser(ctx, id any) *gomock.Call {
+// SetEventHidden indicates an expected call of SetEventHidden.
+func (mr *MockStoreMockRecorder) SetEventHidden(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockStore)(nil).DeleteUser), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventHidden", reflect.TypeOf((*MockStore)(nil).SetEventHidden), ctx, arg)
 }
 
-// DeleteUserTag mocks base method.
-func (m *MockStore) DeleteUserTag(ctx context.Context, arg db.DeleteUserTagParams) error {
+// SetReminderDeliveryStatus mocks base method.
+func (m *MockStore) SetReminderDeliveryStatus(ctx context.Context, arg db.SetReminderDeliveryStatusParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteUserTag", ctx, arg)
+	ret := m.ctrl.Call(m, "SetReminderDeliveryStatus", ctx, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteUserTag indicates an expected call of DeleteUserTag.
-func (mr *MockStoreMockRecorder) DeleteUserTag(ctx, arg any) *gomock.Call {
+// SetReminderDeliveryStatus indicates an expected call of SetReminderDeliveryStatus.
+func (mr *MockStoreMockRecorder) SetReminderDeliveryStatus(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserTag", reflect.TypeOf((*MockStore)(nil).DeleteUserTag), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReminderDeliveryStatus", reflect.TypeOf((*MockStore)(nil).SetReminderDeliveryStatus), ctx, arg)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(ctx context.Context, argUuid uuid.UUID) (db.Session, error) {
+// SetUserBlocked mocks base method.
+func (m *MockStore) SetUserBlocked(ctx context.Context, arg db.SetUserBlockedParams) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, argUuid)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "SetUserBlocked", ctx, arg)
+	ret0, _ := ret[0].(db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(ctx, argUuid any) *gomock.Call {
+// SetUserBlocked indicates an expected call of SetUserBlocked.
+func (mr *MockStoreMockRecorder) SetUserBlocked(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), ctx, argUuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserBlocked", reflect.TypeOf((*MockStore)(nil).SetUserBlocked), ctx, arg)
 }
 
-// GetTags mocks base method.
-func (m *MockStore) GetTags(ctx context.Context) ([]db.Tag, error) {
+// SetUserBlockedTx mocks base method.
+func (m *MockStore) SetUserBlockedTx(ctx context.Context, params db.SetUserBlockedTxParams) (db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTags", ctx)
+	ret := m.ctrl.Call(m, "SetUserBlockedTx", ctx, params)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserBlockedTx indicates an expected call of SetUserBlockedTx.
+func (mr *MockStoreMockRecorder) SetUserBlockedTx(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserBlockedTx", reflect.TypeOf((*MockStore)(nil).SetUserBlockedTx), ctx, params)
+}
+
+// SoftDeleteComment mocks base method.
+func (m *MockStore) SoftDeleteComment(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteComment indicates an expected call of SoftDeleteComment.
+func (mr *MockStoreMockRecorder) SoftDeleteComment(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteComment", reflect.TypeOf((*MockStore)(nil).SoftDeleteComment), ctx, id)
+}
+
+// SubscribeToEvent mocks base method.
+func (m *MockStore) SubscribeToEvent(ctx context.Context, arg db.SubscribeToEventParams) (pgtype.Bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToEvent", ctx, arg)
+	ret0, _ := ret[0].(pgtype.Bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToEvent indicates an expected call of SubscribeToEvent.
+func (mr *MockStoreMockRecorder) SubscribeToEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToEvent", reflect.TypeOf((*MockStore)(nil).SubscribeToEvent), ctx, arg)
+}
+
+// SubscribeToEventTx mocks base method.
+func (m *MockStore) SubscribeToEventTx(ctx context.Context, arg db.SubscribeToEventParams) (pgtype.Bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToEventTx", ctx, arg)
+	ret0, _ := ret[0].(pgtype.Bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToEventTx indicates an expected call of SubscribeToEventTx.
+func (mr *MockStoreMockRecorder) SubscribeToEventTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToEventTx", reflect.TypeOf((*MockStore)(nil).SubscribeToEventTx), ctx, arg)
+}
+
+// SuggestEvents mocks base method.
+func (m *MockStore) SuggestEvents(ctx context.Context, arg db.SuggestEventsParams) ([]db.SuggestEventsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestEvents", ctx, arg)
+	ret0, _ := ret[0].([]db.SuggestEventsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestEvents indicates an expected call of SuggestEvents.
+func (mr *MockStoreMockRecorder) SuggestEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestEvents", reflect.TypeOf((*MockStore)(nil).SuggestEvents), ctx, arg)
+}
+
+// SuggestOrganizers mocks base method.
+func (m *MockStore) SuggestOrganizers(ctx context.Context, arg db.SuggestOrganizersParams) ([]db.SuggestOrganizersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestOrganizers", ctx, arg)
+	ret0, _ := ret[0].([]db.SuggestOrganizersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestOrganizers indicates an expected call of SuggestOrganizers.
+func (mr *MockStoreMockRecorder) SuggestOrganizers(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestOrganizers", reflect.TypeOf((*MockStore)(nil).SuggestOrganizers), ctx, arg)
+}
+
+// SuggestTags mocks base method.
+func (m *MockStore) SuggestTags(ctx context.Context, arg db.SuggestTagsParams) ([]db.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTags", ctx, arg)
 	ret0, _ := ret[0].([]db.Tag)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTags indicates an expected call of GetTags.
-func (mr *MockStoreMockRecorder) GetTags(ctx any) *gomock.Call {
+// SuggestTags indicates an expected call of SuggestTags.
+func (mr *MockStoreMockRecorder) SuggestTags(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockStore)(nil).GetTags), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTags", reflect.TypeOf((*MockStore)(nil).SuggestTags), ctx, arg)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(ctx context.Context, id int32) (db.User, error) {
+// SyncEventsPremium mocks base method.
+func (m *MockStore) SyncEventsPremium(ctx context.Context, eventIds []int32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", ctx, id)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "SyncEventsPremium", ctx, eventIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncEventsPremium indicates an expected call of SyncEventsPremium.
+func (mr *MockStoreMockRecorder) SyncEventsPremium(ctx, eventIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncEventsPremium", reflect.TypeOf((*MockStore)(nil).SyncEventsPremium), ctx, eventIds)
+}
+
+// SyncPromotionsTx mocks base method.
+func (m *MockStore) SyncPromotionsTx(ctx context.Context) (db.SyncPromotionsTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncPromotionsTx", ctx)
+	ret0, _ := ret[0].(db.SyncPromotionsTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(ctx, id any) *gomock.Call {
+// SyncPromotionsTx indicates an expected call of SyncPromotionsTx.
+func (mr *MockStoreMockRecorder) SyncPromotionsTx(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPromotionsTx", reflect.TypeOf((*MockStore)(nil).SyncPromotionsTx), ctx)
 }
 
-// GetUserByEmail mocks base method.
-func (m *MockStore) GetUserByEmail(ctx context.Context, email string) (db.User, error) {
+// UnbanEventUser mocks base method.
+func (m *MockStore) UnbanEventUser(ctx context.Context, arg db.UnbanEventUserParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "UnbanEventUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockStoreMockRecorder) GetUserByEmail(ctx, email any) *gomock.Call {
+// UnbanEventUser indicates an expected call of UnbanEventUser.
+func (mr *MockStoreMockRecorder) UnbanEventUser(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockStore)(nil).GetUserByEmail), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanEventUser", reflect.TypeOf((*MockStore)(nil).UnbanEventUser), ctx, arg)
 }
 
-// GetUserWithTags mocks base method.
-func (m *MockStore) GetUserWithTags(ctx context.Context, id int32) (db.GetUserWithTagsRow, error) {
+// UnfollowUser mocks base method.
+func (m *MockStore) UnfollowUser(ctx context.Context, arg db.UnfollowUserParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserWithTags", ctx, id)
-	ret0, _ := ret[0].(db.GetUserWithTagsRow)
+	ret := m.ctrl.Call(m, "UnfollowUser", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserWithTags indicates an expected call of GetUserWithTags.
-func (mr *MockStoreMockRecorder) GetUserWithTags(ctx, id any) *gomock.Call {
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockStoreMockRecorder) UnfollowUser(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWithTags", reflect.TypeOf((*MockStore)(nil).GetUserWithTags), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockStore)(nil).UnfollowUser), ctx, arg)
 }
 
-// ListUsers mocks base method.
-func (m *MockStore) ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error) {
+// UnsubscribeFromEvent mocks base method.
+func (m *MockStore) UnsubscribeFromEvent(ctx context.Context, arg db.UnsubscribeFromEventParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUsers", ctx, arg)
-	ret0, _ := ret[0].([]db.User)
+	ret := m.ctrl.Call(m, "UnsubscribeFromEvent", ctx, arg)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockStoreMockRecorder) ListUsers(ctx, arg any) *gomock.Call {
+// UnsubscribeFromEvent indicates an expected call of UnsubscribeFromEvent.
+func (mr *MockStoreMockRecorder) UnsubscribeFromEvent(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockStore)(nil).ListUsers), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromEvent", reflect.TypeOf((*MockStore)(nil).UnsubscribeFromEvent), ctx, arg)
+}
+
+// UnsubscribeFromEventTx mocks base method.
+func (m *MockStore) UnsubscribeFromEventTx(ctx context.Context, arg db.UnsubscribeFromEventParams) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeFromEventTx", ctx, arg)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsubscribeFromEventTx indicates an expected call of UnsubscribeFromEventTx.
+func (mr *MockStoreMockRecorder) UnsubscribeFromEventTx(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromEventTx", reflect.TypeOf((*MockStore)(nil).UnsubscribeFromEventTx), ctx, arg)
+}
+
+// UpdateCommentBody mocks base method.
+func (m *MockStore) UpdateCommentBody(ctx context.Context, arg db.UpdateCommentBodyParams) (db.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommentBody", ctx, arg)
+	ret0, _ := ret[0].(db.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCommentBody indicates an expected call of UpdateCommentBody.
+func (mr *MockStoreMockRecorder) UpdateCommentBody(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentBody", reflect.TypeOf((*MockStore)(nil).UpdateCommentBody), ctx, arg)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockStore) UpdateEvent(ctx context.Context, arg db.UpdateEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockStoreMockRecorder) UpdateEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockStore)(nil).UpdateEvent), ctx, arg)
+}
+
+// UpdateEventPremium mocks base method.
+func (m *MockStore) UpdateEventPremium(ctx context.Context, arg db.UpdateEventPremiumParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEventPremium", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateSession mocks base method.
-func (m *MockStore) UpdateSession(ctx context.Context, arg db.UpdateSessionParams) error {
+// UpdateEventPremium indicates an expected call of UpdateEventPremium.
+func (mr *MockStoreMockRecorder) UpdateEventPremium(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventPremium", reflect.TypeOf((*MockStore)(nil).UpdateEventPremium), ctx, arg)
+}
+
+// UpdateEventTx mocks base method.
+func (m *MockStore) UpdateEventTx(ctx context.Context, params db.UpdateEventTxParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSession", ctx, arg)
+	ret := m.ctrl.Call(m, "UpdateEventTx", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateSession indicates an expected call of UpdateSession.
-func (mr *MockStoreMockRecorder) UpdateSession(ctx, arg any) *gomock.Call {
+// UpdateEventTx indicates an expected call of UpdateEventTx.
+func (mr *MockStoreMockRecorder) UpdateEventTx(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockStore)(nil).UpdateSession), ctx, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventTx", reflect.TypeOf((*MockStore)(nil).UpdateEventTx), ctx, params)
 }
 
 // UpdateUser mocks base method.
@@ -232,3 +2361,164 @@ func (mr *MockStoreMockRecorder) UpdateUser(ctx, arg any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockStore)(nil).UpdateUser), ctx, arg)
 }
+
+// UpdateUserPassword mocks base method.
+func (m *MockStore) UpdateUserPassword(ctx context.Context, arg db.UpdateUserPasswordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserPassword", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserPassword indicates an expected call of UpdateUserPassword.
+func (mr *MockStoreMockRecorder) UpdateUserPassword(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserPassword", reflect.TypeOf((*MockStore)(nil).UpdateUserPassword), ctx, arg)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(ctx context.Context, arg db.UpdateUserRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, arg)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), ctx, arg)
+}
+
+// UpdateUserTagsTx mocks base method.
+func (m *MockStore) UpdateUserTagsTx(ctx context.Context, params db.UpdateUserTagsTxParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTagsTx", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserTagsTx indicates an expected call of UpdateUserTagsTx.
+func (mr *MockStoreMockRecorder) UpdateUserTagsTx(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTagsTx", reflect.TypeOf((*MockStore)(nil).UpdateUserTagsTx), ctx, params)
+}
+
+// UpdateUserTx mocks base method.
+func (m *MockStore) UpdateUserTx(ctx context.Context, params db.UpdateUserTxParams) (db.UserWithTagsView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTx", ctx, params)
+	ret0, _ := ret[0].(db.UserWithTagsView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTx indicates an expected call of UpdateUserTx.
+func (mr *MockStoreMockRecorder) UpdateUserTx(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTx", reflect.TypeOf((*MockStore)(nil).UpdateUserTx), ctx, params)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockStore) UpdateWebhook(ctx context.Context, arg db.UpdateWebhookParams) (db.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, arg)
+	ret0, _ := ret[0].(db.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockStoreMockRecorder) UpdateWebhook(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockStore)(nil).UpdateWebhook), ctx, arg)
+}
+
+// UpsertCalendarFeed mocks base method.
+func (m *MockStore) UpsertCalendarFeed(ctx context.Context, arg db.UpsertCalendarFeedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertCalendarFeed", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertCalendarFeed indicates an expected call of UpsertCalendarFeed.
+func (mr *MockStoreMockRecorder) UpsertCalendarFeed(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertCalendarFeed", reflect.TypeOf((*MockStore)(nil).UpsertCalendarFeed), ctx, arg)
+}
+
+// UpsertPrivacySettings mocks base method.
+func (m *MockStore) UpsertPrivacySettings(ctx context.Context, arg db.UpsertPrivacySettingsParams) (db.UserPrivacy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPrivacySettings", ctx, arg)
+	ret0, _ := ret[0].(db.UserPrivacy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertPrivacySettings indicates an expected call of UpsertPrivacySettings.
+func (mr *MockStoreMockRecorder) UpsertPrivacySettings(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPrivacySettings", reflect.TypeOf((*MockStore)(nil).UpsertPrivacySettings), ctx, arg)
+}
+
+// UpsertPushSubscription mocks base method.
+func (m *MockStore) UpsertPushSubscription(ctx context.Context, arg db.UpsertPushSubscriptionParams) (db.PushSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPushSubscription", ctx, arg)
+	ret0, _ := ret[0].(db.PushSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertPushSubscription indicates an expected call of UpsertPushSubscription.
+func (mr *MockStoreMockRecorder) UpsertPushSubscription(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPushSubscription", reflect.TypeOf((*MockStore)(nil).UpsertPushSubscription), ctx, arg)
+}
+
+// UpsertReminderPreferences mocks base method.
+func (m *MockStore) UpsertReminderPreferences(ctx context.Context, arg db.UpsertReminderPreferencesParams) (db.ReminderPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReminderPreferences", ctx, arg)
+	ret0, _ := ret[0].(db.ReminderPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertReminderPreferences indicates an expected call of UpsertReminderPreferences.
+func (mr *MockStoreMockRecorder) UpsertReminderPreferences(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReminderPreferences", reflect.TypeOf((*MockStore)(nil).UpsertReminderPreferences), ctx, arg)
+}
+
+// UpsertReview mocks base method.
+func (m *MockStore) UpsertReview(ctx context.Context, arg db.UpsertReviewParams) (db.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReview", ctx, arg)
+	ret0, _ := ret[0].(db.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertReview indicates an expected call of UpsertReview.
+func (mr *MockStoreMockRecorder) UpsertReview(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReview", reflect.TypeOf((*MockStore)(nil).UpsertReview), ctx, arg)
+}
+
+// VerifyUserEmail mocks base method.
+func (m *MockStore) VerifyUserEmail(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyUserEmail", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyUserEmail indicates an expected call of VerifyUserEmail.
+func (mr *MockStoreMockRecorder) VerifyUserEmail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyUserEmail", reflect.TypeOf((*MockStore)(nil).VerifyUserEmail), ctx, id)
+}
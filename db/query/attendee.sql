@@ -0,0 +1,55 @@
+-- name: ListEventParticipants :many
+SELECT
+    u.id,
+    u.username,
+    i.path AS user_image_path,
+    eu.joined_at
+FROM event_user eu
+         JOIN users u ON u.id = eu.user_id
+         LEFT JOIN images i ON i.id = u.image_id
+WHERE eu.event_id = @event_id
+  AND (
+    NOT @has_cursor::boolean
+        OR (eu.joined_at, u.id) > (@cursor_joined_at::timestamptz, @cursor_id::int)
+    )
+ORDER BY eu.joined_at, u.id
+LIMIT @page_limit::int;
+
+-- name: ListAllEventParticipants :many
+SELECT
+    u.id,
+    u.username,
+    i.path AS user_image_path,
+    eu.joined_at
+FROM event_user eu
+         JOIN users u ON u.id = eu.user_id
+         LEFT JOIN images i ON i.id = u.image_id
+WHERE eu.event_id = @event_id
+ORDER BY eu.joined_at, u.id;
+
+-- name: RemoveEventParticipant :execrows
+DELETE FROM event_user
+WHERE event_id = @event_id AND user_id = @user_id;
+
+-- name: BanEventUser :exec
+INSERT INTO event_bans (event_id, user_id)
+VALUES (@event_id, @user_id)
+ON CONFLICT (event_id, user_id) DO NOTHING;
+
+-- name: UnbanEventUser :execrows
+DELETE FROM event_bans
+WHERE event_id = @event_id AND user_id = @user_id;
+
+-- name: IsBannedFromEvent :one
+SELECT EXISTS (
+    SELECT 1
+    FROM event_bans
+    WHERE event_id = @event_id
+      AND user_id = @user_id
+) AS is_banned;
+
+-- name: GetParticipantJoinedAt :one
+SELECT joined_at
+FROM event_user
+WHERE event_id = @event_id
+  AND user_id = @user_id;
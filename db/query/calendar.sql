@@ -0,0 +1,19 @@
+-- name: UpsertCalendarFeed :exec
+INSERT INTO calendar_feeds (
+    user_id,
+    token_hash
+) VALUES (
+             @user_id,
+             @token_hash
+         )
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    created_at = NOW();
+
+-- name: GetCalendarFeedUserID :one
+SELECT user_id FROM calendar_feeds
+WHERE token_hash = $1;
+
+-- name: DeleteCalendarFeed :exec
+DELETE FROM calendar_feeds
+WHERE user_id = $1;
@@ -0,0 +1,91 @@
+-- name: CreateComment :one
+INSERT INTO comments (
+                      event_id,
+                      user_id,
+                      parent_id,
+                      body
+) VALUES (
+          $1, $2, $3, $4
+         ) RETURNING *;
+
+-- name: GetComment :one
+SELECT * FROM comments
+WHERE id = $1;
+
+-- name: UpdateCommentBody :one
+UPDATE comments
+SET body = $2,
+    edited_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteComment :exec
+UPDATE comments
+SET body = '',
+    is_pinned = false,
+    deleted_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: SetCommentPinned :one
+UPDATE comments
+SET is_pinned = $2
+WHERE id = $1
+  AND parent_id IS NULL
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListEventComments :many
+SELECT
+    c.id,
+    c.event_id,
+    c.parent_id,
+    c.user_id,
+    u.username,
+    i.path AS user_image_path,
+    c.body,
+    c.is_pinned,
+    c.edited_at,
+    c.deleted_at,
+    c.created_at
+FROM comments c
+         JOIN users u ON u.id = c.user_id
+         LEFT JOIN images i ON i.id = u.image_id
+WHERE c.event_id = @event_id
+  AND c.parent_id IS NULL
+  AND (
+    c.deleted_at IS NULL
+        OR EXISTS (
+        SELECT 1
+        FROM comments r
+        WHERE r.parent_id = c.id
+          AND r.deleted_at IS NULL
+    )
+    )
+  AND (
+    NOT @has_cursor::boolean
+        OR (c.is_pinned, c.created_at, c.id) < (@cursor_pinned::boolean, @cursor_created_at::timestamptz, @cursor_id::int)
+    )
+ORDER BY c.is_pinned DESC, c.created_at DESC, c.id DESC
+LIMIT @page_limit::int;
+
+-- name: ListCommentReplies :many
+SELECT
+    c.id,
+    c.event_id,
+    c.parent_id,
+    c.user_id,
+    u.username,
+    i.path AS user_image_path,
+    c.body,
+    c.is_pinned,
+    c.edited_at,
+    c.deleted_at,
+    c.created_at
+FROM comments c
+         JOIN users u ON u.id = c.user_id
+         LEFT JOIN images i ON i.id = u.image_id
+WHERE c.parent_id = ANY(@parent_ids::int[])
+  AND c.deleted_at IS NULL
+ORDER BY c.created_at, c.id;
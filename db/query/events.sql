@@ -9,7 +9,8 @@ INSERT INTO events (
     date,
     owner_id,
     is_private,
-    image_id
+    image_id,
+    series_id
 ) VALUES (
              @name,
              @description,
@@ -20,10 +21,11 @@ INSERT INTO events (
              @date,
              @owner_id,
              @is_private,
-             @image_id
+             @image_id,
+             @series_id
          )
     RETURNING id, name, description, capacity, latitude, longitude,
-    address, date, owner_id, is_private, is_premium, created_at, image_id;
+    address, date, owner_id, is_private, is_premium, created_at, image_id, series_id;
 
 -- name: GetEvent :one
 SELECT
@@ -45,6 +47,7 @@ SELECT
     e.participants_count,
     e.event_image_path,
     e.user_image_path,
+    e.series_id,
     CASE
         WHEN $2 = e.owner_id THEN true
         WHEN NOT e.is_private THEN true
@@ -73,50 +76,238 @@ WHERE e.id = $1
     );
 
 -- name: ListEvents :many
+WITH ranked AS (
+    SELECT
+        evt.id,
+        evt.name,
+        evt.description,
+        evt.capacity,
+        evt.latitude,
+        evt.longitude,
+        evt.address,
+        evt.date,
+        evt.owner_id,
+        evt.owner_username,
+        evt.is_private,
+        evt.is_premium,
+        evt.created_at,
+        evt.tags,
+        evt.participants_count,
+        evt.event_image_path,
+        evt.user_image_path,
+        (
+            SELECT COUNT(*)
+            FROM event_tags et
+            WHERE
+                et.event_id = evt.id
+              AND et.tag_id = ANY(@tag_ids::int[])
+        ) AS matched_tags,
+        ST_Distance(
+                evt.geom,
+                ST_MakePoint(@user_lon::numeric, @user_lat::numeric)::GEOGRAPHY
+        )::float8 AS distance,
+        (CASE WHEN @search_term::text <> '' THEN
+                  ts_rank(evt.search_vector, websearch_to_tsquery('russian', @search_term)) +
+                  SIMILARITY(evt.name, @search_term) +
+                  SIMILARITY(evt.description, @search_term)
+              ELSE 0 END)::float8 AS relevance
+    FROM event_with_tags_view evt
+    WHERE
+        ST_DWithin(
+                evt.geom,
+                ST_MakePoint(@user_lon::numeric, @user_lat::numeric)::GEOGRAPHY,
+                @radius::float8
+        )
+      AND evt.is_private = false
+      AND evt.is_hidden = false
+      AND evt.date > NOW()
+      AND (
+        evt.series_id IS NULL
+            OR evt.id = (
+            SELECT s.id
+            FROM events s
+            WHERE s.series_id = evt.series_id
+              AND s.date > NOW()
+            ORDER BY s.date, s.id
+            LIMIT 1
+        )
+        )
+      AND (
+        @search_term::text = ''
+            OR evt.search_vector @@ websearch_to_tsquery('russian', @search_term)
+            OR @search_term <% evt.name
+            OR @search_term <% evt.description
+        )
+      AND (
+        cardinality(@tag_ids::int[]) = 0
+            OR EXISTS (
+            SELECT 1
+            FROM event_tags et
+            WHERE
+                et.event_id = evt.id
+              AND et.tag_id = ANY(@tag_ids::int[])
+        )
+        )
+      AND (
+        @date_range::text IS NULL
+            OR @date_range::text = ''
+            OR CASE
+                WHEN @date_range = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
+                WHEN @date_range = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
+                WHEN @date_range = 'month' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 month'
+                ELSE TRUE
+            END
+        )
+)
+SELECT
+    id,
+    name,
+    description,
+    capacity,
+    latitude,
+    longitude,
+    address,
+    date,
+    owner_id,
+    owner_username,
+    is_private,
+    is_premium,
+    created_at,
+    tags,
+    participants_count,
+    event_image_path,
+    user_image_path,
+    matched_tags,
+    distance,
+    relevance,
+    (CASE WHEN @search_term <> '' THEN
+              ts_headline('russian', name, websearch_to_tsquery('russian', @search_term),
+                          'HighlightAll=true, StartSel=' || chr(2) || ', StopSel=' || chr(3))
+          ELSE '' END)::text AS name_highlight,
+    (CASE WHEN @search_term <> '' THEN
+              ts_headline('russian', description, websearch_to_tsquery('russian', @search_term),
+                          'MaxWords=30, MinWords=10, MaxFragments=2, StartSel=' || chr(2) || ', StopSel=' || chr(3))
+          ELSE '' END)::text AS snippet
+FROM ranked
+WHERE
+    NOT @has_cursor::boolean
+   OR relevance < @cursor_relevance::float8
+   OR (relevance = @cursor_relevance::float8
+    AND matched_tags < @cursor_matched_tags::bigint)
+   OR (relevance = @cursor_relevance::float8
+    AND matched_tags = @cursor_matched_tags::bigint
+    AND created_at < @cursor_created_at::timestamptz)
+   OR (relevance = @cursor_relevance::float8
+    AND matched_tags = @cursor_matched_tags::bigint
+    AND created_at = @cursor_created_at::timestamptz
+    AND distance > @cursor_distance::float8)
+   OR (relevance = @cursor_relevance::float8
+    AND matched_tags = @cursor_matched_tags::bigint
+    AND created_at = @cursor_created_at::timestamptz
+    AND distance = @cursor_distance::float8
+    AND id > @cursor_id::int)
+ORDER BY
+    relevance DESC,
+    matched_tags DESC,
+    created_at DESC,
+    distance ASC,
+    id ASC
+LIMIT @page_limit::int;
+
+-- name: ListEventClusters :many
+WITH filtered AS (
+    SELECT
+        evt.id,
+        evt.date,
+        evt.geom::geometry AS point
+    FROM event_with_tags_view evt
+    WHERE
+        evt.geom && ST_MakeEnvelope(
+                @min_lon::float8,
+                @min_lat::float8,
+                @max_lon::float8,
+                @max_lat::float8,
+                4326
+        )::GEOGRAPHY
+      AND evt.is_private = false
+      AND evt.is_hidden = false
+      AND evt.date > NOW()
+      AND (
+        evt.series_id IS NULL
+            OR evt.id = (
+            SELECT s.id
+            FROM events s
+            WHERE s.series_id = evt.series_id
+              AND s.date > NOW()
+            ORDER BY s.date, s.id
+            LIMIT 1
+        )
+        )
+      AND (
+        @search_term::text = ''
+            OR evt.search_vector @@ websearch_to_tsquery('russian', @search_term)
+            OR @search_term <% evt.name
+            OR @search_term <% evt.description
+        )
+      AND (
+        cardinality(@tag_ids::int[]) = 0
+            OR EXISTS (
+            SELECT 1
+            FROM event_tags et
+            WHERE
+                et.event_id = evt.id
+              AND et.tag_id = ANY(@tag_ids::int[])
+        )
+        )
+      AND (
+        @date_range::text = ''
+            OR CASE
+                WHEN @date_range = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
+                WHEN @date_range = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
+                WHEN @date_range = 'month' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 month'
+                ELSE TRUE
+            END
+        )
+)
+SELECT
+    COUNT(*) AS count,
+    ST_Y(ST_Centroid(ST_Collect(point)))::float8 AS latitude,
+    ST_X(ST_Centroid(ST_Collect(point)))::float8 AS longitude,
+    (ARRAY_AGG(id ORDER BY date, id))[1:@sample_size::int]::int[] AS event_ids
+FROM filtered
+GROUP BY ST_SnapToGrid(point, @cell_size::float8)
+ORDER BY count DESC;
+
+-- name: ListEventMarkers :many
 SELECT
     evt.id,
     evt.name,
-    evt.description,
-    evt.capacity,
     evt.latitude,
     evt.longitude,
-    evt.address,
     evt.date,
-    evt.owner_id,
-    evt.owner_username,
-    evt.is_private,
-    evt.is_premium,
-    evt.created_at,
-    evt.tags,
-    evt.participants_count,
-    evt.event_image_path,
-    evt.user_image_path,
-    (
-        SELECT COUNT(*)
-        FROM event_tags et
-        WHERE
-            et.event_id = evt.id
-          AND et.tag_id = ANY(@tag_ids::int[])
-    ) AS matched_tags,
-    ST_Distance(
-            evt.geom,
-            ST_MakePoint(@user_lon::numeric, @user_lat::numeric)::GEOGRAPHY
-    ) AS distance
+    evt.is_premium
 FROM event_with_tags_view evt
 WHERE
-    ST_DWithin(
-            evt.geom,
-            ST_MakePoint(@user_lon::numeric, @user_lat::numeric)::GEOGRAPHY,
-            100000
-    )
+    evt.geom && ST_MakeEnvelope(
+            @min_lon::float8,
+            @min_lat::float8,
+            @max_lon::float8,
+            @max_lat::float8,
+            4326
+    )::GEOGRAPHY
   AND evt.is_private = false
+  AND evt.is_hidden = false
   AND evt.date > NOW()
   AND (
-    @search_term::text = ''
-        OR (
-            evt.name ILIKE '%' || @search_term || '%'
-            OR evt.description ILIKE '%' || @search_term || '%'
-        )
+    evt.series_id IS NULL
+        OR evt.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = evt.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
     )
   AND (
     cardinality(@tag_ids::int[]) = 0
@@ -129,8 +320,7 @@ WHERE
     )
     )
   AND (
-    @date_range::text IS NULL
-        OR @date_range::text = ''
+    @date_range::text = ''
         OR CASE
             WHEN @date_range = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
             WHEN @date_range = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
@@ -139,13 +329,10 @@ WHERE
         END
     )
 ORDER BY
-    CASE WHEN @search_term::text <> '' THEN
-             SIMILARITY(evt.name, @search_term) +
-             SIMILARITY(evt.description, @search_term)
-         ELSE 0 END DESC,
-    matched_tags DESC,
-    evt.created_at DESC,
-    distance ASC;
+    evt.is_premium DESC,
+    evt.date,
+    evt.id
+LIMIT @marker_limit::int;
 
 -- name: UpdateEvent :exec
 UPDATE events
@@ -165,6 +352,27 @@ WHERE id = @id;
 DELETE FROM events
 WHERE id = $1;
 
+-- name: GetEventSummary :one
+SELECT id, name, owner_id
+FROM events
+WHERE id = $1;
+
+-- name: UpdateEventPremium :execrows
+UPDATE events e
+SET premium_manual = $2,
+    is_premium = $2 OR EXISTS (
+        SELECT 1
+        FROM promotions p
+        WHERE p.event_id = e.id
+          AND p.status = 'active'
+    )
+WHERE e.id = $1;
+
+-- name: SetEventHidden :exec
+UPDATE events
+SET is_hidden = $2
+WHERE id = $1;
+
 -- name: GetPremiumEvents :many
 SELECT
     id,
@@ -186,7 +394,18 @@ SELECT
     user_image_path
 FROM event_with_tags_view
 WHERE is_premium = TRUE
-  AND date > NOW() AND is_private = false
+  AND date > NOW() AND is_private = false AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY created_at DESC
     LIMIT 6;
 
@@ -210,7 +429,18 @@ SELECT
     event_image_path,
     user_image_path
 FROM event_with_tags_view
-WHERE date > NOW() AND is_private = false
+WHERE date > NOW() AND is_private = false AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY created_at DESC
     LIMIT 6;
 
@@ -234,7 +464,18 @@ SELECT
     event_image_path,
     user_image_path
 FROM event_with_tags_view
-WHERE date > NOW() AND is_private = false
+WHERE date > NOW() AND is_private = false AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY participants_count DESC, created_at DESC
     LIMIT 6;
 
@@ -283,6 +524,18 @@ WHERE
         100000
       )
   AND evt.is_private = false
+  AND evt.is_hidden = false
+  AND (
+    evt.series_id IS NULL
+        OR evt.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = evt.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY
     matched_tags DESC,
     created_at DESC,
@@ -317,6 +570,18 @@ WHERE
     100000
     )
   AND is_private = false
+  AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY
     ST_Distance(geom, ST_MakePoint(@user_lon::numeric, @user_lat::numeric)::GEOGRAPHY) ASC,
     created_at DESC
@@ -346,8 +611,14 @@ FROM event_with_tags_view e
 WHERE
     eu.user_id = @user_id
   AND e.date < NOW()
+  AND (
+    NOT @has_cursor::boolean
+        OR (e.date, e.id) < (@cursor_date::timestamptz, @cursor_id::int)
+    )
 ORDER BY
-    e.date DESC;
+    e.date DESC,
+    e.id DESC
+LIMIT @page_limit::int;
 
 -- name: GetUpcomingUserEvents :many
 SELECT
@@ -373,8 +644,14 @@ FROM event_with_tags_view e
 WHERE
     eu.user_id = @user_id
   AND e.date > NOW()
+  AND (
+    NOT @has_cursor::boolean
+        OR (e.date, e.id) > (@cursor_date::timestamptz, @cursor_id::int)
+    )
 ORDER BY
-    e.date ASC;
+    e.date ASC,
+    e.id ASC
+LIMIT @page_limit::int;
 
 -- name: GetOwnedUserEvents :many
 SELECT
@@ -398,8 +675,14 @@ SELECT
 FROM event_with_tags_view e
 WHERE
     e.owner_id = @user_id
+  AND (
+    NOT @has_cursor::boolean
+        OR (e.date, e.id) < (@cursor_date::timestamptz, @cursor_id::int)
+    )
 ORDER BY
-    e.date DESC;
+    e.date DESC,
+    e.id DESC
+LIMIT @page_limit::int;
 
 -- name: CreatePrivateEventToken :exec
 INSERT INTO event_tokens (
@@ -0,0 +1,76 @@
+-- name: FollowUser :execrows
+INSERT INTO user_follows (
+                          follower_id,
+                          followee_id
+) VALUES (
+          @follower_id, @followee_id
+         )
+ON CONFLICT DO NOTHING;
+
+-- name: UnfollowUser :execrows
+DELETE FROM user_follows
+WHERE follower_id = @follower_id AND followee_id = @followee_id;
+
+-- name: GetFollowCounts :one
+SELECT
+    (SELECT COUNT(*) FROM user_follows f WHERE f.followee_id = @user_id) AS followers_count,
+    (SELECT COUNT(*) FROM user_follows f WHERE f.follower_id = @user_id) AS following_count;
+
+-- name: IsFollowing :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_follows
+    WHERE follower_id = @follower_id
+      AND followee_id = @followee_id
+) AS is_following;
+
+-- name: ListFollowedIDs :many
+SELECT followee_id
+FROM user_follows
+WHERE follower_id = @follower_id;
+
+-- name: GetFollowingFeedEvents :many
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.owner_username,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    e.tags,
+    e.participants_count,
+    e.event_image_path,
+    e.user_image_path
+FROM event_with_tags_view e
+         JOIN user_follows f ON f.followee_id = e.owner_id
+WHERE
+    f.follower_id = @follower_id
+  AND e.date > NOW()
+  AND e.is_private = false
+  AND e.is_hidden = false
+  AND (
+    e.series_id IS NULL
+        OR e.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = e.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
+  AND (
+    NOT @has_cursor::boolean
+        OR (e.date, e.id) > (@cursor_date::timestamptz, @cursor_id::int)
+    )
+ORDER BY
+    e.date ASC,
+    e.id ASC
+LIMIT @page_limit::int;
@@ -20,4 +20,9 @@ WHERE id = @id;
 -- name: GetImageByUserID :one
 SELECT i.id, i.path
 FROM images i LEFT JOIN users u ON u.image_id = i.id
-WHERE u.id = @id;
\ No newline at end of file
+WHERE u.id = @id;
+
+-- name: CountImageReferences :one
+SELECT
+    (SELECT COUNT(*) FROM events WHERE events.image_id = @id) +
+    (SELECT COUNT(*) FROM users WHERE users.image_id = @id) AS references_count;
@@ -0,0 +1,65 @@
+-- name: CreateNotification :exec
+INSERT INTO notifications (user_id, type, event_id, event_name, actor_id)
+SELECT @user_id::int, @type::varchar, e.id, e.name, sqlc.narg(actor_id)::int
+FROM events e
+WHERE e.id = @event_id;
+
+-- name: NotifyEventParticipants :execrows
+INSERT INTO notifications (user_id, type, event_id, event_name)
+SELECT eu.user_id, @type::varchar, e.id, e.name
+FROM event_user eu
+         JOIN events e ON e.id = eu.event_id
+WHERE eu.event_id = @event_id
+  AND eu.user_id <> e.owner_id;
+
+-- name: NotifyEventOwner :exec
+INSERT INTO notifications (user_id, type, event_id, event_name, actor_id)
+SELECT e.owner_id, @type::varchar, e.id, e.name, @actor_id::int
+FROM events e
+WHERE e.id = @event_id;
+
+-- name: NotifyStartingEvents :execrows
+INSERT INTO notifications (user_id, type, event_id, event_name)
+SELECT eu.user_id, 'event_starting', e.id, e.name
+FROM events e
+         JOIN event_user eu ON eu.event_id = e.id
+WHERE e.date > NOW()
+  AND e.date <= NOW() + INTERVAL '24 hours'
+ON CONFLICT (user_id, event_id) WHERE type = 'event_starting' DO NOTHING;
+
+-- name: ListNotifications :many
+SELECT
+    n.id,
+    n.user_id,
+    n.type,
+    n.event_id,
+    n.event_name,
+    n.actor_id,
+    n.read_at,
+    n.created_at,
+    a.username AS actor_username
+FROM notifications n
+         LEFT JOIN users a ON a.id = n.actor_id
+WHERE n.user_id = @user_id
+  AND (NOT @unread_only::boolean OR n.read_at IS NULL)
+ORDER BY n.id DESC
+LIMIT @lim
+OFFSET @off;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE user_id = @user_id
+  AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = @id
+  AND user_id = @user_id;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = NOW()
+WHERE user_id = @user_id
+  AND read_at IS NULL;
@@ -0,0 +1,39 @@
+-- name: CreateOutboxMessage :exec
+INSERT INTO outbox (event_type, payload)
+VALUES (@event_type, @payload);
+
+-- name: ClaimOutboxMessages :many
+WITH claimed AS (
+    UPDATE outbox
+        SET next_attempt_at = NOW() + @lease_seconds::int * INTERVAL '1 second'
+        WHERE id IN (
+            SELECT o.id
+            FROM outbox o
+            WHERE o.published_at IS NULL
+              AND o.attempts < @max_attempts::int
+              AND o.next_attempt_at <= NOW()
+            ORDER BY o.id
+            LIMIT @lim
+                FOR UPDATE SKIP LOCKED
+        )
+        RETURNING *
+)
+SELECT * FROM claimed
+ORDER BY id;
+
+-- name: MarkOutboxMessagePublished :exec
+UPDATE outbox
+SET published_at = NOW()
+WHERE id = @id;
+
+-- name: RecordOutboxMessageFailure :exec
+UPDATE outbox
+SET attempts = attempts + 1,
+    last_error = @last_error,
+    next_attempt_at = @next_attempt_at
+WHERE id = @id;
+
+-- name: DeleteExpiredOutboxMessages :execrows
+DELETE FROM outbox
+WHERE (published_at IS NOT NULL AND published_at < @expired_before::timestamptz)
+   OR (published_at IS NULL AND attempts >= @max_attempts::int AND created_at < @expired_before::timestamptz);
@@ -0,0 +1,110 @@
+-- name: GetPublicProfile :one
+SELECT
+    v.id,
+    v.username,
+    v.created_at,
+    v.tags,
+    v.image_path,
+    (
+        SELECT COUNT(*)
+        FROM events e
+        WHERE e.owner_id = v.id
+          AND e.is_private = false
+          AND e.is_hidden = false
+    ) AS hosted_events_count,
+    COALESCE(p.hide_attendance, false)::boolean AS hide_attendance
+FROM user_with_tags_view v
+         JOIN users u ON u.id = v.id
+         LEFT JOIN user_privacy p ON p.user_id = v.id
+WHERE v.id = @user_id
+  AND u.is_blocked = false;
+
+-- name: ListProfileUpcomingEvents :many
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.owner_username,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    e.tags,
+    e.participants_count,
+    e.event_image_path,
+    e.user_image_path
+FROM event_with_tags_view e
+WHERE
+    e.owner_id = @owner_id
+  AND e.date > NOW()
+  AND e.is_private = false
+  AND e.is_hidden = false
+  AND (
+    e.series_id IS NULL
+        OR e.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = e.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
+ORDER BY
+    e.date ASC,
+    e.id ASC
+LIMIT @lim;
+
+-- name: ListProfileAttendedEvents :many
+SELECT
+    e.id,
+    e.name,
+    e.description,
+    e.capacity,
+    e.latitude,
+    e.longitude,
+    e.address,
+    e.date,
+    e.owner_id,
+    e.owner_username,
+    e.is_private,
+    e.is_premium,
+    e.created_at,
+    e.tags,
+    e.participants_count,
+    e.event_image_path,
+    e.user_image_path
+FROM event_with_tags_view e
+         JOIN event_user eu ON e.id = eu.event_id
+WHERE
+    eu.user_id = @user_id
+  AND e.date <= NOW()
+  AND e.is_private = false
+  AND e.is_hidden = false
+ORDER BY
+    e.date DESC,
+    e.id DESC
+LIMIT @lim;
+
+-- name: GetPrivacySettings :one
+SELECT COALESCE(
+               (SELECT hide_attendance FROM user_privacy WHERE user_id = @user_id),
+               false
+       )::boolean AS hide_attendance;
+
+-- name: UpsertPrivacySettings :one
+INSERT INTO user_privacy (
+                          user_id,
+                          hide_attendance
+) VALUES (
+          @user_id, @hide_attendance
+         )
+ON CONFLICT (user_id) DO UPDATE
+    SET hide_attendance = EXCLUDED.hide_attendance,
+        updated_at = NOW()
+RETURNING *;
@@ -0,0 +1,81 @@
+-- name: CreatePromotion :one
+INSERT INTO promotions (
+                        event_id,
+                        requested_by,
+                        starts_at,
+                        ends_at
+) VALUES (
+          $1, $2, $3, $4
+         ) RETURNING *;
+
+-- name: HasOverlappingPromotion :one
+SELECT EXISTS (
+    SELECT 1
+    FROM promotions
+    WHERE event_id = @event_id
+      AND status IN ('pending', 'approved', 'active')
+      AND starts_at < @ends_at
+      AND ends_at > @starts_at
+) AS overlaps;
+
+-- name: ListEventPromotions :many
+SELECT * FROM promotions
+WHERE event_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListPromotions :many
+SELECT
+    p.id,
+    p.event_id,
+    p.requested_by,
+    p.status,
+    p.starts_at,
+    p.ends_at,
+    p.reviewed_by,
+    p.reviewed_at,
+    p.activated_at,
+    p.finished_at,
+    p.created_at,
+    e.name AS event_name
+FROM promotions p
+         JOIN events e ON e.id = p.event_id
+WHERE (@status::text = '' OR p.status = @status::text)
+ORDER BY p.created_at DESC
+LIMIT @lim
+OFFSET @off;
+
+-- name: ReviewPromotion :one
+UPDATE promotions
+SET status = @status,
+    reviewed_by = @reviewed_by,
+    reviewed_at = NOW()
+WHERE id = @id
+  AND status = 'pending'
+RETURNING *;
+
+-- name: ActivatePromotions :many
+UPDATE promotions
+SET status = 'active',
+    activated_at = NOW()
+WHERE status = 'approved'
+  AND starts_at <= NOW()
+  AND ends_at > NOW()
+RETURNING event_id;
+
+-- name: FinishPromotions :many
+UPDATE promotions
+SET status = CASE WHEN status = 'active' THEN 'finished' ELSE 'expired' END,
+    finished_at = NOW()
+WHERE status IN ('pending', 'approved', 'active')
+  AND ends_at <= NOW()
+RETURNING event_id;
+
+-- name: SyncEventsPremium :exec
+UPDATE events e
+SET is_premium = e.premium_manual OR EXISTS (
+    SELECT 1
+    FROM promotions p
+    WHERE p.event_id = e.id
+      AND p.status = 'active'
+)
+WHERE e.id = ANY(@event_ids::int[]);
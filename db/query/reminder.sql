@@ -0,0 +1,113 @@
+-- name: ListDueReminders :many
+SELECT
+    eu.user_id,
+    u.username,
+    u.email,
+    e.id AS event_id,
+    e.name AS event_name,
+    e.date AS event_date,
+    COALESCE(p.email_enabled, true)::boolean AS email_enabled,
+    COALESCE(p.push_enabled, true)::boolean AS push_enabled
+FROM event_user eu
+         JOIN events e ON e.id = eu.event_id
+         JOIN users u ON u.id = eu.user_id
+         LEFT JOIN reminder_preferences p ON p.user_id = eu.user_id
+WHERE e.date > @date_from::timestamptz
+  AND e.date <= @date_to::timestamptz
+  AND u.is_blocked = false
+  AND (
+    SELECT COUNT(*)
+    FROM reminder_deliveries d
+    WHERE d.event_id = e.id
+      AND d.user_id = eu.user_id
+      AND d.kind = @kind::varchar
+      AND NOT (d.status = 'pending' AND d.attempts < @max_attempts::int AND d.next_attempt_at <= NOW())
+    ) < @channels::int
+ORDER BY e.date, e.id, eu.user_id
+LIMIT @lim;
+
+-- name: ClaimReminderDelivery :one
+INSERT INTO reminder_deliveries (
+                                 event_id,
+                                 user_id,
+                                 kind,
+                                 channel,
+                                 next_attempt_at
+) VALUES (
+          @event_id, @user_id, @kind, @channel, NOW() + @lease_seconds::int * INTERVAL '1 second'
+         )
+ON CONFLICT (event_id, user_id, kind, channel) DO UPDATE
+    SET next_attempt_at = EXCLUDED.next_attempt_at
+    WHERE reminder_deliveries.status = 'pending'
+      AND reminder_deliveries.attempts < @max_attempts::int
+      AND reminder_deliveries.next_attempt_at <= NOW()
+RETURNING attempts;
+
+-- name: SetReminderDeliveryStatus :exec
+UPDATE reminder_deliveries
+SET status = @status
+WHERE event_id = @event_id
+  AND user_id = @user_id
+  AND kind = @kind
+  AND channel = @channel;
+
+-- name: RecordReminderDeliveryFailure :exec
+UPDATE reminder_deliveries
+SET attempts = attempts + 1,
+    next_attempt_at = @next_attempt_at
+WHERE event_id = @event_id
+  AND user_id = @user_id
+  AND kind = @kind
+  AND channel = @channel
+  AND status = 'pending';
+
+-- name: GetReminderPreferences :one
+SELECT
+    COALESCE(p.email_enabled, true)::boolean AS email_enabled,
+    COALESCE(p.push_enabled, true)::boolean AS push_enabled
+FROM users u
+         LEFT JOIN reminder_preferences p ON p.user_id = u.id
+WHERE u.id = @user_id;
+
+-- name: UpsertReminderPreferences :one
+INSERT INTO reminder_preferences (
+                                  user_id,
+                                  email_enabled,
+                                  push_enabled
+) VALUES (
+          @user_id, @email_enabled, @push_enabled
+         )
+ON CONFLICT (user_id) DO UPDATE
+    SET email_enabled = EXCLUDED.email_enabled,
+        push_enabled = EXCLUDED.push_enabled,
+        updated_at = NOW()
+RETURNING *;
+
+-- name: UpsertPushSubscription :one
+INSERT INTO push_subscriptions (
+                                user_id,
+                                endpoint,
+                                p256dh,
+                                auth
+) VALUES (
+          @user_id, @endpoint, @p256dh, @auth
+         )
+ON CONFLICT (endpoint) DO UPDATE
+    SET user_id = EXCLUDED.user_id,
+        p256dh = EXCLUDED.p256dh,
+        auth = EXCLUDED.auth
+RETURNING *;
+
+-- name: ListUserPushSubscriptions :many
+SELECT * FROM push_subscriptions
+WHERE user_id = @user_id
+ORDER BY id;
+
+-- name: DeletePushSubscription :execrows
+DELETE FROM push_subscriptions
+WHERE user_id = @user_id
+  AND endpoint = @endpoint;
+
+-- name: DeletePushSubscriptionByEndpoint :exec
+DELETE FROM push_subscriptions
+WHERE endpoint = @endpoint;
@@ -0,0 +1,68 @@
+-- name: CreateReport :one
+INSERT INTO reports (
+                     reporter_id,
+                     event_id,
+                     user_id,
+                     reason,
+                     comment
+) VALUES (
+          $1, $2, $3, $4, $5
+         ) RETURNING *;
+
+-- name: HideReportedEvent :execrows
+UPDATE events
+SET is_hidden = true
+WHERE id = @event_id
+  AND is_hidden = false
+  AND (
+    SELECT COUNT(*)
+    FROM reports r
+    WHERE r.event_id = @event_id
+      AND r.status = 'open'
+) >= @threshold::bigint;
+
+-- name: ListReports :many
+SELECT
+    r.id,
+    r.reporter_id,
+    reporter.username AS reporter_username,
+    r.event_id,
+    e.name AS event_name,
+    r.user_id,
+    target.username AS target_username,
+    r.reason,
+    r.comment,
+    r.status,
+    r.resolved_by,
+    r.resolved_at,
+    r.created_at,
+    (
+        SELECT COUNT(*)
+        FROM reports o
+        WHERE o.status = 'open'
+          AND (o.event_id = r.event_id OR o.user_id = r.user_id)
+    ) AS open_reports
+FROM reports r
+         JOIN users reporter ON reporter.id = r.reporter_id
+         LEFT JOIN events e ON e.id = r.event_id
+         LEFT JOIN users target ON target.id = r.user_id
+WHERE (@status::text = '' OR r.status = @status::text)
+  AND (
+    @target_type::text = ''
+        OR (@target_type::text = 'event' AND r.event_id IS NOT NULL)
+        OR (@target_type::text = 'user' AND r.user_id IS NOT NULL)
+    )
+ORDER BY r.created_at, r.id
+LIMIT @lim
+OFFSET @off;
+
+-- name: ReviewReports :many
+UPDATE reports r
+SET status = @status,
+    resolved_by = @resolved_by,
+    resolved_at = NOW()
+FROM reports target
+WHERE target.id = @id
+  AND r.status = 'open'
+  AND (r.event_id = target.event_id OR r.user_id = target.user_id)
+RETURNING r.id, r.reporter_id, r.event_id, r.user_id, r.reason, r.comment, r.status, r.resolved_by, r.resolved_at, r.created_at;
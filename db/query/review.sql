@@ -0,0 +1,63 @@
+-- name: UpsertReview :one
+INSERT INTO reviews (
+                     event_id,
+                     user_id,
+                     rating,
+                     body
+) VALUES (
+          $1, $2, $3, $4
+         )
+ON CONFLICT (event_id, user_id) DO UPDATE
+    SET rating = EXCLUDED.rating,
+        body = EXCLUDED.body,
+        updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteReview :execrows
+DELETE FROM reviews
+WHERE event_id = $1 AND user_id = $2;
+
+-- name: ListEventReviews :many
+SELECT
+    r.event_id,
+    r.user_id,
+    u.username,
+    i.path AS user_image_path,
+    r.rating,
+    r.body,
+    r.created_at,
+    r.updated_at,
+    COALESCE(p.hide_attendance, false)::boolean AS hide_attendance
+FROM reviews r
+         JOIN users u ON u.id = r.user_id
+         LEFT JOIN images i ON i.id = u.image_id
+         LEFT JOIN user_privacy p ON p.user_id = r.user_id
+WHERE r.event_id = @event_id
+ORDER BY r.created_at DESC, r.user_id
+LIMIT @lim
+OFFSET @off;
+
+-- name: GetEventRating :one
+SELECT
+    COALESCE(AVG(rating), 0)::float8 AS average,
+    COUNT(*) AS reviews_count
+FROM reviews
+WHERE event_id = $1;
+
+-- name: GetOrganizerRating :one
+SELECT
+    COALESCE(AVG(r.rating), 0)::float8 AS average,
+    COUNT(*) AS reviews_count
+FROM reviews r
+         JOIN events e ON e.id = r.event_id
+WHERE e.owner_id = $1;
+
+-- name: ListOrganizerRatings :many
+SELECT
+    e.owner_id,
+    AVG(r.rating)::float8 AS average,
+    COUNT(*) AS reviews_count
+FROM reviews r
+         JOIN events e ON e.id = r.event_id
+WHERE e.owner_id = ANY(@owner_ids::int[])
+GROUP BY e.owner_id;
@@ -0,0 +1,62 @@
+-- name: SuggestEvents :many
+SELECT
+    e.id,
+    e.name,
+    e.date
+FROM events e
+WHERE
+    @search_term::text <% e.name
+  AND e.is_private = false
+  AND e.is_hidden = false
+  AND e.date > NOW()
+  AND (
+    e.series_id IS NULL
+        OR e.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = e.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
+ORDER BY
+    word_similarity(@search_term, e.name) / (
+        1 + ST_Distance(
+                e.geom,
+                ST_MakePoint(@user_lon::numeric, @user_lat::numeric)::GEOGRAPHY
+            ) / 50000
+        ) DESC,
+    e.date,
+    e.id
+LIMIT @result_limit::int;
+
+-- name: SuggestTags :many
+SELECT
+    t.id,
+    t.name
+FROM tags t
+WHERE @search_term::text <% t.name
+ORDER BY
+    word_similarity(@search_term, t.name) DESC,
+    t.name
+LIMIT @result_limit::int;
+
+-- name: SuggestOrganizers :many
+SELECT
+    u.id,
+    u.username,
+    i.path AS image_path
+FROM users u
+         LEFT JOIN images i ON u.image_id = i.id
+WHERE
+    @search_term::text <% u.username
+  AND EXISTS (
+    SELECT 1
+    FROM events e
+    WHERE e.owner_id = u.id
+)
+ORDER BY
+    word_similarity(@search_term, u.username) DESC,
+    u.username
+LIMIT @result_limit::int;
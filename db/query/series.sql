@@ -0,0 +1,16 @@
+-- name: CreateEventSeries :one
+INSERT INTO event_series (
+    owner_id,
+    rrule
+) VALUES (
+             @owner_id,
+             @rrule
+         )
+RETURNING id, owner_id, rrule, created_at;
+
+-- name: ListFollowingSeriesEvents :many
+SELECT f.id, f.date
+FROM events e
+         JOIN events f ON f.series_id = e.series_id AND f.date >= e.date
+WHERE e.id = @id
+ORDER BY f.date, f.id;
@@ -4,17 +4,73 @@ INSERT INTO sessions (
                       user_id,
                       refresh_token,
                       expires_at,
-                      is_blocked
+                      is_blocked,
+                      user_agent,
+                      client_ip,
+                      family_id
 ) VALUES (
-          $1, $2, $3, $4, $5
+          $1, $2, $3, $4, $5, $6, $7, $8
          ) RETURNING *;
 
 -- name: GetSession :one
 SELECT * FROM sessions
 WHERE uuid = $1 LIMIT 1;
 
--- name: UpdateSession :exec
+-- name: RotateSession :execrows
 UPDATE sessions
-SET uuid = sqlc.arg(new_uuid), refresh_token = sqlc.arg(refresh_token), expires_at = sqlc.arg(expires_at)
-WHERE uuid = sqlc.arg(old_uuid)
-RETURNING *;
\ No newline at end of file
+SET rotated_at = NOW()
+WHERE uuid = $1
+  AND rotated_at IS NULL
+  AND is_blocked = false;
+
+-- name: CreateRotatedSession :exec
+INSERT INTO sessions (
+                      uuid,
+                      user_id,
+                      refresh_token,
+                      expires_at,
+                      user_agent,
+                      client_ip,
+                      family_id,
+                      created_at
+)
+SELECT sqlc.arg(new_uuid),
+       user_id,
+       sqlc.arg(refresh_token),
+       sqlc.arg(expires_at),
+       sqlc.arg(user_agent),
+       sqlc.arg(client_ip),
+       family_id,
+       created_at
+FROM sessions
+WHERE uuid = sqlc.arg(old_uuid);
+
+-- name: BlockUserSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE user_id = $1;
+
+-- name: BlockSessionFamily :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = $1;
+
+-- name: ListUserSessions :many
+SELECT * FROM sessions
+WHERE user_id = $1
+  AND is_blocked = false
+  AND rotated_at IS NULL
+  AND expires_at > NOW()
+ORDER BY last_used_at DESC;
+
+-- name: BlockSession :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = (SELECT s.family_id FROM sessions s WHERE s.uuid = $1)
+  AND user_id = $2;
+
+-- name: BlockOtherUserSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE user_id = $1
+  AND family_id IS DISTINCT FROM (SELECT s.family_id FROM sessions s WHERE s.uuid = $2);
@@ -37,6 +37,7 @@ WITH event_check AS (
     SELECT
         e.capacity,
         e.is_private,
+        e.date,
         COUNT(eu.user_id) AS participants,
         EXISTS (
             SELECT 1 FROM event_tokens
@@ -54,18 +55,23 @@ SELECT $1, $2
 FROM event_check
 WHERE
     participants < capacity
+  AND date > NOW()
   AND (
     NOT is_private
         OR
     (is_private AND valid_token)
     )
+  AND NOT EXISTS (
+    SELECT 1 FROM event_bans
+    WHERE event_id = $2 AND user_id = $1
+    )
     RETURNING (
     SELECT participants < capacity
     AND (NOT is_private OR valid_token)
     FROM event_check
 ) AS allowed;
 
--- name: UnsubscribeFromEvent :exec
+-- name: UnsubscribeFromEvent :execrows
 DELETE FROM event_user
 WHERE user_id = $1 AND event_id = $2;
 
@@ -75,4 +81,31 @@ SELECT EXISTS (
     FROM event_user
     WHERE event_id = $1
       AND user_id = $2
-) AS is_participant;
\ No newline at end of file
+) AS is_participant;
+
+-- name: VerifyUserEmail :exec
+UPDATE users
+SET email_verified = true
+WHERE id = $1;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2
+WHERE id = $1;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: SetUserBlocked :one
+UPDATE users
+SET is_blocked = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: GetUserAccess :one
+SELECT role, is_blocked
+FROM users
+WHERE id = $1;
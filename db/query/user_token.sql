@@ -0,0 +1,33 @@
+-- name: CreateUserToken :exec
+INSERT INTO user_tokens (
+    token_hash,
+    user_id,
+    purpose,
+    expires_at
+) VALUES (
+             $1, $2, $3, $4
+         );
+
+-- name: ConsumeUserToken :one
+UPDATE user_tokens
+SET used_at = NOW()
+WHERE token_hash = $1
+  AND purpose = $2
+  AND used_at IS NULL
+  AND expires_at > NOW()
+RETURNING user_id;
+
+-- name: DeleteUserTokens :exec
+DELETE FROM user_tokens
+WHERE user_id = $1
+  AND purpose = $2;
+
+-- name: HasActiveUserToken :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_tokens
+    WHERE user_id = $1
+      AND purpose = $2
+      AND used_at IS NULL
+      AND expires_at > NOW()
+) AS has_active;
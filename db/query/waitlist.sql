@@ -0,0 +1,51 @@
+-- name: JoinEventWaitlist :exec
+INSERT INTO event_waitlist (event_id, user_id)
+VALUES (@event_id, @user_id)
+ON CONFLICT (event_id, user_id) DO NOTHING;
+
+-- name: LeaveEventWaitlist :exec
+DELETE FROM event_waitlist
+WHERE event_id = @event_id AND user_id = @user_id;
+
+-- name: GetWaitlistStatus :one
+SELECT
+    COUNT(*) AS waitlist_count,
+    COALESCE((
+        SELECT q.position
+        FROM (
+            SELECT
+                w.user_id,
+                ROW_NUMBER() OVER (ORDER BY w.created_at, w.user_id) AS position
+            FROM event_waitlist w
+            WHERE w.event_id = @event_id
+        ) q
+        WHERE q.user_id = @user_id
+    ), 0)::int AS position
+FROM event_waitlist
+WHERE event_id = @event_id;
+
+-- name: PopEventWaitlist :one
+DELETE FROM event_waitlist
+WHERE (event_id, user_id) = (
+    SELECT w.event_id, w.user_id
+    FROM event_waitlist w
+    WHERE w.event_id = @event_id
+    ORDER BY w.created_at, w.user_id
+    LIMIT 1
+    FOR UPDATE
+)
+RETURNING user_id;
+
+-- name: GetEventCapacityForUpdate :one
+SELECT capacity FROM events
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CountEventParticipants :one
+SELECT COUNT(*) FROM event_user
+WHERE event_id = $1;
+
+-- name: AddEventParticipant :exec
+INSERT INTO event_user (user_id, event_id)
+VALUES (@user_id, @event_id)
+ON CONFLICT (user_id, event_id) DO NOTHING;
@@ -0,0 +1,111 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (
+                      owner_id,
+                      url,
+                      secret,
+                      event_types
+) VALUES (
+          @owner_id, @url, @secret, @event_types::varchar[]
+         )
+RETURNING *;
+
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+WHERE owner_id = @owner_id
+ORDER BY id;
+
+-- name: UpdateWebhook :one
+UPDATE webhooks
+SET url = @url,
+    event_types = @event_types::varchar[],
+    is_active = @is_active,
+    consecutive_failures = CASE WHEN @is_active THEN 0 ELSE consecutive_failures END,
+    disabled_at = CASE WHEN @is_active THEN NULL ELSE disabled_at END
+WHERE id = @id
+  AND owner_id = @owner_id
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = @id
+  AND owner_id = @owner_id;
+
+-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (webhook_id, outbox_id, event_type, payload)
+SELECT w.id, @outbox_id::bigint, @event_type::varchar, @payload::jsonb
+FROM webhooks w
+WHERE w.owner_id = @owner_id
+  AND w.is_active
+  AND @event_type::varchar = ANY (w.event_types)
+ON CONFLICT (webhook_id, outbox_id) DO NOTHING;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = NOW() + @lease_seconds::int * INTERVAL '1 second'
+FROM webhooks w
+WHERE w.id = d.webhook_id
+  AND d.id IN (
+    SELECT dd.id
+    FROM webhook_deliveries dd
+             JOIN webhooks ww ON ww.id = dd.webhook_id
+    WHERE dd.status = 'pending'
+      AND dd.next_attempt_at <= NOW()
+      AND ww.is_active
+    ORDER BY dd.next_attempt_at
+    LIMIT @lim
+        FOR UPDATE OF dd SKIP LOCKED
+)
+RETURNING d.id, d.webhook_id, d.event_type, d.payload, d.attempts, w.url, w.secret;
+
+-- name: RecordWebhookDeliverySuccess :exec
+UPDATE webhook_deliveries
+SET status = 'succeeded',
+    attempts = attempts + 1,
+    response_status = @response_status,
+    last_error = '',
+    delivered_at = NOW()
+WHERE id = @id;
+
+-- name: RecordWebhookDeliveryFailure :exec
+UPDATE webhook_deliveries
+SET status = @status,
+    attempts = attempts + 1,
+    response_status = sqlc.narg(response_status),
+    last_error = @last_error,
+    next_attempt_at = @next_attempt_at
+WHERE id = @id;
+
+-- name: ResetWebhookFailures :exec
+UPDATE webhooks
+SET consecutive_failures = 0
+WHERE id = @id;
+
+-- name: RecordWebhookFailure :one
+UPDATE webhooks
+SET consecutive_failures = consecutive_failures + 1,
+    is_active = consecutive_failures + 1 < @disable_threshold::int,
+    disabled_at = CASE
+                      WHEN consecutive_failures + 1 >= @disable_threshold::int THEN NOW()
+                      ELSE disabled_at
+        END
+WHERE id = @id
+RETURNING is_active;
+
+-- name: ListWebhookDeliveries :many
+SELECT d.*
+FROM webhook_deliveries d
+         JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.webhook_id = @webhook_id
+  AND w.owner_id = @owner_id
+ORDER BY d.id DESC
+LIMIT @lim OFFSET @off;
+
+-- name: ReplayWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook_id, event_type, payload)
+SELECT d.webhook_id, d.event_type, d.payload
+FROM webhook_deliveries d
+         JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.id = @id
+  AND d.webhook_id = @webhook_id
+  AND w.owner_id = @owner_id
+RETURNING *;
@@ -15,6 +15,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
 		Password: cfg.Password,
 		DB:       cfg.DB,
+		PoolSize: cfg.PoolSize,
 	})
 
 	if err := client.Ping(context.Background()).Err(); err != nil {
@@ -29,4 +30,6 @@ type Config struct {
 	Port     int
 	Password string
 	DB       int
+	// PoolSize of 0 keeps the go-redis default.
+	PoolSize int
 }
@@ -0,0 +1,95 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/redis/go-redis/v9"
+	"time"
+	"treffly/api/models"
+)
+
+const (
+	eventStreamMaxLen = 100
+	eventStreamTTL    = 24 * time.Hour
+	eventStreamBatch  = 50
+)
+
+// EventStream keeps a short Redis stream of live updates per event. Stream
+// ids double as SSE event ids, so a reconnecting client resumes with XREAD
+// after its Last-Event-ID.
+type EventStream struct {
+	client *Client
+}
+
+func NewEventStream(client *Client) *EventStream {
+	return &EventStream{client: client}
+}
+
+func eventStreamKey(eventID int32) string {
+	return fmt.Sprintf("event_stream:%d", eventID)
+}
+
+func (s *EventStream) Publish(ctx context.Context, eventID int32, msgType string, data []byte) error {
+	key := eventStreamKey(eventID)
+
+	pipe := s.client.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: eventStreamMaxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"type": msgType,
+			"data": data,
+		},
+	})
+	pipe.Expire(ctx, key, eventStreamTTL)
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// LastID returns the id of the newest message, or "0-0" for an empty stream.
+func (s *EventStream) LastID(ctx context.Context, eventID int32) (string, error) {
+	messages, err := s.client.XRevRangeN(ctx, eventStreamKey(eventID), "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+
+	if len(messages) == 0 {
+		return "0-0", nil
+	}
+
+	return messages[0].ID, nil
+}
+
+// Read waits up to block for messages after the given id. It returns an empty
+// slice, not an error, when nothing arrived in time.
+func (s *EventStream) Read(ctx context.Context, eventID int32, after string, block time.Duration) ([]models.EventStreamMessage, error) {
+	streams, err := s.client.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{eventStreamKey(eventID), after},
+		Count:   eventStreamBatch,
+		Block:   block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return []models.EventStreamMessage{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result := []models.EventStreamMessage{}
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			msgType, _ := msg.Values["type"].(string)
+			data, _ := msg.Values["data"].(string)
+			result = append(result, models.EventStreamMessage{
+				ID:   msg.ID,
+				Type: msgType,
+				Data: []byte(data),
+			})
+		}
+	}
+
+	return result, nil
+}
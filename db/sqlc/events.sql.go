@@ -23,7 +23,8 @@ INSERT INTO events (
     date,
     owner_id,
     is_private,
-    image_id
+    image_id,
+    series_id
 ) VALUES (
              $1,
              $2,
@@ -34,10 +35,11 @@ INSERT INTO events (
              $7,
              $8,
              $9,
-             $10
+             $10,
+             $11
          )
     RETURNING id, name, description, capacity, latitude, longitude,
-    address, date, owner_id, is_private, is_premium, created_at, image_id
+    address, date, owner_id, is_private, is_premium, created_at, image_id, series_id
 `
 
 type CreateEventParams struct {
@@ -51,6 +53,7 @@ type CreateEventParams struct {
 	OwnerID     int32          `json:"owner_id"`
 	IsPrivate   bool           `json:"is_private"`
 	ImageID     pgtype.UUID    `json:"image_id"`
+	SeriesID    pgtype.Int4    `json:"series_id"`
 }
 
 type CreateEventRow struct {
@@ -67,6 +70,7 @@ type CreateEventRow struct {
 	IsPremium   bool           `json:"is_premium"`
 	CreatedAt   time.Time      `json:"created_at"`
 	ImageID     pgtype.UUID    `json:"image_id"`
+	SeriesID    pgtype.Int4    `json:"series_id"`
 }
 
 func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (CreateEventRow, error) {
@@ -81,6 +85,7 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Creat
 		arg.OwnerID,
 		arg.IsPrivate,
 		arg.ImageID,
+		arg.SeriesID,
 	)
 	var i CreateEventRow
 	err := row.Scan(
@@ -97,6 +102,7 @@ func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Creat
 		&i.IsPremium,
 		&i.CreatedAt,
 		&i.ImageID,
+		&i.SeriesID,
 	)
 	return i, err
 }
@@ -150,6 +156,7 @@ SELECT
     e.participants_count,
     e.event_image_path,
     e.user_image_path,
+    e.series_id,
     CASE
         WHEN $2 = e.owner_id THEN true
         WHEN NOT e.is_private THEN true
@@ -203,6 +210,7 @@ type GetEventRow struct {
 	ParticipantsCount int64          `json:"participants_count"`
 	EventImagePath    pgtype.Text    `json:"event_image_path"`
 	UserImagePath     pgtype.Text    `json:"user_image_path"`
+	SeriesID          pgtype.Int4    `json:"series_id"`
 	Allowed           bool           `json:"allowed"`
 }
 
@@ -228,11 +236,31 @@ func (q *Queries) GetEvent(ctx context.Context, arg GetEventParams) (GetEventRow
 		&i.ParticipantsCount,
 		&i.EventImagePath,
 		&i.UserImagePath,
+		&i.SeriesID,
 		&i.Allowed,
 	)
 	return i, err
 }
 
+const getEventSummary = `-- name: GetEventSummary :one
+SELECT id, name, owner_id
+FROM events
+WHERE id = $1
+`
+
+type GetEventSummaryRow struct {
+	ID      int32  `json:"id"`
+	Name    string `json:"name"`
+	OwnerID int32  `json:"owner_id"`
+}
+
+func (q *Queries) GetEventSummary(ctx context.Context, id int32) (GetEventSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getEventSummary, id)
+	var i GetEventSummaryRow
+	err := row.Scan(&i.ID, &i.Name, &i.OwnerID)
+	return i, err
+}
+
 const getGuestRecommendedEvents = `-- name: GetGuestRecommendedEvents :many
 SELECT
     id,
@@ -261,6 +289,18 @@ WHERE
     100000
     )
   AND is_private = false
+  AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY
     ST_Distance(geom, ST_MakePoint($1::numeric, $2::numeric)::GEOGRAPHY) ASC,
     created_at DESC
@@ -350,7 +390,18 @@ SELECT
     event_image_path,
     user_image_path
 FROM event_with_tags_view
-WHERE date > NOW() AND is_private = false
+WHERE date > NOW() AND is_private = false AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY created_at DESC
     LIMIT 6
 `
@@ -435,10 +486,24 @@ SELECT
 FROM event_with_tags_view e
 WHERE
     e.owner_id = $1
+  AND (
+    NOT $2::boolean
+        OR (e.date, e.id) < ($3::timestamptz, $4::int)
+    )
 ORDER BY
-    e.date DESC
+    e.date DESC,
+    e.id DESC
+LIMIT $5::int
 `
 
+type GetOwnedUserEventsParams struct {
+	UserID     int32     `json:"user_id"`
+	HasCursor  bool      `json:"has_cursor"`
+	CursorDate time.Time `json:"cursor_date"`
+	CursorID   int32     `json:"cursor_id"`
+	PageLimit  int32     `json:"page_limit"`
+}
+
 type GetOwnedUserEventsRow struct {
 	ID                int32          `json:"id"`
 	Name              string         `json:"name"`
@@ -459,8 +524,14 @@ type GetOwnedUserEventsRow struct {
 	UserImagePath     pgtype.Text    `json:"user_image_path"`
 }
 
-func (q *Queries) GetOwnedUserEvents(ctx context.Context, userID int32) ([]GetOwnedUserEventsRow, error) {
-	rows, err := q.db.Query(ctx, getOwnedUserEvents, userID)
+func (q *Queries) GetOwnedUserEvents(ctx context.Context, arg GetOwnedUserEventsParams) ([]GetOwnedUserEventsRow, error) {
+	rows, err := q.db.Query(ctx, getOwnedUserEvents,
+		arg.UserID,
+		arg.HasCursor,
+		arg.CursorDate,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -521,10 +592,24 @@ FROM event_with_tags_view e
 WHERE
     eu.user_id = $1
   AND e.date < NOW()
+  AND (
+    NOT $2::boolean
+        OR (e.date, e.id) < ($3::timestamptz, $4::int)
+    )
 ORDER BY
-    e.date DESC
+    e.date DESC,
+    e.id DESC
+LIMIT $5::int
 `
 
+type GetPastUserEventsParams struct {
+	UserID     int32     `json:"user_id"`
+	HasCursor  bool      `json:"has_cursor"`
+	CursorDate time.Time `json:"cursor_date"`
+	CursorID   int32     `json:"cursor_id"`
+	PageLimit  int32     `json:"page_limit"`
+}
+
 type GetPastUserEventsRow struct {
 	ID                int32          `json:"id"`
 	Name              string         `json:"name"`
@@ -545,8 +630,14 @@ type GetPastUserEventsRow struct {
 	UserImagePath     pgtype.Text    `json:"user_image_path"`
 }
 
-func (q *Queries) GetPastUserEvents(ctx context.Context, userID int32) ([]GetPastUserEventsRow, error) {
-	rows, err := q.db.Query(ctx, getPastUserEvents, userID)
+func (q *Queries) GetPastUserEvents(ctx context.Context, arg GetPastUserEventsParams) ([]GetPastUserEventsRow, error) {
+	rows, err := q.db.Query(ctx, getPastUserEvents,
+		arg.UserID,
+		arg.HasCursor,
+		arg.CursorDate,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -603,7 +694,18 @@ SELECT
     event_image_path,
     user_image_path
 FROM event_with_tags_view
-WHERE date > NOW() AND is_private = false
+WHERE date > NOW() AND is_private = false AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY participants_count DESC, created_at DESC
     LIMIT 6
 `
@@ -687,7 +789,18 @@ SELECT
     user_image_path
 FROM event_with_tags_view
 WHERE is_premium = TRUE
-  AND date > NOW() AND is_private = false
+  AND date > NOW() AND is_private = false AND is_hidden = false
+  AND (
+    event_with_tags_view.series_id IS NULL
+        OR event_with_tags_view.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = event_with_tags_view.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY created_at DESC
     LIMIT 6
 `
@@ -774,10 +887,24 @@ FROM event_with_tags_view e
 WHERE
     eu.user_id = $1
   AND e.date > NOW()
+  AND (
+    NOT $2::boolean
+        OR (e.date, e.id) > ($3::timestamptz, $4::int)
+    )
 ORDER BY
-    e.date ASC
+    e.date ASC,
+    e.id ASC
+LIMIT $5::int
 `
 
+type GetUpcomingUserEventsParams struct {
+	UserID     int32     `json:"user_id"`
+	HasCursor  bool      `json:"has_cursor"`
+	CursorDate time.Time `json:"cursor_date"`
+	CursorID   int32     `json:"cursor_id"`
+	PageLimit  int32     `json:"page_limit"`
+}
+
 type GetUpcomingUserEventsRow struct {
 	ID                int32          `json:"id"`
 	Name              string         `json:"name"`
@@ -798,8 +925,14 @@ type GetUpcomingUserEventsRow struct {
 	UserImagePath     pgtype.Text    `json:"user_image_path"`
 }
 
-func (q *Queries) GetUpcomingUserEvents(ctx context.Context, userID int32) ([]GetUpcomingUserEventsRow, error) {
-	rows, err := q.db.Query(ctx, getUpcomingUserEvents, userID)
+func (q *Queries) GetUpcomingUserEvents(ctx context.Context, arg GetUpcomingUserEventsParams) ([]GetUpcomingUserEventsRow, error) {
+	rows, err := q.db.Query(ctx, getUpcomingUserEvents,
+		arg.UserID,
+		arg.HasCursor,
+		arg.CursorDate,
+		arg.CursorID,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -881,6 +1014,18 @@ WHERE
         100000
       )
   AND evt.is_private = false
+  AND evt.is_hidden = false
+  AND (
+    evt.series_id IS NULL
+        OR evt.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = evt.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
+    )
 ORDER BY
     matched_tags DESC,
     created_at DESC,
@@ -956,88 +1101,390 @@ func (q *Queries) GetUserRecommendedEvents(ctx context.Context, arg GetUserRecom
 	return items, nil
 }
 
-const listEvents = `-- name: ListEvents :many
+const listEventClusters = `-- name: ListEventClusters :many
+WITH filtered AS (
+    SELECT
+        evt.id,
+        evt.date,
+        evt.geom::geometry AS point
+    FROM event_with_tags_view evt
+    WHERE
+        evt.geom && ST_MakeEnvelope(
+                $1::float8,
+                $2::float8,
+                $3::float8,
+                $4::float8,
+                4326
+        )::GEOGRAPHY
+      AND evt.is_private = false
+      AND evt.is_hidden = false
+      AND evt.date > NOW()
+      AND (
+        evt.series_id IS NULL
+            OR evt.id = (
+            SELECT s.id
+            FROM events s
+            WHERE s.series_id = evt.series_id
+              AND s.date > NOW()
+            ORDER BY s.date, s.id
+            LIMIT 1
+        )
+        )
+      AND (
+        $5::text = ''
+            OR evt.search_vector @@ websearch_to_tsquery('russian', $5)
+            OR $5 <% evt.name
+            OR $5 <% evt.description
+        )
+      AND (
+        cardinality($6::int[]) = 0
+            OR EXISTS (
+            SELECT 1
+            FROM event_tags et
+            WHERE
+                et.event_id = evt.id
+              AND et.tag_id = ANY($6::int[])
+        )
+        )
+      AND (
+        $7::text = ''
+            OR CASE
+                WHEN $7 = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
+                WHEN $7 = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
+                WHEN $7 = 'month' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 month'
+                ELSE TRUE
+            END
+        )
+)
+SELECT
+    COUNT(*) AS count,
+    ST_Y(ST_Centroid(ST_Collect(point)))::float8 AS latitude,
+    ST_X(ST_Centroid(ST_Collect(point)))::float8 AS longitude,
+    (ARRAY_AGG(id ORDER BY date, id))[1:$8::int]::int[] AS event_ids
+FROM filtered
+GROUP BY ST_SnapToGrid(point, $9::float8)
+ORDER BY count DESC
+`
+
+type ListEventClustersParams struct {
+	MinLon     float64 `json:"min_lon"`
+	MinLat     float64 `json:"min_lat"`
+	MaxLon     float64 `json:"max_lon"`
+	MaxLat     float64 `json:"max_lat"`
+	SearchTerm string  `json:"search_term"`
+	TagIds     []int32 `json:"tag_ids"`
+	DateRange  string  `json:"date_range"`
+	SampleSize int32   `json:"sample_size"`
+	CellSize   float64 `json:"cell_size"`
+}
+
+type ListEventClustersRow struct {
+	Count     int64   `json:"count"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	EventIds  []int32 `json:"event_ids"`
+}
+
+func (q *Queries) ListEventClusters(ctx context.Context, arg ListEventClustersParams) ([]ListEventClustersRow, error) {
+	rows, err := q.db.Query(ctx, listEventClusters,
+		arg.MinLon,
+		arg.MinLat,
+		arg.MaxLon,
+		arg.MaxLat,
+		arg.SearchTerm,
+		arg.TagIds,
+		arg.DateRange,
+		arg.SampleSize,
+		arg.CellSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEventClustersRow{}
+	for rows.Next() {
+		var i ListEventClustersRow
+		if err := rows.Scan(
+			&i.Count,
+			&i.Latitude,
+			&i.Longitude,
+			&i.EventIds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventMarkers = `-- name: ListEventMarkers :many
 SELECT
     evt.id,
     evt.name,
-    evt.description,
-    evt.capacity,
     evt.latitude,
     evt.longitude,
-    evt.address,
     evt.date,
-    evt.owner_id,
-    evt.owner_username,
-    evt.is_private,
-    evt.is_premium,
-    evt.created_at,
-    evt.tags,
-    evt.participants_count,
-    evt.event_image_path,
-    evt.user_image_path,
-    (
-        SELECT COUNT(*)
-        FROM event_tags et
-        WHERE
-            et.event_id = evt.id
-          AND et.tag_id = ANY($1::int[])
-    ) AS matched_tags,
-    ST_Distance(
-            evt.geom,
-            ST_MakePoint($2::numeric, $3::numeric)::GEOGRAPHY
-    ) AS distance
+    evt.is_premium
 FROM event_with_tags_view evt
 WHERE
-    ST_DWithin(
-            evt.geom,
-            ST_MakePoint($2::numeric, $3::numeric)::GEOGRAPHY,
-            100000
-    )
+    evt.geom && ST_MakeEnvelope(
+            $1::float8,
+            $2::float8,
+            $3::float8,
+            $4::float8,
+            4326
+    )::GEOGRAPHY
   AND evt.is_private = false
+  AND evt.is_hidden = false
   AND evt.date > NOW()
   AND (
-    $4::text = ''
-        OR (
-            evt.name ILIKE '%' || $4 || '%'
-            OR evt.description ILIKE '%' || $4 || '%'
-        )
+    evt.series_id IS NULL
+        OR evt.id = (
+        SELECT s.id
+        FROM events s
+        WHERE s.series_id = evt.series_id
+          AND s.date > NOW()
+        ORDER BY s.date, s.id
+        LIMIT 1
+    )
     )
   AND (
-    cardinality($1::int[]) = 0
+    cardinality($5::int[]) = 0
         OR EXISTS (
         SELECT 1
         FROM event_tags et
         WHERE
             et.event_id = evt.id
-          AND et.tag_id = ANY($1::int[])
+          AND et.tag_id = ANY($5::int[])
     )
     )
   AND (
-    $5::text IS NULL
-        OR $5::text = ''
+    $6::text = ''
         OR CASE
-            WHEN $5 = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
-            WHEN $5 = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
-            WHEN $5 = 'month' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 month'
+            WHEN $6 = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
+            WHEN $6 = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
+            WHEN $6 = 'month' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 month'
             ELSE TRUE
         END
     )
 ORDER BY
-    CASE WHEN $4::text <> '' THEN
-             SIMILARITY(evt.name, $4) +
-             SIMILARITY(evt.description, $4)
-         ELSE 0 END DESC,
+    evt.is_premium DESC,
+    evt.date,
+    evt.id
+LIMIT $7::int
+`
+
+type ListEventMarkersParams struct {
+	MinLon      float64 `json:"min_lon"`
+	MinLat      float64 `json:"min_lat"`
+	MaxLon      float64 `json:"max_lon"`
+	MaxLat      float64 `json:"max_lat"`
+	TagIds      []int32 `json:"tag_ids"`
+	DateRange   string  `json:"date_range"`
+	MarkerLimit int32   `json:"marker_limit"`
+}
+
+type ListEventMarkersRow struct {
+	ID        int32          `json:"id"`
+	Name      string         `json:"name"`
+	Latitude  pgtype.Numeric `json:"latitude"`
+	Longitude pgtype.Numeric `json:"longitude"`
+	Date      time.Time      `json:"date"`
+	IsPremium bool           `json:"is_premium"`
+}
+
+func (q *Queries) ListEventMarkers(ctx context.Context, arg ListEventMarkersParams) ([]ListEventMarkersRow, error) {
+	rows, err := q.db.Query(ctx, listEventMarkers,
+		arg.MinLon,
+		arg.MinLat,
+		arg.MaxLon,
+		arg.MaxLat,
+		arg.TagIds,
+		arg.DateRange,
+		arg.MarkerLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEventMarkersRow{}
+	for rows.Next() {
+		var i ListEventMarkersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Date,
+			&i.IsPremium,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEvents = `-- name: ListEvents :many
+WITH ranked AS (
+    SELECT
+        evt.id,
+        evt.name,
+        evt.description,
+        evt.capacity,
+        evt.latitude,
+        evt.longitude,
+        evt.address,
+        evt.date,
+        evt.owner_id,
+        evt.owner_username,
+        evt.is_private,
+        evt.is_premium,
+        evt.created_at,
+        evt.tags,
+        evt.participants_count,
+        evt.event_image_path,
+        evt.user_image_path,
+        (
+            SELECT COUNT(*)
+            FROM event_tags et
+            WHERE
+                et.event_id = evt.id
+              AND et.tag_id = ANY($1::int[])
+        ) AS matched_tags,
+        ST_Distance(
+                evt.geom,
+                ST_MakePoint($2::numeric, $3::numeric)::GEOGRAPHY
+        )::float8 AS distance,
+        (CASE WHEN $4::text <> '' THEN
+                  ts_rank(evt.search_vector, websearch_to_tsquery('russian', $4)) +
+                  SIMILARITY(evt.name, $4) +
+                  SIMILARITY(evt.description, $4)
+              ELSE 0 END)::float8 AS relevance
+    FROM event_with_tags_view evt
+    WHERE
+        ST_DWithin(
+                evt.geom,
+                ST_MakePoint($2::numeric, $3::numeric)::GEOGRAPHY,
+                $5::float8
+        )
+      AND evt.is_private = false
+      AND evt.is_hidden = false
+      AND evt.date > NOW()
+      AND (
+        evt.series_id IS NULL
+            OR evt.id = (
+            SELECT s.id
+            FROM events s
+            WHERE s.series_id = evt.series_id
+              AND s.date > NOW()
+            ORDER BY s.date, s.id
+            LIMIT 1
+        )
+        )
+      AND (
+        $4::text = ''
+            OR evt.search_vector @@ websearch_to_tsquery('russian', $4)
+            OR $4 <% evt.name
+            OR $4 <% evt.description
+        )
+      AND (
+        cardinality($1::int[]) = 0
+            OR EXISTS (
+            SELECT 1
+            FROM event_tags et
+            WHERE
+                et.event_id = evt.id
+              AND et.tag_id = ANY($1::int[])
+        )
+        )
+      AND (
+        $6::text IS NULL
+            OR $6::text = ''
+            OR CASE
+                WHEN $6 = 'day' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 day'
+                WHEN $6 = 'week' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '7 days'
+                WHEN $6 = 'month' THEN evt.date BETWEEN NOW() AND NOW() + INTERVAL '1 month'
+                ELSE TRUE
+            END
+        )
+)
+SELECT
+    id,
+    name,
+    description,
+    capacity,
+    latitude,
+    longitude,
+    address,
+    date,
+    owner_id,
+    owner_username,
+    is_private,
+    is_premium,
+    created_at,
+    tags,
+    participants_count,
+    event_image_path,
+    user_image_path,
+    matched_tags,
+    distance,
+    relevance,
+    (CASE WHEN $4 <> '' THEN
+              ts_headline('russian', name, websearch_to_tsquery('russian', $4),
+                          'HighlightAll=true, StartSel=' || chr(2) || ', StopSel=' || chr(3))
+          ELSE '' END)::text AS name_highlight,
+    (CASE WHEN $4 <> '' THEN
+              ts_headline('russian', description, websearch_to_tsquery('russian', $4),
+                          'MaxWords=30, MinWords=10, MaxFragments=2, StartSel=' || chr(2) || ', StopSel=' || chr(3))
+          ELSE '' END)::text AS snippet
+FROM ranked
+WHERE
+    NOT $7::boolean
+   OR relevance < $8::float8
+   OR (relevance = $8::float8
+    AND matched_tags < $9::bigint)
+   OR (relevance = $8::float8
+    AND matched_tags = $9::bigint
+    AND created_at < $10::timestamptz)
+   OR (relevance = $8::float8
+    AND matched_tags = $9::bigint
+    AND created_at = $10::timestamptz
+    AND distance > $11::float8)
+   OR (relevance = $8::float8
+    AND matched_tags = $9::bigint
+    AND created_at = $10::timestamptz
+    AND distance = $11::float8
+    AND id > $12::int)
+ORDER BY
+    relevance DESC,
     matched_tags DESC,
-    evt.created_at DESC,
-    distance ASC
+    created_at DESC,
+    distance ASC,
+    id ASC
+LIMIT $13::int
 `
 
 type ListEventsParams struct {
-	TagIds     []int32        `json:"tag_ids"`
-	UserLon    pgtype.Numeric `json:"user_lon"`
-	UserLat    pgtype.Numeric `json:"user_lat"`
-	SearchTerm string         `json:"search_term"`
-	DateRange  string         `json:"date_range"`
+	TagIds            []int32        `json:"tag_ids"`
+	UserLon           pgtype.Numeric `json:"user_lon"`
+	UserLat           pgtype.Numeric `json:"user_lat"`
+	SearchTerm        string         `json:"search_term"`
+	Radius            float64        `json:"radius"`
+	DateRange         string         `json:"date_range"`
+	HasCursor         bool           `json:"has_cursor"`
+	CursorRelevance   float64        `json:"cursor_relevance"`
+	CursorMatchedTags int64          `json:"cursor_matched_tags"`
+	CursorCreatedAt   time.Time      `json:"cursor_created_at"`
+	CursorDistance    float64        `json:"cursor_distance"`
+	CursorID          int32          `json:"cursor_id"`
+	PageLimit         int32          `json:"page_limit"`
 }
 
 type ListEventsRow struct {
@@ -1059,7 +1506,10 @@ type ListEventsRow struct {
 	EventImagePath    pgtype.Text    `json:"event_image_path"`
 	UserImagePath     pgtype.Text    `json:"user_image_path"`
 	MatchedTags       int64          `json:"matched_tags"`
-	Distance          interface{}    `json:"distance"`
+	Distance          float64        `json:"distance"`
+	Relevance         float64        `json:"relevance"`
+	NameHighlight     string         `json:"name_highlight"`
+	Snippet           string         `json:"snippet"`
 }
 
 func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListEventsRow, error) {
@@ -1068,7 +1518,15 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListE
 		arg.UserLon,
 		arg.UserLat,
 		arg.SearchTerm,
+		arg.Radius,
 		arg.DateRange,
+		arg.HasCursor,
+		arg.CursorRelevance,
+		arg.CursorMatchedTags,
+		arg.CursorCreatedAt,
+		arg.CursorDistance,
+		arg.CursorID,
+		arg.PageLimit,
 	)
 	if err != nil {
 		return nil, err
@@ -1097,6 +1555,9 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListE
 			&i.UserImagePath,
 			&i.MatchedTags,
 			&i.Distance,
+			&i.Relevance,
+			&i.NameHighlight,
+			&i.Snippet,
 		); err != nil {
 			return nil, err
 		}
@@ -1108,6 +1569,22 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListE
 	return items, nil
 }
 
+const setEventHidden = `-- name: SetEventHidden :exec
+UPDATE events
+SET is_hidden = $2
+WHERE id = $1
+`
+
+type SetEventHiddenParams struct {
+	ID       int32 `json:"id"`
+	IsHidden bool  `json:"is_hidden"`
+}
+
+func (q *Queries) SetEventHidden(ctx context.Context, arg SetEventHiddenParams) error {
+	_, err := q.db.Exec(ctx, setEventHidden, arg.ID, arg.IsHidden)
+	return err
+}
+
 const updateEvent = `-- name: UpdateEvent :exec
 UPDATE events
 SET
@@ -1151,3 +1628,28 @@ func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) error
 	)
 	return err
 }
+
+const updateEventPremium = `-- name: UpdateEventPremium :execrows
+UPDATE events e
+SET premium_manual = $2,
+    is_premium = $2 OR EXISTS (
+        SELECT 1
+        FROM promotions p
+        WHERE p.event_id = e.id
+          AND p.status = 'active'
+    )
+WHERE e.id = $1
+`
+
+type UpdateEventPremiumParams struct {
+	ID            int32 `json:"id"`
+	PremiumManual bool  `json:"premium_manual"`
+}
+
+func (q *Queries) UpdateEventPremium(ctx context.Context, arg UpdateEventPremiumParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateEventPremium, arg.ID, arg.PremiumManual)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
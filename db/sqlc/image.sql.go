@@ -9,8 +9,22 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countImageReferences = `-- name: CountImageReferences :one
+SELECT
+    (SELECT COUNT(*) FROM events WHERE events.image_id = $1) +
+    (SELECT COUNT(*) FROM users WHERE users.image_id = $1) AS references_count
+`
+
+func (q *Queries) CountImageReferences(ctx context.Context, id pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countImageReferences, id)
+	var references_count int64
+	err := row.Scan(&references_count)
+	return references_count, err
+}
+
 const createImage = `-- name: CreateImage :one
 INSERT INTO images (
     id,
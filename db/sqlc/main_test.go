@@ -1,8 +1,8 @@
 package db
 
 import (
-	"database/sql"
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"context"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"log"
 	"os"
 	"testing"
@@ -10,14 +10,14 @@ import (
 )
 
 var testQueries *Queries
-var testDB *sql.DB
+var testDB *pgxpool.Pool
 
 func TestMain(m *testing.M) {
 	config, err := util.LoadConfig("../..")
 	if err != nil {
 		log.Fatal("cannot load config", err)
 	}
-	testDB, err = sql.Open(config.DBDriver, config.DBSource)
+	testDB, err = pgxpool.New(context.Background(), config.DBSource)
 	if err != nil {
 		log.Fatal("cannot connect to db")
 	}
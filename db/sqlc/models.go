@@ -11,21 +11,56 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type CalendarFeed struct {
+	UserID    int32     `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Comment struct {
+	ID        int32              `json:"id"`
+	EventID   int32              `json:"event_id"`
+	UserID    int32              `json:"user_id"`
+	ParentID  pgtype.Int4        `json:"parent_id"`
+	Body      string             `json:"body"`
+	IsPinned  bool               `json:"is_pinned"`
+	EditedAt  pgtype.Timestamptz `json:"edited_at"`
+	DeletedAt pgtype.Timestamptz `json:"deleted_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
 type Event struct {
-	ID          int32          `json:"id"`
-	Name        string         `json:"name"`
-	Description string         `json:"description"`
-	Capacity    int32          `json:"capacity"`
-	Latitude    pgtype.Numeric `json:"latitude"`
-	Longitude   pgtype.Numeric `json:"longitude"`
-	Address     string         `json:"address"`
-	Date        time.Time      `json:"date"`
-	OwnerID     int32          `json:"owner_id"`
-	IsPrivate   bool           `json:"is_private"`
-	IsPremium   bool           `json:"is_premium"`
-	CreatedAt   time.Time      `json:"created_at"`
-	Geom        interface{}    `json:"geom"`
-	ImageID     pgtype.UUID    `json:"image_id"`
+	ID            int32          `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Capacity      int32          `json:"capacity"`
+	Latitude      pgtype.Numeric `json:"latitude"`
+	Longitude     pgtype.Numeric `json:"longitude"`
+	Address       string         `json:"address"`
+	Date          time.Time      `json:"date"`
+	OwnerID       int32          `json:"owner_id"`
+	IsPrivate     bool           `json:"is_private"`
+	IsPremium     bool           `json:"is_premium"`
+	CreatedAt     time.Time      `json:"created_at"`
+	Geom          interface{}    `json:"geom"`
+	ImageID       pgtype.UUID    `json:"image_id"`
+	SeriesID      pgtype.Int4    `json:"series_id"`
+	SearchVector  interface{}    `json:"search_vector"`
+	PremiumManual bool           `json:"premium_manual"`
+	IsHidden      bool           `json:"is_hidden"`
+}
+
+type EventBan struct {
+	EventID   int32     `json:"event_id"`
+	UserID    int32     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type EventSeries struct {
+	ID        int32     `json:"id"`
+	OwnerID   int32     `json:"owner_id"`
+	Rrule     string    `json:"rrule"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type EventTag struct {
@@ -41,8 +76,15 @@ type EventToken struct {
 }
 
 type EventUser struct {
-	UserID  int32 `json:"user_id"`
-	EventID int32 `json:"event_id"`
+	UserID   int32     `json:"user_id"`
+	EventID  int32     `json:"event_id"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type EventWaitlist struct {
+	EventID   int32     `json:"event_id"`
+	UserID    int32     `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type EventWithTagsView struct {
@@ -65,6 +107,9 @@ type EventWithTagsView struct {
 	EventImagePath    pgtype.Text    `json:"event_image_path"`
 	UserImagePath     pgtype.Text    `json:"user_image_path"`
 	ImageID           pgtype.UUID    `json:"image_id"`
+	SeriesID          pgtype.Int4    `json:"series_id"`
+	SearchVector      interface{}    `json:"search_vector"`
+	IsHidden          bool           `json:"is_hidden"`
 }
 
 type Image struct {
@@ -72,13 +117,103 @@ type Image struct {
 	Path string    `json:"path"`
 }
 
-type Session struct {
-	Uuid         uuid.UUID `json:"uuid"`
+type Notification struct {
+	ID        int32              `json:"id"`
+	UserID    int32              `json:"user_id"`
+	Type      string             `json:"type"`
+	EventID   pgtype.Int4        `json:"event_id"`
+	EventName string             `json:"event_name"`
+	ActorID   pgtype.Int4        `json:"actor_id"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+type Outbox struct {
+	ID            int64              `json:"id"`
+	EventType     string             `json:"event_type"`
+	Payload       []byte             `json:"payload"`
+	Attempts      int32              `json:"attempts"`
+	NextAttemptAt time.Time          `json:"next_attempt_at"`
+	LastError     string             `json:"last_error"`
+	CreatedAt     time.Time          `json:"created_at"`
+	PublishedAt   pgtype.Timestamptz `json:"published_at"`
+}
+
+type Promotion struct {
+	ID          int32              `json:"id"`
+	EventID     int32              `json:"event_id"`
+	RequestedBy int32              `json:"requested_by"`
+	Status      string             `json:"status"`
+	StartsAt    time.Time          `json:"starts_at"`
+	EndsAt      time.Time          `json:"ends_at"`
+	ReviewedBy  pgtype.Int4        `json:"reviewed_by"`
+	ReviewedAt  pgtype.Timestamptz `json:"reviewed_at"`
+	ActivatedAt pgtype.Timestamptz `json:"activated_at"`
+	FinishedAt  pgtype.Timestamptz `json:"finished_at"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type PushSubscription struct {
+	ID        int32     `json:"id"`
+	UserID    int32     `json:"user_id"`
+	Endpoint  string    `json:"endpoint"`
+	P256dh    string    `json:"p256dh"`
+	Auth      string    `json:"auth"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReminderDelivery struct {
+	EventID       int32     `json:"event_id"`
+	UserID        int32     `json:"user_id"`
+	Kind          string    `json:"kind"`
+	Channel       string    `json:"channel"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_at"`
+	Attempts      int32     `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+}
+
+type ReminderPreference struct {
 	UserID       int32     `json:"user_id"`
-	RefreshToken string    `json:"refresh_token"`
-	ExpiresAt    time.Time `json:"expires_at"`
-	IsBlocked    bool      `json:"is_blocked"`
-	CreatedAt    time.Time `json:"created_at"`
+	EmailEnabled bool      `json:"email_enabled"`
+	PushEnabled  bool      `json:"push_enabled"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type Report struct {
+	ID         int32              `json:"id"`
+	ReporterID int32              `json:"reporter_id"`
+	EventID    pgtype.Int4        `json:"event_id"`
+	UserID     pgtype.Int4        `json:"user_id"`
+	Reason     string             `json:"reason"`
+	Comment    string             `json:"comment"`
+	Status     string             `json:"status"`
+	ResolvedBy pgtype.Int4        `json:"resolved_by"`
+	ResolvedAt pgtype.Timestamptz `json:"resolved_at"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+type Review struct {
+	EventID   int32     `json:"event_id"`
+	UserID    int32     `json:"user_id"`
+	Rating    int16     `json:"rating"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Session struct {
+	Uuid         uuid.UUID          `json:"uuid"`
+	UserID       int32              `json:"user_id"`
+	RefreshToken string             `json:"refresh_token"`
+	ExpiresAt    time.Time          `json:"expires_at"`
+	IsBlocked    bool               `json:"is_blocked"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UserAgent    string             `json:"user_agent"`
+	ClientIp     string             `json:"client_ip"`
+	LastUsedAt   time.Time          `json:"last_used_at"`
+	FamilyID     uuid.UUID          `json:"family_id"`
+	RotatedAt    pgtype.Timestamptz `json:"rotated_at"`
 }
 
 type Tag struct {
@@ -87,13 +222,27 @@ type Tag struct {
 }
 
 type User struct {
-	ID           int32       `json:"id"`
-	Username     string      `json:"username"`
-	Email        string      `json:"email"`
-	PasswordHash string      `json:"password_hash"`
-	CreatedAt    time.Time   `json:"created_at"`
-	IsAdmin      bool        `json:"is_admin"`
-	ImageID      pgtype.UUID `json:"image_id"`
+	ID            int32       `json:"id"`
+	Username      string      `json:"username"`
+	Email         string      `json:"email"`
+	PasswordHash  string      `json:"password_hash"`
+	CreatedAt     time.Time   `json:"created_at"`
+	ImageID       pgtype.UUID `json:"image_id"`
+	EmailVerified bool        `json:"email_verified"`
+	Role          string      `json:"role"`
+	IsBlocked     bool        `json:"is_blocked"`
+}
+
+type UserFollow struct {
+	FollowerID int32     `json:"follower_id"`
+	FolloweeID int32     `json:"followee_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type UserPrivacy struct {
+	UserID         int32     `json:"user_id"`
+	HideAttendance bool      `json:"hide_attendance"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
 
 type UserTag struct {
@@ -101,11 +250,48 @@ type UserTag struct {
 	TagID  int32 `json:"tag_id"`
 }
 
+type UserToken struct {
+	TokenHash string             `json:"token_hash"`
+	UserID    int32              `json:"user_id"`
+	Purpose   string             `json:"purpose"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
 type UserWithTagsView struct {
-	ID        int32       `json:"id"`
-	Username  string      `json:"username"`
-	Email     string      `json:"email"`
-	CreatedAt time.Time   `json:"created_at"`
-	Tags      []Tag       `json:"tags"`
-	ImagePath pgtype.Text `json:"image_path"`
+	ID            int32       `json:"id"`
+	Username      string      `json:"username"`
+	Email         string      `json:"email"`
+	CreatedAt     time.Time   `json:"created_at"`
+	Tags          []Tag       `json:"tags"`
+	ImagePath     pgtype.Text `json:"image_path"`
+	EmailVerified bool        `json:"email_verified"`
+}
+
+type Webhook struct {
+	ID                  int32              `json:"id"`
+	OwnerID             int32              `json:"owner_id"`
+	Url                 string             `json:"url"`
+	Secret              string             `json:"secret"`
+	EventTypes          []string           `json:"event_types"`
+	IsActive            bool               `json:"is_active"`
+	ConsecutiveFailures int32              `json:"consecutive_failures"`
+	DisabledAt          pgtype.Timestamptz `json:"disabled_at"`
+	CreatedAt           time.Time          `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             int64              `json:"id"`
+	WebhookID      int32              `json:"webhook_id"`
+	OutboxID       pgtype.Int8        `json:"outbox_id"`
+	EventType      string             `json:"event_type"`
+	Payload        []byte             `json:"payload"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  time.Time          `json:"next_attempt_at"`
+	ResponseStatus pgtype.Int4        `json:"response_status"`
+	LastError      string             `json:"last_error"`
+	CreatedAt      time.Time          `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
 }
@@ -6,49 +6,164 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	ActivatePromotions(ctx context.Context) ([]int32, error)
+	AddEventParticipant(ctx context.Context, arg AddEventParticipantParams) error
 	AddEventTag(ctx context.Context, arg AddEventTagParams) (EventTag, error)
 	AddUserTags(ctx context.Context, arg AddUserTagsParams) error
+	BanEventUser(ctx context.Context, arg BanEventUserParams) error
+	BlockOtherUserSessions(ctx context.Context, arg BlockOtherUserSessionsParams) error
+	BlockSession(ctx context.Context, arg BlockSessionParams) (int64, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error
+	BlockUserSessions(ctx context.Context, userID int32) error
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	ClaimOutboxMessages(ctx context.Context, arg ClaimOutboxMessagesParams) ([]ClaimOutboxMessagesRow, error)
+	ClaimReminderDelivery(ctx context.Context, arg ClaimReminderDeliveryParams) (int32, error)
+	ConsumeUserToken(ctx context.Context, arg ConsumeUserTokenParams) (int32, error)
+	CountEventParticipants(ctx context.Context, eventID int32) (int64, error)
+	CountImageReferences(ctx context.Context, id pgtype.UUID) (int64, error)
+	CountUnreadNotifications(ctx context.Context, userID int32) (int64, error)
+	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
 	CreateEvent(ctx context.Context, arg CreateEventParams) (CreateEventRow, error)
+	CreateEventSeries(ctx context.Context, arg CreateEventSeriesParams) (EventSeries, error)
 	CreateImage(ctx context.Context, arg CreateImageParams) (Image, error)
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) error
+	CreateOutboxMessage(ctx context.Context, arg CreateOutboxMessageParams) error
 	CreatePrivateEventToken(ctx context.Context, arg CreatePrivateEventTokenParams) error
+	CreatePromotion(ctx context.Context, arg CreatePromotionParams) (Promotion, error)
+	CreateReport(ctx context.Context, arg CreateReportParams) (Report, error)
+	CreateRotatedSession(ctx context.Context, arg CreateRotatedSessionParams) error
 	CreateSession(ctx context.Context, arg CreateSessionParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUserToken(ctx context.Context, arg CreateUserTokenParams) error
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
 	DeleteAllEventTags(ctx context.Context, eventID int32) error
+	DeleteCalendarFeed(ctx context.Context, userID int32) error
 	DeleteEvent(ctx context.Context, id int32) error
+	DeleteExpiredOutboxMessages(ctx context.Context, arg DeleteExpiredOutboxMessagesParams) (int64, error)
 	DeleteImage(ctx context.Context, id uuid.UUID) error
+	DeletePushSubscription(ctx context.Context, arg DeletePushSubscriptionParams) (int64, error)
+	DeletePushSubscriptionByEndpoint(ctx context.Context, endpoint string) error
+	DeleteReview(ctx context.Context, arg DeleteReviewParams) (int64, error)
 	DeleteUser(ctx context.Context, id int32) error
 	DeleteUserTags(ctx context.Context, userID int32) error
+	DeleteUserTokens(ctx context.Context, arg DeleteUserTokensParams) error
+	DeleteWebhook(ctx context.Context, arg DeleteWebhookParams) (int64, error)
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error)
+	FinishPromotions(ctx context.Context) ([]int32, error)
+	FollowUser(ctx context.Context, arg FollowUserParams) (int64, error)
 	GetAllUserTags(ctx context.Context, id int32) ([]Tag, error)
+	GetCalendarFeedUserID(ctx context.Context, tokenHash string) (int32, error)
+	GetComment(ctx context.Context, id int32) (Comment, error)
 	GetEvent(ctx context.Context, arg GetEventParams) (GetEventRow, error)
+	GetEventCapacityForUpdate(ctx context.Context, id int32) (int32, error)
+	GetEventRating(ctx context.Context, eventID int32) (GetEventRatingRow, error)
+	GetEventSummary(ctx context.Context, id int32) (GetEventSummaryRow, error)
+	GetFollowCounts(ctx context.Context, userID int32) (GetFollowCountsRow, error)
+	GetFollowingFeedEvents(ctx context.Context, arg GetFollowingFeedEventsParams) ([]GetFollowingFeedEventsRow, error)
 	GetGuestRecommendedEvents(ctx context.Context, arg GetGuestRecommendedEventsParams) ([]GetGuestRecommendedEventsRow, error)
 	GetImageByEventID(ctx context.Context, id int32) (Image, error)
 	GetImageByUserID(ctx context.Context, id int32) (Image, error)
 	GetLatestEvents(ctx context.Context) ([]GetLatestEventsRow, error)
-	GetOwnedUserEvents(ctx context.Context, userID int32) ([]GetOwnedUserEventsRow, error)
-	GetPastUserEvents(ctx context.Context, userID int32) ([]GetPastUserEventsRow, error)
+	GetOrganizerRating(ctx context.Context, ownerID int32) (GetOrganizerRatingRow, error)
+	GetOwnedUserEvents(ctx context.Context, arg GetOwnedUserEventsParams) ([]GetOwnedUserEventsRow, error)
+	GetParticipantJoinedAt(ctx context.Context, arg GetParticipantJoinedAtParams) (time.Time, error)
+	GetPastUserEvents(ctx context.Context, arg GetPastUserEventsParams) ([]GetPastUserEventsRow, error)
 	GetPopularEvents(ctx context.Context) ([]GetPopularEventsRow, error)
 	GetPremiumEvents(ctx context.Context) ([]GetPremiumEventsRow, error)
+	GetPrivacySettings(ctx context.Context, userID int32) (bool, error)
+	GetPublicProfile(ctx context.Context, userID int32) (GetPublicProfileRow, error)
+	GetReminderPreferences(ctx context.Context, userID int32) (GetReminderPreferencesRow, error)
 	GetSession(ctx context.Context, argUuid uuid.UUID) (Session, error)
 	GetTags(ctx context.Context) ([]Tag, error)
-	GetUpcomingUserEvents(ctx context.Context, userID int32) ([]GetUpcomingUserEventsRow, error)
+	GetUpcomingUserEvents(ctx context.Context, arg GetUpcomingUserEventsParams) ([]GetUpcomingUserEventsRow, error)
 	GetUser(ctx context.Context, id int32) (User, error)
+	GetUserAccess(ctx context.Context, id int32) (GetUserAccessRow, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserRecommendedEvents(ctx context.Context, arg GetUserRecommendedEventsParams) ([]GetUserRecommendedEventsRow, error)
 	GetUserWithTags(ctx context.Context, id int32) (UserWithTagsView, error)
+	GetWaitlistStatus(ctx context.Context, arg GetWaitlistStatusParams) (GetWaitlistStatusRow, error)
+	HasActiveUserToken(ctx context.Context, arg HasActiveUserTokenParams) (bool, error)
+	HasOverlappingPromotion(ctx context.Context, arg HasOverlappingPromotionParams) (bool, error)
+	HideReportedEvent(ctx context.Context, arg HideReportedEventParams) (int64, error)
+	IsBannedFromEvent(ctx context.Context, arg IsBannedFromEventParams) (bool, error)
+	IsFollowing(ctx context.Context, arg IsFollowingParams) (bool, error)
 	IsParticipant(ctx context.Context, arg IsParticipantParams) (bool, error)
+	JoinEventWaitlist(ctx context.Context, arg JoinEventWaitlistParams) error
+	LeaveEventWaitlist(ctx context.Context, arg LeaveEventWaitlistParams) error
+	ListAllEventParticipants(ctx context.Context, eventID int32) ([]ListAllEventParticipantsRow, error)
+	ListCommentReplies(ctx context.Context, parentIds []int32) ([]ListCommentRepliesRow, error)
+	ListDueReminders(ctx context.Context, arg ListDueRemindersParams) ([]ListDueRemindersRow, error)
+	ListEventClusters(ctx context.Context, arg ListEventClustersParams) ([]ListEventClustersRow, error)
+	ListEventComments(ctx context.Context, arg ListEventCommentsParams) ([]ListEventCommentsRow, error)
+	ListEventMarkers(ctx context.Context, arg ListEventMarkersParams) ([]ListEventMarkersRow, error)
+	ListEventParticipants(ctx context.Context, arg ListEventParticipantsParams) ([]ListEventParticipantsRow, error)
+	ListEventPromotions(ctx context.Context, eventID int32) ([]Promotion, error)
+	ListEventReviews(ctx context.Context, arg ListEventReviewsParams) ([]ListEventReviewsRow, error)
 	ListEvents(ctx context.Context, arg ListEventsParams) ([]ListEventsRow, error)
+	ListFollowedIDs(ctx context.Context, followerID int32) ([]int32, error)
+	ListFollowingSeriesEvents(ctx context.Context, id int32) ([]ListFollowingSeriesEventsRow, error)
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]ListNotificationsRow, error)
+	ListOrganizerRatings(ctx context.Context, ownerIds []int32) ([]ListOrganizerRatingsRow, error)
+	ListProfileAttendedEvents(ctx context.Context, arg ListProfileAttendedEventsParams) ([]ListProfileAttendedEventsRow, error)
+	ListProfileUpcomingEvents(ctx context.Context, arg ListProfileUpcomingEventsParams) ([]ListProfileUpcomingEventsRow, error)
+	ListPromotions(ctx context.Context, arg ListPromotionsParams) ([]ListPromotionsRow, error)
+	ListReports(ctx context.Context, arg ListReportsParams) ([]ListReportsRow, error)
+	ListUserPushSubscriptions(ctx context.Context, userID int32) ([]PushSubscription, error)
+	ListUserSessions(ctx context.Context, userID int32) ([]Session, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context, ownerID int32) ([]Webhook, error)
+	MarkAllNotificationsRead(ctx context.Context, userID int32) (int64, error)
+	MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error)
+	MarkOutboxMessagePublished(ctx context.Context, id int64) error
+	NotifyEventOwner(ctx context.Context, arg NotifyEventOwnerParams) error
+	NotifyEventParticipants(ctx context.Context, arg NotifyEventParticipantsParams) (int64, error)
+	NotifyStartingEvents(ctx context.Context) (int64, error)
+	PopEventWaitlist(ctx context.Context, eventID int32) (int32, error)
+	RecordOutboxMessageFailure(ctx context.Context, arg RecordOutboxMessageFailureParams) error
+	RecordReminderDeliveryFailure(ctx context.Context, arg RecordReminderDeliveryFailureParams) error
+	RecordWebhookDeliveryFailure(ctx context.Context, arg RecordWebhookDeliveryFailureParams) error
+	RecordWebhookDeliverySuccess(ctx context.Context, arg RecordWebhookDeliverySuccessParams) error
+	RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (bool, error)
+	RemoveEventParticipant(ctx context.Context, arg RemoveEventParticipantParams) (int64, error)
+	ReplayWebhookDelivery(ctx context.Context, arg ReplayWebhookDeliveryParams) (WebhookDelivery, error)
+	ResetWebhookFailures(ctx context.Context, id int32) error
+	ReviewPromotion(ctx context.Context, arg ReviewPromotionParams) (Promotion, error)
+	ReviewReports(ctx context.Context, arg ReviewReportsParams) ([]Report, error)
+	RotateSession(ctx context.Context, argUuid uuid.UUID) (int64, error)
+	SetCommentPinned(ctx context.Context, arg SetCommentPinnedParams) (Comment, error)
+	SetEventHidden(ctx context.Context, arg SetEventHiddenParams) error
+	SetReminderDeliveryStatus(ctx context.Context, arg SetReminderDeliveryStatusParams) error
+	SetUserBlocked(ctx context.Context, arg SetUserBlockedParams) (User, error)
+	SoftDeleteComment(ctx context.Context, id int32) error
 	SubscribeToEvent(ctx context.Context, arg SubscribeToEventParams) (pgtype.Bool, error)
-	UnsubscribeFromEvent(ctx context.Context, arg UnsubscribeFromEventParams) error
+	SuggestEvents(ctx context.Context, arg SuggestEventsParams) ([]SuggestEventsRow, error)
+	SuggestOrganizers(ctx context.Context, arg SuggestOrganizersParams) ([]SuggestOrganizersRow, error)
+	SuggestTags(ctx context.Context, arg SuggestTagsParams) ([]Tag, error)
+	SyncEventsPremium(ctx context.Context, eventIds []int32) error
+	UnbanEventUser(ctx context.Context, arg UnbanEventUserParams) (int64, error)
+	UnfollowUser(ctx context.Context, arg UnfollowUserParams) (int64, error)
+	UnsubscribeFromEvent(ctx context.Context, arg UnsubscribeFromEventParams) (int64, error)
+	UpdateCommentBody(ctx context.Context, arg UpdateCommentBodyParams) (Comment, error)
 	UpdateEvent(ctx context.Context, arg UpdateEventParams) error
-	UpdateSession(ctx context.Context, arg UpdateSessionParams) error
+	UpdateEventPremium(ctx context.Context, arg UpdateEventPremiumParams) (int64, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error)
+	UpsertCalendarFeed(ctx context.Context, arg UpsertCalendarFeedParams) error
+	UpsertPrivacySettings(ctx context.Context, arg UpsertPrivacySettingsParams) (UserPrivacy, error)
+	UpsertPushSubscription(ctx context.Context, arg UpsertPushSubscriptionParams) (PushSubscription, error)
+	UpsertReminderPreferences(ctx context.Context, arg UpsertReminderPreferencesParams) (ReminderPreference, error)
+	UpsertReview(ctx context.Context, arg UpsertReviewParams) (Review, error)
+	VerifyUserEmail(ctx context.Context, id int32) error
 }
 
 var _ Querier = (*Queries)(nil)
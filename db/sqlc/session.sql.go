@@ -12,16 +12,122 @@ import (
 	"github.com/google/uuid"
 )
 
+const blockOtherUserSessions = `-- name: BlockOtherUserSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE user_id = $1
+  AND family_id IS DISTINCT FROM (SELECT s.family_id FROM sessions s WHERE s.uuid = $2)
+`
+
+type BlockOtherUserSessionsParams struct {
+	UserID int32     `json:"user_id"`
+	Uuid   uuid.UUID `json:"uuid"`
+}
+
+func (q *Queries) BlockOtherUserSessions(ctx context.Context, arg BlockOtherUserSessionsParams) error {
+	_, err := q.db.Exec(ctx, blockOtherUserSessions, arg.UserID, arg.Uuid)
+	return err
+}
+
+const blockSession = `-- name: BlockSession :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = (SELECT s.family_id FROM sessions s WHERE s.uuid = $1)
+  AND user_id = $2
+`
+
+type BlockSessionParams struct {
+	Uuid   uuid.UUID `json:"uuid"`
+	UserID int32     `json:"user_id"`
+}
+
+func (q *Queries) BlockSession(ctx context.Context, arg BlockSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, blockSession, arg.Uuid, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const blockSessionFamily = `-- name: BlockSessionFamily :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = $1
+`
+
+func (q *Queries) BlockSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, blockSessionFamily, familyID)
+	return err
+}
+
+const blockUserSessions = `-- name: BlockUserSessions :exec
+UPDATE sessions
+SET is_blocked = true
+WHERE user_id = $1
+`
+
+func (q *Queries) BlockUserSessions(ctx context.Context, userID int32) error {
+	_, err := q.db.Exec(ctx, blockUserSessions, userID)
+	return err
+}
+
+const createRotatedSession = `-- name: CreateRotatedSession :exec
+INSERT INTO sessions (
+                      uuid,
+                      user_id,
+                      refresh_token,
+                      expires_at,
+                      user_agent,
+                      client_ip,
+                      family_id,
+                      created_at
+)
+SELECT $1,
+       user_id,
+       $2,
+       $3,
+       $4,
+       $5,
+       family_id,
+       created_at
+FROM sessions
+WHERE uuid = $6
+`
+
+type CreateRotatedSessionParams struct {
+	NewUuid      uuid.UUID `json:"new_uuid"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	UserAgent    string    `json:"user_agent"`
+	ClientIp     string    `json:"client_ip"`
+	OldUuid      uuid.UUID `json:"old_uuid"`
+}
+
+func (q *Queries) CreateRotatedSession(ctx context.Context, arg CreateRotatedSessionParams) error {
+	_, err := q.db.Exec(ctx, createRotatedSession,
+		arg.NewUuid,
+		arg.RefreshToken,
+		arg.ExpiresAt,
+		arg.UserAgent,
+		arg.ClientIp,
+		arg.OldUuid,
+	)
+	return err
+}
+
 const createSession = `-- name: CreateSession :exec
 INSERT INTO sessions (
                       uuid,
                       user_id,
                       refresh_token,
                       expires_at,
-                      is_blocked
+                      is_blocked,
+                      user_agent,
+                      client_ip,
+                      family_id
 ) VALUES (
-          $1, $2, $3, $4, $5
-         ) RETURNING uuid, user_id, refresh_token, expires_at, is_blocked, created_at
+          $1, $2, $3, $4, $5, $6, $7, $8
+         ) RETURNING uuid, user_id, refresh_token, expires_at, is_blocked, created_at, user_agent, client_ip, last_used_at, family_id, rotated_at
 `
 
 type CreateSessionParams struct {
@@ -30,6 +136,9 @@ type CreateSessionParams struct {
 	RefreshToken string    `json:"refresh_token"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	IsBlocked    bool      `json:"is_blocked"`
+	UserAgent    string    `json:"user_agent"`
+	ClientIp     string    `json:"client_ip"`
+	FamilyID     uuid.UUID `json:"family_id"`
 }
 
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
@@ -39,12 +148,15 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 		arg.RefreshToken,
 		arg.ExpiresAt,
 		arg.IsBlocked,
+		arg.UserAgent,
+		arg.ClientIp,
+		arg.FamilyID,
 	)
 	return err
 }
 
 const getSession = `-- name: GetSession :one
-SELECT uuid, user_id, refresh_token, expires_at, is_blocked, created_at FROM sessions
+SELECT uuid, user_id, refresh_token, expires_at, is_blocked, created_at, user_agent, client_ip, last_used_at, family_id, rotated_at FROM sessions
 WHERE uuid = $1 LIMIT 1
 `
 
@@ -58,30 +170,68 @@ func (q *Queries) GetSession(ctx context.Context, argUuid uuid.UUID) (Session, e
 		&i.ExpiresAt,
 		&i.IsBlocked,
 		&i.CreatedAt,
+		&i.UserAgent,
+		&i.ClientIp,
+		&i.LastUsedAt,
+		&i.FamilyID,
+		&i.RotatedAt,
 	)
 	return i, err
 }
 
-const updateSession = `-- name: UpdateSession :exec
-UPDATE sessions
-SET uuid = $1, refresh_token = $2, expires_at = $3
-WHERE uuid = $4
-RETURNING uuid, user_id, refresh_token, expires_at, is_blocked, created_at
+const listUserSessions = `-- name: ListUserSessions :many
+SELECT uuid, user_id, refresh_token, expires_at, is_blocked, created_at, user_agent, client_ip, last_used_at, family_id, rotated_at FROM sessions
+WHERE user_id = $1
+  AND is_blocked = false
+  AND rotated_at IS NULL
+  AND expires_at > NOW()
+ORDER BY last_used_at DESC
 `
 
-type UpdateSessionParams struct {
-	NewUuid      uuid.UUID `json:"new_uuid"`
-	RefreshToken string    `json:"refresh_token"`
-	ExpiresAt    time.Time `json:"expires_at"`
-	OldUuid      uuid.UUID `json:"old_uuid"`
+func (q *Queries) ListUserSessions(ctx context.Context, userID int32) ([]Session, error) {
+	rows, err := q.db.Query(ctx, listUserSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Session{}
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.Uuid,
+			&i.UserID,
+			&i.RefreshToken,
+			&i.ExpiresAt,
+			&i.IsBlocked,
+			&i.CreatedAt,
+			&i.UserAgent,
+			&i.ClientIp,
+			&i.LastUsedAt,
+			&i.FamilyID,
+			&i.RotatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) UpdateSession(ctx context.Context, arg UpdateSessionParams) error {
-	_, err := q.db.Exec(ctx, updateSession,
-		arg.NewUuid,
-		arg.RefreshToken,
-		arg.ExpiresAt,
-		arg.OldUuid,
-	)
-	return err
+const rotateSession = `-- name: RotateSession :execrows
+UPDATE sessions
+SET rotated_at = NOW()
+WHERE uuid = $1
+  AND rotated_at IS NULL
+  AND is_blocked = false
+`
+
+func (q *Queries) RotateSession(ctx context.Context, argUuid uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, rotateSession, argUuid)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
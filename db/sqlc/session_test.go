@@ -19,15 +19,25 @@ func createRandomSession(t *testing.T, userID int32, tokenMaker token.Maker) Ses
 		RefreshToken: refreshToken,
 		ExpiresAt:    payload.ExpiredAt,
 		IsBlocked:    false,
+		UserAgent:    "Mozilla/5.0",
+		ClientIp:     "127.0.0.1",
+		FamilyID:     payload.ID,
 	}
 
-	session, err := testQueries.CreateSession(context.Background(), arg)
+	err = testQueries.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+
+	session, err := testQueries.GetSession(context.Background(), arg.Uuid)
 	require.NoError(t, err)
 	require.NotEmpty(t, session)
 	require.Equal(t, session.UserID, arg.UserID)
 	require.Equal(t, session.RefreshToken, arg.RefreshToken)
 	require.WithinDuration(t, session.ExpiresAt, arg.ExpiresAt, time.Second)
 	require.Equal(t, session.IsBlocked, arg.IsBlocked)
+	require.Equal(t, session.UserAgent, arg.UserAgent)
+	require.Equal(t, session.ClientIp, arg.ClientIp)
+	require.Equal(t, session.FamilyID, arg.FamilyID)
+	require.False(t, session.RotatedAt.Valid)
 
 	return session
 }
@@ -56,7 +66,8 @@ func TestGetSession(t *testing.T) {
 	require.Equal(t, session1.IsBlocked, session2.IsBlocked)
 }
 
-func TestUpdateSession(t *testing.T) {
+func TestRotateSessionTx(t *testing.T) {
+	store := NewStore(testDB)
 	user := createRandomUser(t)
 	tokenMaker, err := token.NewPasetoMaker(util.RandomString(32))
 	require.NoError(t, err)
@@ -65,14 +76,19 @@ func TestUpdateSession(t *testing.T) {
 	newToken, payload, err := tokenMaker.CreateToken(user.ID, time.Hour)
 	require.NoError(t, err)
 
-	arg := UpdateSessionParams{
-		payload.ID,
-		newToken,
-		payload.ExpiredAt,
-		session1.Uuid,
+	arg := RotateSessionTxParams{
+		OldUuid:      session1.Uuid,
+		NewUuid:      payload.ID,
+		RefreshToken: newToken,
+		ExpiresAt:    payload.ExpiredAt,
+		UserAgent:    session1.UserAgent,
+		ClientIp:     session1.ClientIp,
 	}
 
-	session2, err := testQueries.UpdateSession(context.Background(), arg)
+	err = store.RotateSessionTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	session2, err := testQueries.GetSession(context.Background(), payload.ID)
 	require.NoError(t, err)
 	require.NotEmpty(t, session2)
 
@@ -80,5 +96,78 @@ func TestUpdateSession(t *testing.T) {
 	require.Equal(t, session2.UserID, payload.UserID)
 	require.Equal(t, session2.RefreshToken, newToken)
 	require.WithinDuration(t, session2.ExpiresAt, payload.ExpiredAt, time.Second)
-	require.Equal(t, session2.IsBlocked, session2.IsBlocked)
+	require.Equal(t, session1.FamilyID, session2.FamilyID)
+	require.WithinDuration(t, session1.CreatedAt, session2.CreatedAt, time.Second)
+	require.False(t, session2.RotatedAt.Valid)
+
+	rotated, err := testQueries.GetSession(context.Background(), session1.Uuid)
+	require.NoError(t, err)
+	require.True(t, rotated.RotatedAt.Valid)
+
+	err = store.RotateSessionTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrSessionRotated)
+
+	sessions, err := testQueries.ListUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session2.Uuid, sessions[0].Uuid)
+}
+
+func TestBlockSessionFamily(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+	tokenMaker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+	session1 := createRandomSession(t, user.ID, tokenMaker)
+	other := createRandomSession(t, user.ID, tokenMaker)
+
+	newToken, payload, err := tokenMaker.CreateToken(user.ID, time.Hour)
+	require.NoError(t, err)
+
+	err = store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		OldUuid:      session1.Uuid,
+		NewUuid:      payload.ID,
+		RefreshToken: newToken,
+		ExpiresAt:    payload.ExpiredAt,
+	})
+	require.NoError(t, err)
+
+	err = testQueries.BlockSessionFamily(context.Background(), session1.FamilyID)
+	require.NoError(t, err)
+
+	session2, err := testQueries.GetSession(context.Background(), payload.ID)
+	require.NoError(t, err)
+	require.True(t, session2.IsBlocked)
+
+	sessions, err := testQueries.ListUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, other.Uuid, sessions[0].Uuid)
+}
+
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	tokenMaker, err := token.NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+	session1 := createRandomSession(t, user.ID, tokenMaker)
+	session2 := createRandomSession(t, user.ID, tokenMaker)
+
+	rows, err := testQueries.BlockSession(context.Background(), BlockSessionParams{
+		Uuid:   session1.Uuid,
+		UserID: user.ID + 1,
+	})
+	require.NoError(t, err)
+	require.Zero(t, rows)
+
+	rows, err = testQueries.BlockSession(context.Background(), BlockSessionParams{
+		Uuid:   session1.Uuid,
+		UserID: user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rows)
+
+	sessions, err := testQueries.ListUserSessions(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session2.Uuid, sessions[0].Uuid)
 }
@@ -2,15 +2,29 @@ package db
 
 import (
 	"context"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Store interface {
 	Querier
 	CreateEventTx(ctx context.Context, eventParams CreateEventTxParams, imageParams CreateImageParams) (GetEventRow, error)
+	CreateEventSeriesTx(ctx context.Context, arg CreateEventSeriesTxParams, imageParams CreateImageParams) (GetEventRow, error)
 	UpdateEventTx(ctx context.Context, params UpdateEventTxParams) error
+	DeleteEventTx(ctx context.Context, eventID int32) error
+	SubscribeToEventTx(ctx context.Context, arg SubscribeToEventParams) (pgtype.Bool, error)
+	UnsubscribeFromEventTx(ctx context.Context, arg UnsubscribeFromEventParams) ([]int32, error)
+	JoinEventWaitlistTx(ctx context.Context, arg JoinEventWaitlistParams) ([]int32, error)
+	RemoveEventParticipantTx(ctx context.Context, arg RemoveEventParticipantTxParams) (RemoveEventParticipantTxResult, error)
 	UpdateUserTagsTx(ctx context.Context, params UpdateUserTagsTxParams) error
 	UpdateUserTx(ctx context.Context, params UpdateUserTxParams) (UserWithTagsView, error)
+	ResetPasswordTx(ctx context.Context, params ResetPasswordTxParams) (int32, error)
+	SetUserBlockedTx(ctx context.Context, params SetUserBlockedTxParams) (User, error)
+	SyncPromotionsTx(ctx context.Context) (SyncPromotionsTxResult, error)
+	CreatePromotionTx(ctx context.Context, arg CreatePromotionParams) (Promotion, error)
+	CreateReportTx(ctx context.Context, params CreateReportTxParams) (CreateReportTxResult, error)
+	ReviewReportTx(ctx context.Context, params ReviewReportTxParams) ([]Report, error)
+	RotateSessionTx(ctx context.Context, params RotateSessionTxParams) error
 }
 
 type SQLStore struct {
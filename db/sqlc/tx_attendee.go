@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"treffly/eventbus"
+)
+
+type RemoveEventParticipantTxParams struct {
+	EventID int32
+	UserID  int32
+	Ban     bool
+}
+
+type RemoveEventParticipantTxResult struct {
+	Removed  bool
+	Promoted []int32
+}
+
+// RemoveEventParticipantTx takes a participant off an event on the owner's
+// behalf and fills the seat from the waitlist. Unlike leaving, the user's
+// review is kept: organisers must not be able to drop critics this way. A ban
+// is recorded even for users who are not participants.
+func (store *SQLStore) RemoveEventParticipantTx(ctx context.Context, arg RemoveEventParticipantTxParams) (RemoveEventParticipantTxResult, error) {
+	var result RemoveEventParticipantTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		if arg.Ban {
+			err := q.BanEventUser(ctx, BanEventUserParams{
+				EventID: arg.EventID,
+				UserID:  arg.UserID,
+			})
+			if err != nil {
+				return fmt.Errorf("ban user error: %w", err)
+			}
+
+			err = q.LeaveEventWaitlist(ctx, LeaveEventWaitlistParams{
+				EventID: arg.EventID,
+				UserID:  arg.UserID,
+			})
+			if err != nil {
+				return fmt.Errorf("leave waitlist error: %w", err)
+			}
+		}
+
+		removed, err := q.RemoveEventParticipant(ctx, RemoveEventParticipantParams{
+			EventID: arg.EventID,
+			UserID:  arg.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("remove participant error: %w", err)
+		}
+		if removed == 0 {
+			return nil
+		}
+		result.Removed = true
+
+		err = enqueue(ctx, q, eventbus.ParticipantLeft{
+			EventID: arg.EventID,
+			UserID:  arg.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Promoted, err = promoteFromWaitlist(ctx, q, arg.EventID)
+		return err
+	})
+
+	if err != nil {
+		return RemoveEventParticipantTxResult{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return result, nil
+}
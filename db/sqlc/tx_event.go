@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"time"
+	"treffly/eventbus"
 )
 
 type CreateEventTxParams struct {
@@ -26,45 +27,19 @@ func (store *SQLStore) CreateEventTx(ctx context.Context, eventParams CreateEven
 	var result GetEventRow
 
 	err := store.execTx(ctx, func(q *Queries) error {
-		if imageParams.ID != uuid.Nil || imageParams.Path != "" {
-			_, err := q.CreateImage(ctx, imageParams)
-			if err != nil {
-				return fmt.Errorf("create image error: %w", err)
-			}
-		}
-		imageUUID := pgtype.UUID{
-			Bytes: imageParams.ID,
-			Valid: imageParams.ID != uuid.Nil,
-		}
-
-		event, err := q.CreateEvent(ctx, CreateEventParams{
-			Name:        eventParams.Name,
-			Description: eventParams.Description,
-			Capacity:    eventParams.Capacity,
-			Latitude:    eventParams.Latitude,
-			Longitude:   eventParams.Longitude,
-			Address:     eventParams.Address,
-			Date:        eventParams.Date,
-			OwnerID:     eventParams.OwnerID,
-			IsPrivate:   eventParams.IsPrivate,
-			ImageID:     imageUUID,
-		})
+		imageUUID, err := createEventImage(ctx, q, imageParams)
 		if err != nil {
-			return fmt.Errorf("create event error: %w", err)
+			return err
 		}
 
-		for _, tagID := range eventParams.Tags {
-			if _, err = q.AddEventTag(ctx, AddEventTagParams{
-				EventID: event.ID,
-				TagID:   tagID,
-			}); err != nil {
-				return fmt.Errorf("add tag %d error: %w", tagID, err)
-			}
+		eventID, err := createEventWithTags(ctx, q, eventParams, imageUUID, pgtype.Int4{})
+		if err != nil {
+			return err
 		}
 
 		arg := GetEventParams{
-			event.ID,
-			event.OwnerID,
+			eventID,
+			eventParams.OwnerID,
 			"",
 		}
 
@@ -83,20 +58,134 @@ func (store *SQLStore) CreateEventTx(ctx context.Context, eventParams CreateEven
 	return result, nil
 }
 
+type CreateEventSeriesTxParams struct {
+	Event CreateEventTxParams
+	Rrule string
+	Dates []time.Time
+}
+
+func (store *SQLStore) CreateEventSeriesTx(ctx context.Context, arg CreateEventSeriesTxParams, imageParams CreateImageParams) (GetEventRow, error) {
+	var result GetEventRow
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		imageUUID, err := createEventImage(ctx, q, imageParams)
+		if err != nil {
+			return err
+		}
+
+		series, err := q.CreateEventSeries(ctx, CreateEventSeriesParams{
+			OwnerID: arg.Event.OwnerID,
+			Rrule:   arg.Rrule,
+		})
+		if err != nil {
+			return fmt.Errorf("create series error: %w", err)
+		}
+
+		seriesID := pgtype.Int4{
+			Int32: series.ID,
+			Valid: true,
+		}
+
+		var firstID int32
+		for i, date := range arg.Dates {
+			occurrence := arg.Event
+			occurrence.Date = date
+
+			eventID, err := createEventWithTags(ctx, q, occurrence, imageUUID, seriesID)
+			if err != nil {
+				return fmt.Errorf("create occurrence %d error: %w", i, err)
+			}
+			if i == 0 {
+				firstID = eventID
+			}
+		}
+
+		result, err = q.GetEvent(ctx, GetEventParams{
+			ID:      firstID,
+			OwnerID: arg.Event.OwnerID,
+		})
+		if err != nil {
+			return fmt.Errorf("get event with tags error: %w", err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return GetEventRow{}, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return result, nil
+}
+
+func createEventImage(ctx context.Context, q *Queries, imageParams CreateImageParams) (pgtype.UUID, error) {
+	if imageParams.ID != uuid.Nil || imageParams.Path != "" {
+		_, err := q.CreateImage(ctx, imageParams)
+		if err != nil {
+			return pgtype.UUID{}, fmt.Errorf("create image error: %w", err)
+		}
+	}
+
+	return pgtype.UUID{
+		Bytes: imageParams.ID,
+		Valid: imageParams.ID != uuid.Nil,
+	}, nil
+}
+
+func createEventWithTags(ctx context.Context, q *Queries, eventParams CreateEventTxParams, imageID pgtype.UUID, seriesID pgtype.Int4) (int32, error) {
+	event, err := q.CreateEvent(ctx, CreateEventParams{
+		Name:        eventParams.Name,
+		Description: eventParams.Description,
+		Capacity:    eventParams.Capacity,
+		Latitude:    eventParams.Latitude,
+		Longitude:   eventParams.Longitude,
+		Address:     eventParams.Address,
+		Date:        eventParams.Date,
+		OwnerID:     eventParams.OwnerID,
+		IsPrivate:   eventParams.IsPrivate,
+		ImageID:     imageID,
+		SeriesID:    seriesID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create event error: %w", err)
+	}
+
+	for _, tagID := range eventParams.Tags {
+		if _, err = q.AddEventTag(ctx, AddEventTagParams{
+			EventID: event.ID,
+			TagID:   tagID,
+		}); err != nil {
+			return 0, fmt.Errorf("add tag %d error: %w", tagID, err)
+		}
+	}
+
+	err = enqueue(ctx, q, eventbus.EventCreated{
+		EventID:  event.ID,
+		OwnerID:  event.OwnerID,
+		SeriesID: seriesID.Int32,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return event.ID, nil
+}
+
 type UpdateEventTxParams struct {
-	EventID     int32
-	Name        string
-	Description string
-	Capacity    int32
-	Latitude    pgtype.Numeric
-	Longitude   pgtype.Numeric
-	Address     string
-	Date        time.Time
-	IsPrivate   bool
-	Tags        []int32
-	NewImageID  uuid.UUID
-	NewPath     string
-	OldImageID  uuid.UUID
+	EventID          int32
+	Name             string
+	Description      string
+	Capacity         int32
+	Latitude         pgtype.Numeric
+	Longitude        pgtype.Numeric
+	Address          string
+	Date             time.Time
+	IsPrivate        bool
+	Tags             []int32
+	NewImageID       uuid.UUID
+	NewPath          string
+	OldImageID       uuid.UUID
+	ApplyToFollowing bool
 }
 
 func (store *SQLStore) UpdateEventTx(ctx context.Context, arg UpdateEventTxParams) error {
@@ -121,34 +210,59 @@ func (store *SQLStore) UpdateEventTx(ctx context.Context, arg UpdateEventTxParam
 			}
 		}
 
-		err := q.UpdateEvent(ctx, UpdateEventParams{
-			ID:          arg.EventID,
-			Name:        arg.Name,
-			Description: arg.Description,
-			Capacity:    arg.Capacity,
-			Latitude:    arg.Latitude,
-			Longitude:   arg.Longitude,
-			Address:     arg.Address,
-			Date:        arg.Date,
-			IsPrivate:   arg.IsPrivate,
-			ImageID:     newImageUUID,
-		})
+		targets, err := eventsToUpdate(ctx, q, arg)
 		if err != nil {
-			return fmt.Errorf("update event error: %w", err)
+			return fmt.Errorf("list series events error: %w", err)
 		}
 
-		err = q.DeleteAllEventTags(ctx, arg.EventID)
-		if err != nil {
-			return fmt.Errorf("delete old tags error: %w", err)
-		}
+		for _, target := range targets {
+			err = q.UpdateEvent(ctx, UpdateEventParams{
+				ID:          target.ID,
+				Name:        arg.Name,
+				Description: arg.Description,
+				Capacity:    arg.Capacity,
+				Latitude:    arg.Latitude,
+				Longitude:   arg.Longitude,
+				Address:     arg.Address,
+				Date:        target.Date,
+				IsPrivate:   arg.IsPrivate,
+				ImageID:     newImageUUID,
+			})
+			if err != nil {
+				return fmt.Errorf("update event %d error: %w", target.ID, err)
+			}
 
-		for _, tagID := range arg.Tags {
-			_, err := q.AddEventTag(ctx, AddEventTagParams{
-				EventID: arg.EventID,
-				TagID:   tagID,
+			err = q.DeleteAllEventTags(ctx, target.ID)
+			if err != nil {
+				return fmt.Errorf("delete old tags error: %w", err)
+			}
+
+			for _, tagID := range arg.Tags {
+				_, err := q.AddEventTag(ctx, AddEventTagParams{
+					EventID: target.ID,
+					TagID:   tagID,
+				})
+				if err != nil {
+					return fmt.Errorf("add new tag %d error: %w", tagID, err)
+				}
+			}
+
+			_, err = q.NotifyEventParticipants(ctx, NotifyEventParticipantsParams{
+				Type:    "event_updated",
+				EventID: target.ID,
 			})
 			if err != nil {
-				return fmt.Errorf("add new tag %d error: %w", tagID, err)
+				return fmt.Errorf("notify participants error: %w", err)
+			}
+
+			_, err = promoteFromWaitlist(ctx, q, target.ID)
+			if err != nil {
+				return fmt.Errorf("promote from waitlist error: %w", err)
+			}
+
+			err = enqueue(ctx, q, eventbus.EventUpdated{EventID: target.ID})
+			if err != nil {
+				return err
 			}
 		}
 
@@ -158,14 +272,17 @@ func (store *SQLStore) UpdateEventTx(ctx context.Context, arg UpdateEventTxParam
 		}
 
 		if oldImageUUID.Valid && arg.OldImageID != arg.NewImageID {
-			err = q.DeleteImage(ctx, oldImageUUID.Bytes)
+			references, err := q.CountImageReferences(ctx, oldImageUUID)
 			if err != nil {
-				return fmt.Errorf("delete old image error: %w", err)
+				return fmt.Errorf("count image references error: %w", err)
 			}
-		}
 
-		if err != nil {
-			return fmt.Errorf("get updated event error: %w", err)
+			if references == 0 {
+				err = q.DeleteImage(ctx, oldImageUUID.Bytes)
+				if err != nil {
+					return fmt.Errorf("delete old image error: %w", err)
+				}
+			}
 		}
 
 		return nil
@@ -173,3 +290,61 @@ func (store *SQLStore) UpdateEventTx(ctx context.Context, arg UpdateEventTxParam
 
 	return err
 }
+
+func eventsToUpdate(ctx context.Context, q *Queries, arg UpdateEventTxParams) ([]ListFollowingSeriesEventsRow, error) {
+	single := []ListFollowingSeriesEventsRow{{ID: arg.EventID, Date: arg.Date}}
+	if !arg.ApplyToFollowing {
+		return single, nil
+	}
+
+	rows, err := q.ListFollowingSeriesEvents(ctx, arg.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return single, nil
+	}
+
+	var shift time.Duration
+	for _, row := range rows {
+		if row.ID == arg.EventID {
+			shift = arg.Date.Sub(row.Date)
+		}
+	}
+
+	for i := range rows {
+		rows[i].Date = rows[i].Date.Add(shift)
+	}
+
+	return rows, nil
+}
+
+// DeleteEventTx notifies participants before the event goes away: deleting it
+// cascades to event_user, so afterwards there is nobody left to tell.
+func (store *SQLStore) DeleteEventTx(ctx context.Context, eventID int32) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		event, err := q.GetEventSummary(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("get event error: %w", err)
+		}
+
+		_, err = q.NotifyEventParticipants(ctx, NotifyEventParticipantsParams{
+			Type:    "event_cancelled",
+			EventID: eventID,
+		})
+		if err != nil {
+			return fmt.Errorf("notify participants error: %w", err)
+		}
+
+		err = q.DeleteEvent(ctx, eventID)
+		if err != nil {
+			return fmt.Errorf("delete event error: %w", err)
+		}
+
+		return enqueue(ctx, q, eventbus.EventDeleted{
+			EventID: eventID,
+			OwnerID: event.OwnerID,
+			Name:    event.Name,
+		})
+	})
+}
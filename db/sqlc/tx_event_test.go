@@ -0,0 +1,36 @@
+package db
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteEventTxNotifiesParticipants(t *testing.T) {
+	store := NewStore(testDB)
+	owner := createRandomUser(t)
+	event := createRandomEvent(t, owner.ID, 2)
+	participant := createRandomUser(t)
+
+	_, err := store.SubscribeToEventTx(context.Background(), SubscribeToEventParams{UserID: participant.ID, EventID: event.ID})
+	require.NoError(t, err)
+
+	err = store.DeleteEventTx(context.Background(), event.ID)
+	require.NoError(t, err)
+
+	notifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		UserID: participant.ID,
+		Lim:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	require.Equal(t, "event_cancelled", notifications[0].Type)
+	require.Equal(t, event.Name, notifications[0].EventName)
+
+	ownerNotifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{
+		UserID: owner.ID,
+		Lim:    10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, ownerNotifications)
+}
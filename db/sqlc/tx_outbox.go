@@ -0,0 +1,26 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"treffly/eventbus"
+)
+
+// enqueue writes a domain event to the outbox. It must be called with the
+// transaction's Queries so the event is only published if the change commits.
+func enqueue(ctx context.Context, q *Queries, e eventbus.Event) error {
+	payload, err := eventbus.Encode(e)
+	if err != nil {
+		return fmt.Errorf("encode %s error: %w", e.Type(), err)
+	}
+
+	err = q.CreateOutboxMessage(ctx, CreateOutboxMessageParams{
+		EventType: e.Type(),
+		Payload:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue %s error: %w", e.Type(), err)
+	}
+
+	return nil
+}
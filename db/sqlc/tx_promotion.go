@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrPromotionOverlap = errors.New("overlapping promotion")
+
+// CreatePromotionTx locks the event row before the overlap check, so two
+// concurrent requests for the same event cannot both pass it.
+// ErrPromotionOverlap is returned when the window is already taken.
+func (store *SQLStore) CreatePromotionTx(ctx context.Context, arg CreatePromotionParams) (Promotion, error) {
+	var promotion Promotion
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetEventCapacityForUpdate(ctx, arg.EventID)
+		if err != nil {
+			return fmt.Errorf("lock event error: %w", err)
+		}
+
+		overlaps, err := q.HasOverlappingPromotion(ctx, HasOverlappingPromotionParams{
+			EventID:  arg.EventID,
+			EndsAt:   arg.EndsAt,
+			StartsAt: arg.StartsAt,
+		})
+		if err != nil {
+			return fmt.Errorf("check overlap error: %w", err)
+		}
+		if overlaps {
+			return ErrPromotionOverlap
+		}
+
+		promotion, err = q.CreatePromotion(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("create promotion error: %w", err)
+		}
+
+		return nil
+	})
+
+	return promotion, err
+}
+
+type SyncPromotionsTxResult struct {
+	Activated []int32
+	Finished  []int32
+}
+
+// SyncPromotionsTx moves promotions whose window opened or closed to their
+// next status and recomputes is_premium for every affected event.
+func (store *SQLStore) SyncPromotionsTx(ctx context.Context) (SyncPromotionsTxResult, error) {
+	var result SyncPromotionsTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Finished, err = q.FinishPromotions(ctx)
+		if err != nil {
+			return fmt.Errorf("finish promotions error: %w", err)
+		}
+
+		result.Activated, err = q.ActivatePromotions(ctx)
+		if err != nil {
+			return fmt.Errorf("activate promotions error: %w", err)
+		}
+
+		eventIDs := append(append([]int32{}, result.Finished...), result.Activated...)
+		if len(eventIDs) == 0 {
+			return nil
+		}
+
+		err = q.SyncEventsPremium(ctx, eventIDs)
+		if err != nil {
+			return fmt.Errorf("sync events premium error: %w", err)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrProtectedUser is returned by ReviewReportTx when resolving a report would
+// block the reviewing moderator or another staff member.
+var ErrProtectedUser = errors.New("reported user cannot be blocked by a moderator")
+
+type CreateReportTxParams struct {
+	CreateReportParams
+	HideThreshold int64
+}
+
+type CreateReportTxResult struct {
+	Report      Report
+	EventHidden bool
+}
+
+// CreateReportTx stores a report and hides the reported event once the number
+// of open reports against it reaches HideThreshold.
+func (store *SQLStore) CreateReportTx(ctx context.Context, params CreateReportTxParams) (CreateReportTxResult, error) {
+	var result CreateReportTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Report, err = q.CreateReport(ctx, params.CreateReportParams)
+		if err != nil {
+			return fmt.Errorf("create report error: %w", err)
+		}
+
+		if !params.EventID.Valid || params.HideThreshold <= 0 {
+			return nil
+		}
+
+		hidden, err := q.HideReportedEvent(ctx, HideReportedEventParams{
+			EventID:   params.EventID.Int32,
+			Threshold: params.HideThreshold,
+		})
+		if err != nil {
+			return fmt.Errorf("hide reported event error: %w", err)
+		}
+		result.EventHidden = hidden > 0
+
+		return nil
+	})
+
+	return result, err
+}
+
+type ReviewReportTxParams struct {
+	ReportID    int32
+	ModeratorID int32
+	Status      string
+}
+
+// ReviewReportTx closes every open report against the same target as
+// ReportID. Resolving keeps the reported event hidden or blocks the reported
+// user; dismissing makes the event visible again. Staff accounts are left to
+// the admin API, so resolving a report against one fails with ErrProtectedUser.
+func (store *SQLStore) ReviewReportTx(ctx context.Context, params ReviewReportTxParams) ([]Report, error) {
+	var reports []Report
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		reports, err = q.ReviewReports(ctx, ReviewReportsParams{
+			Status:     params.Status,
+			ResolvedBy: pgtype.Int4{Int32: params.ModeratorID, Valid: true},
+			ID:         params.ReportID,
+		})
+		if err != nil {
+			return fmt.Errorf("review reports error: %w", err)
+		}
+		if len(reports) == 0 {
+			return pgx.ErrNoRows
+		}
+
+		resolved := params.Status == "resolved"
+		target := reports[0]
+
+		if target.EventID.Valid {
+			err = q.SetEventHidden(ctx, SetEventHiddenParams{
+				ID:       target.EventID.Int32,
+				IsHidden: resolved,
+			})
+			if err != nil {
+				return fmt.Errorf("set event hidden error: %w", err)
+			}
+			return nil
+		}
+
+		if !resolved {
+			return nil
+		}
+
+		if target.UserID.Int32 == params.ModeratorID {
+			return ErrProtectedUser
+		}
+
+		access, err := q.GetUserAccess(ctx, target.UserID.Int32)
+		if err != nil {
+			return fmt.Errorf("get reported user error: %w", err)
+		}
+		if access.Role == "moderator" || access.Role == "admin" {
+			return ErrProtectedUser
+		}
+
+		_, err = q.SetUserBlocked(ctx, SetUserBlockedParams{
+			ID:        target.UserID.Int32,
+			IsBlocked: true,
+		})
+		if err != nil {
+			return fmt.Errorf("block reported user error: %w", err)
+		}
+
+		err = q.BlockUserSessions(ctx, target.UserID.Int32)
+		if err != nil {
+			return fmt.Errorf("block user sessions error: %w", err)
+		}
+
+		return nil
+	})
+
+	return reports, err
+}
@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+var ErrSessionRotated = errors.New("session already rotated")
+
+type RotateSessionTxParams struct {
+	OldUuid      uuid.UUID
+	NewUuid      uuid.UUID
+	RefreshToken string
+	ExpiresAt    time.Time
+	UserAgent    string
+	ClientIp     string
+}
+
+// RotateSessionTx retires the session identified by OldUuid and issues its
+// successor within the same family. ErrSessionRotated is returned when the
+// old session was already rotated or blocked, which callers treat as reuse.
+func (store *SQLStore) RotateSessionTx(ctx context.Context, params RotateSessionTxParams) error {
+	return store.execTx(ctx, func(q *Queries) error {
+		rows, err := q.RotateSession(ctx, params.OldUuid)
+		if err != nil {
+			return fmt.Errorf("rotate session error: %w", err)
+		}
+		if rows == 0 {
+			return ErrSessionRotated
+		}
+
+		err = q.CreateRotatedSession(ctx, CreateRotatedSessionParams{
+			NewUuid:      params.NewUuid,
+			RefreshToken: params.RefreshToken,
+			ExpiresAt:    params.ExpiresAt,
+			UserAgent:    params.UserAgent,
+			ClientIp:     params.ClientIp,
+			OldUuid:      params.OldUuid,
+		})
+		if err != nil {
+			return fmt.Errorf("create rotated session error: %w", err)
+		}
+
+		return nil
+	})
+}
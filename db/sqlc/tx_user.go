@@ -98,3 +98,82 @@ func (store *SQLStore) UpdateUserTx(ctx context.Context, params UpdateUserTxPara
 
 	return result, err
 }
+
+type ResetPasswordTxParams struct {
+	TokenHash    string
+	Purpose      string
+	PasswordHash string
+}
+
+func (store *SQLStore) ResetPasswordTx(ctx context.Context, params ResetPasswordTxParams) (int32, error) {
+	var userID int32
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		userID, err = q.ConsumeUserToken(ctx, ConsumeUserTokenParams{
+			TokenHash: params.TokenHash,
+			Purpose:   params.Purpose,
+		})
+		if err != nil {
+			return fmt.Errorf("consume user token error: %w", err)
+		}
+
+		err = q.UpdateUserPassword(ctx, UpdateUserPasswordParams{
+			ID:           userID,
+			PasswordHash: params.PasswordHash,
+		})
+		if err != nil {
+			return fmt.Errorf("update user password error: %w", err)
+		}
+
+		err = q.DeleteUserTokens(ctx, DeleteUserTokensParams{
+			UserID:  userID,
+			Purpose: params.Purpose,
+		})
+		if err != nil {
+			return fmt.Errorf("delete user tokens error: %w", err)
+		}
+
+		err = q.BlockUserSessions(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("block user sessions error: %w", err)
+		}
+
+		return nil
+	})
+
+	return userID, err
+}
+
+type SetUserBlockedTxParams struct {
+	UserID    int32
+	IsBlocked bool
+}
+
+func (store *SQLStore) SetUserBlockedTx(ctx context.Context, params SetUserBlockedTxParams) (User, error) {
+	var user User
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		user, err = q.SetUserBlocked(ctx, SetUserBlockedParams{
+			ID:        params.UserID,
+			IsBlocked: params.IsBlocked,
+		})
+		if err != nil {
+			return fmt.Errorf("set user blocked error: %w", err)
+		}
+
+		if !params.IsBlocked {
+			return nil
+		}
+
+		err = q.BlockUserSessions(ctx, params.UserID)
+		if err != nil {
+			return fmt.Errorf("block user sessions error: %w", err)
+		}
+
+		return nil
+	})
+
+	return user, err
+}
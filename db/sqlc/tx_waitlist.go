@@ -0,0 +1,186 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"treffly/eventbus"
+)
+
+// ErrNotParticipant is returned by UnsubscribeFromEventTx when the user has
+// not joined the event, so nothing is announced or promoted.
+var ErrNotParticipant = errors.New("user is not a participant")
+
+// SubscribeToEventTx returns the error of SubscribeToEvent unwrapped: no row
+// means the event is full or private and the caller reports it as such. The
+// event row is locked first so concurrent joins and waitlist promotion count
+// participants one at a time.
+func (store *SQLStore) SubscribeToEventTx(ctx context.Context, arg SubscribeToEventParams) (pgtype.Bool, error) {
+	var allowed pgtype.Bool
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetEventCapacityForUpdate(ctx, arg.EventID)
+		if err != nil {
+			return err
+		}
+
+		allowed, err = q.SubscribeToEvent(ctx, arg)
+		if err != nil {
+			return err
+		}
+		if allowed.Valid && !allowed.Bool {
+			return nil
+		}
+
+		err = q.LeaveEventWaitlist(ctx, LeaveEventWaitlistParams{
+			EventID: arg.EventID,
+			UserID:  arg.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("leave waitlist error: %w", err)
+		}
+
+		return enqueue(ctx, q, eventbus.ParticipantJoined{
+			EventID: arg.EventID,
+			UserID:  arg.UserID,
+		})
+	})
+
+	return allowed, err
+}
+
+// JoinEventWaitlistTx queues the user under the event lock and runs promotion
+// right away, so a seat freed since the caller saw the event full is not left
+// empty while the user waits for it.
+func (store *SQLStore) JoinEventWaitlistTx(ctx context.Context, arg JoinEventWaitlistParams) ([]int32, error) {
+	var promoted []int32
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		_, err := q.GetEventCapacityForUpdate(ctx, arg.EventID)
+		if err != nil {
+			return fmt.Errorf("lock event error: %w", err)
+		}
+
+		err = q.JoinEventWaitlist(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("join waitlist error: %w", err)
+		}
+
+		promoted, err = promoteFromWaitlist(ctx, q, arg.EventID)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return promoted, nil
+}
+
+func (store *SQLStore) UnsubscribeFromEventTx(ctx context.Context, arg UnsubscribeFromEventParams) ([]int32, error) {
+	var promoted []int32
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		deleted, err := q.UnsubscribeFromEvent(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("unsubscribe error: %w", err)
+		}
+		if deleted == 0 {
+			return ErrNotParticipant
+		}
+
+		// Reviews are reserved for participants, so leaving an event
+		// withdraws the rating as well.
+		_, err = q.DeleteReview(ctx, DeleteReviewParams{
+			EventID: arg.EventID,
+			UserID:  arg.UserID,
+		})
+		if err != nil {
+			return fmt.Errorf("delete review error: %w", err)
+		}
+
+		err = enqueue(ctx, q, eventbus.ParticipantLeft{
+			EventID: arg.EventID,
+			UserID:  arg.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		promoted, err = promoteFromWaitlist(ctx, q, arg.EventID)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// promoteFromWaitlist fills free seats from the waitlist. Every path that
+// frees a seat ends here, so the promoted users and the owner are notified
+// here rather than by the callers.
+func promoteFromWaitlist(ctx context.Context, q *Queries, eventID int32) ([]int32, error) {
+	capacity, err := q.GetEventCapacityForUpdate(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("lock event error: %w", err)
+	}
+
+	participants, err := q.CountEventParticipants(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("count participants error: %w", err)
+	}
+
+	promoted := []int32{}
+	for free := int64(capacity) - participants; free > 0; free-- {
+		userID, err := q.PopEventWaitlist(ctx, eventID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("pop waitlist error: %w", err)
+		}
+
+		err = q.AddEventParticipant(ctx, AddEventParticipantParams{
+			UserID:  userID,
+			EventID: eventID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("promote user %d error: %w", userID, err)
+		}
+
+		err = q.CreateNotification(ctx, CreateNotificationParams{
+			UserID:  userID,
+			Type:    "waitlist_promoted",
+			EventID: eventID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("notify promoted user %d error: %w", userID, err)
+		}
+
+		err = q.NotifyEventOwner(ctx, NotifyEventOwnerParams{
+			Type:    "participant_joined",
+			ActorID: userID,
+			EventID: eventID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("notify owner about user %d error: %w", userID, err)
+		}
+
+		err = enqueue(ctx, q, eventbus.ParticipantJoined{
+			EventID:      eventID,
+			UserID:       userID,
+			FromWaitlist: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		promoted = append(promoted, userID)
+	}
+
+	return promoted, nil
+}
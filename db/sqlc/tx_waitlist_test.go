@@ -0,0 +1,151 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+	"treffly/util"
+)
+
+func createRandomEvent(t *testing.T, ownerID int32, capacity int32) GetEventRow {
+	var latitude, longitude pgtype.Numeric
+	require.NoError(t, latitude.Scan("55.75580000"))
+	require.NoError(t, longitude.Scan("37.61730000"))
+
+	store := NewStore(testDB)
+	event, err := store.CreateEventTx(context.Background(), CreateEventTxParams{
+		Name:        util.RandomString(10),
+		Description: util.RandomString(30),
+		Capacity:    capacity,
+		Latitude:    latitude,
+		Longitude:   longitude,
+		Address:     util.RandomString(20),
+		Date:        time.Now().Add(48 * time.Hour),
+		OwnerID:     ownerID,
+	}, CreateImageParams{})
+	require.NoError(t, err)
+	require.Equal(t, capacity, event.Capacity)
+
+	return event
+}
+
+func requireParticipant(t *testing.T, eventID, userID int32, expected bool) {
+	isParticipant, err := testQueries.IsParticipant(context.Background(), IsParticipantParams{
+		EventID: eventID,
+		UserID:  userID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, isParticipant)
+}
+
+func TestSubscribeToEventTxFull(t *testing.T) {
+	store := NewStore(testDB)
+	owner := createRandomUser(t)
+	event := createRandomEvent(t, owner.ID, 1)
+	first := createRandomUser(t)
+	second := createRandomUser(t)
+
+	allowed, err := store.SubscribeToEventTx(context.Background(), SubscribeToEventParams{UserID: first.ID, EventID: event.ID})
+	require.NoError(t, err)
+	require.True(t, allowed.Bool)
+
+	_, err = store.SubscribeToEventTx(context.Background(), SubscribeToEventParams{UserID: second.ID, EventID: event.ID})
+	require.Error(t, err)
+	requireParticipant(t, event.ID, second.ID, false)
+}
+
+func TestUnsubscribeFromEventTxPromotes(t *testing.T) {
+	store := NewStore(testDB)
+	owner := createRandomUser(t)
+	event := createRandomEvent(t, owner.ID, 1)
+	participant := createRandomUser(t)
+	waiting := createRandomUser(t)
+
+	_, err := store.SubscribeToEventTx(context.Background(), SubscribeToEventParams{UserID: participant.ID, EventID: event.ID})
+	require.NoError(t, err)
+
+	promoted, err := store.JoinEventWaitlistTx(context.Background(), JoinEventWaitlistParams{EventID: event.ID, UserID: waiting.ID})
+	require.NoError(t, err)
+	require.Empty(t, promoted)
+
+	promoted, err = store.UnsubscribeFromEventTx(context.Background(), UnsubscribeFromEventParams{UserID: participant.ID, EventID: event.ID})
+	require.NoError(t, err)
+	require.Equal(t, []int32{waiting.ID}, promoted)
+
+	requireParticipant(t, event.ID, participant.ID, false)
+	requireParticipant(t, event.ID, waiting.ID, true)
+
+	status, err := testQueries.GetWaitlistStatus(context.Background(), GetWaitlistStatusParams{EventID: event.ID, UserID: waiting.ID})
+	require.NoError(t, err)
+	require.Zero(t, status.WaitlistCount)
+
+	promotedNotifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{UserID: waiting.ID, Lim: 10})
+	require.NoError(t, err)
+	require.Len(t, promotedNotifications, 1)
+	require.Equal(t, "waitlist_promoted", promotedNotifications[0].Type)
+
+	ownerNotifications, err := testQueries.ListNotifications(context.Background(), ListNotificationsParams{UserID: owner.ID, Lim: 10})
+	require.NoError(t, err)
+	require.Len(t, ownerNotifications, 1)
+	require.Equal(t, "participant_joined", ownerNotifications[0].Type)
+	require.Equal(t, waiting.ID, ownerNotifications[0].ActorID.Int32)
+}
+
+func TestUnsubscribeFromEventTxNotParticipant(t *testing.T) {
+	store := NewStore(testDB)
+	owner := createRandomUser(t)
+	event := createRandomEvent(t, owner.ID, 1)
+	participant := createRandomUser(t)
+	stranger := createRandomUser(t)
+	waiting := createRandomUser(t)
+
+	_, err := store.SubscribeToEventTx(context.Background(), SubscribeToEventParams{UserID: participant.ID, EventID: event.ID})
+	require.NoError(t, err)
+	_, err = store.JoinEventWaitlistTx(context.Background(), JoinEventWaitlistParams{EventID: event.ID, UserID: waiting.ID})
+	require.NoError(t, err)
+
+	promoted, err := store.UnsubscribeFromEventTx(context.Background(), UnsubscribeFromEventParams{UserID: stranger.ID, EventID: event.ID})
+	require.ErrorIs(t, err, ErrNotParticipant)
+	require.Empty(t, promoted)
+
+	requireParticipant(t, event.ID, participant.ID, true)
+	requireParticipant(t, event.ID, waiting.ID, false)
+}
+
+func TestUpdateEventTxPromotesOnCapacityRaise(t *testing.T) {
+	store := NewStore(testDB)
+	owner := createRandomUser(t)
+	event := createRandomEvent(t, owner.ID, 1)
+	participant := createRandomUser(t)
+	first := createRandomUser(t)
+	second := createRandomUser(t)
+
+	_, err := store.SubscribeToEventTx(context.Background(), SubscribeToEventParams{UserID: participant.ID, EventID: event.ID})
+	require.NoError(t, err)
+	for _, user := range []User{first, second} {
+		_, err = store.JoinEventWaitlistTx(context.Background(), JoinEventWaitlistParams{EventID: event.ID, UserID: user.ID})
+		require.NoError(t, err)
+	}
+
+	err = store.UpdateEventTx(context.Background(), UpdateEventTxParams{
+		EventID:     event.ID,
+		Name:        event.Name,
+		Description: event.Description,
+		Capacity:    2,
+		Latitude:    event.Latitude,
+		Longitude:   event.Longitude,
+		Address:     event.Address,
+		Date:        event.Date,
+	})
+	require.NoError(t, err)
+
+	requireParticipant(t, event.ID, first.ID, true)
+	requireParticipant(t, event.ID, second.ID, false)
+
+	status, err := testQueries.GetWaitlistStatus(context.Background(), GetWaitlistStatusParams{EventID: event.ID, UserID: second.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), status.WaitlistCount)
+	require.Equal(t, int32(1), status.Position)
+}
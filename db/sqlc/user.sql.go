@@ -15,7 +15,7 @@ const createUser = `-- name: CreateUser :one
 INSERT INTO users (username,
                    email,
                    password_hash)
-VALUES ($1, $2, $3) RETURNING id, username, email, password_hash, created_at, is_admin, image_id
+VALUES ($1, $2, $3) RETURNING id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked
 `
 
 type CreateUserParams struct {
@@ -33,8 +33,10 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
-		&i.IsAdmin,
 		&i.ImageID,
+		&i.EmailVerified,
+		&i.Role,
+		&i.IsBlocked,
 	)
 	return i, err
 }
@@ -50,7 +52,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, username, email, password_hash, created_at, is_admin, image_id FROM users
+SELECT id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked FROM users
 WHERE id = $1 LIMIT 1
 `
 
@@ -63,14 +65,34 @@ func (q *Queries) GetUser(ctx context.Context, id int32) (User, error) {
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
-		&i.IsAdmin,
 		&i.ImageID,
+		&i.EmailVerified,
+		&i.Role,
+		&i.IsBlocked,
 	)
 	return i, err
 }
 
+const getUserAccess = `-- name: GetUserAccess :one
+SELECT role, is_blocked
+FROM users
+WHERE id = $1
+`
+
+type GetUserAccessRow struct {
+	Role      string `json:"role"`
+	IsBlocked bool   `json:"is_blocked"`
+}
+
+func (q *Queries) GetUserAccess(ctx context.Context, id int32) (GetUserAccessRow, error) {
+	row := q.db.QueryRow(ctx, getUserAccess, id)
+	var i GetUserAccessRow
+	err := row.Scan(&i.Role, &i.IsBlocked)
+	return i, err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, username, email, password_hash, created_at, is_admin, image_id FROM users
+SELECT id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked FROM users
 WHERE email = $1 LIMIT 1
 `
 
@@ -83,14 +105,16 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
-		&i.IsAdmin,
 		&i.ImageID,
+		&i.EmailVerified,
+		&i.Role,
+		&i.IsBlocked,
 	)
 	return i, err
 }
 
 const getUserWithTags = `-- name: GetUserWithTags :one
-SELECT id, username, email, created_at, tags, image_path FROM user_with_tags_view WHERE id = $1
+SELECT id, username, email, created_at, tags, image_path, email_verified FROM user_with_tags_view WHERE id = $1
 `
 
 func (q *Queries) GetUserWithTags(ctx context.Context, id int32) (UserWithTagsView, error) {
@@ -103,6 +127,7 @@ func (q *Queries) GetUserWithTags(ctx context.Context, id int32) (UserWithTagsVi
 		&i.CreatedAt,
 		&i.Tags,
 		&i.ImagePath,
+		&i.EmailVerified,
 	)
 	return i, err
 }
@@ -129,7 +154,7 @@ func (q *Queries) IsParticipant(ctx context.Context, arg IsParticipantParams) (b
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, username, email, password_hash, created_at, is_admin, image_id FROM users
+SELECT id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked FROM users
 ORDER BY id
 LIMIT $1
 OFFSET $2
@@ -155,8 +180,10 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 			&i.Email,
 			&i.PasswordHash,
 			&i.CreatedAt,
-			&i.IsAdmin,
 			&i.ImageID,
+			&i.EmailVerified,
+			&i.Role,
+			&i.IsBlocked,
 		); err != nil {
 			return nil, err
 		}
@@ -168,11 +195,41 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	return items, nil
 }
 
+const setUserBlocked = `-- name: SetUserBlocked :one
+UPDATE users
+SET is_blocked = $2
+WHERE id = $1
+RETURNING id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked
+`
+
+type SetUserBlockedParams struct {
+	ID        int32 `json:"id"`
+	IsBlocked bool  `json:"is_blocked"`
+}
+
+func (q *Queries) SetUserBlocked(ctx context.Context, arg SetUserBlockedParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserBlocked, arg.ID, arg.IsBlocked)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.ImageID,
+		&i.EmailVerified,
+		&i.Role,
+		&i.IsBlocked,
+	)
+	return i, err
+}
+
 const subscribeToEvent = `-- name: SubscribeToEvent :one
 WITH event_check AS (
     SELECT
         e.capacity,
         e.is_private,
+        e.date,
         COUNT(eu.user_id) AS participants,
         EXISTS (
             SELECT 1 FROM event_tokens
@@ -190,11 +247,16 @@ SELECT $1, $2
 FROM event_check
 WHERE
     participants < capacity
+  AND date > NOW()
   AND (
     NOT is_private
         OR
     (is_private AND valid_token)
     )
+  AND NOT EXISTS (
+    SELECT 1 FROM event_bans
+    WHERE event_id = $2 AND user_id = $1
+    )
     RETURNING (
     SELECT participants < capacity
     AND (NOT is_private OR valid_token)
@@ -215,7 +277,7 @@ func (q *Queries) SubscribeToEvent(ctx context.Context, arg SubscribeToEventPara
 	return allowed, err
 }
 
-const unsubscribeFromEvent = `-- name: UnsubscribeFromEvent :exec
+const unsubscribeFromEvent = `-- name: UnsubscribeFromEvent :execrows
 DELETE FROM event_user
 WHERE user_id = $1 AND event_id = $2
 `
@@ -225,9 +287,12 @@ type UnsubscribeFromEventParams struct {
 	EventID int32 `json:"event_id"`
 }
 
-func (q *Queries) UnsubscribeFromEvent(ctx context.Context, arg UnsubscribeFromEventParams) error {
-	_, err := q.db.Exec(ctx, unsubscribeFromEvent, arg.UserID, arg.EventID)
-	return err
+func (q *Queries) UnsubscribeFromEvent(ctx context.Context, arg UnsubscribeFromEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unsubscribeFromEvent, arg.UserID, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const updateUser = `-- name: UpdateUser :one
@@ -235,7 +300,7 @@ UPDATE users
 SET username = $2,
     image_id = $3
 WHERE id = $1
-RETURNING id, username, email, password_hash, created_at, is_admin, image_id
+RETURNING id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked
 `
 
 type UpdateUserParams struct {
@@ -253,8 +318,66 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.Email,
 		&i.PasswordHash,
 		&i.CreatedAt,
-		&i.IsAdmin,
 		&i.ImageID,
+		&i.EmailVerified,
+		&i.Role,
+		&i.IsBlocked,
 	)
 	return i, err
 }
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID           int32  `json:"id"`
+	PasswordHash string `json:"password_hash"`
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE id = $1
+RETURNING id, username, email, password_hash, created_at, image_id, email_verified, role, is_blocked
+`
+
+type UpdateUserRoleParams struct {
+	ID   int32  `json:"id"`
+	Role string `json:"role"`
+}
+
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserRole, arg.ID, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+		&i.ImageID,
+		&i.EmailVerified,
+		&i.Role,
+		&i.IsBlocked,
+	)
+	return i, err
+}
+
+const verifyUserEmail = `-- name: VerifyUserEmail :exec
+UPDATE users
+SET email_verified = true
+WHERE id = $1
+`
+
+func (q *Queries) VerifyUserEmail(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, verifyUserEmail, id)
+	return err
+}
@@ -0,0 +1,34 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"treffly/push"
+)
+
+const (
+	ChannelEmail = "email"
+	ChannelPush  = "push"
+)
+
+// ErrNoAddress means the recipient cannot be reached through the channel,
+// e.g. they have no push subscriptions. It is not worth retrying.
+var ErrNoAddress = errors.New("recipient has no address for this channel")
+
+type Message struct {
+	Title string
+	Body  string
+	URL   string
+}
+
+type Recipient struct {
+	UserID            int32
+	Username          string
+	Email             string
+	PushSubscriptions []push.Subscription
+}
+
+type Channel interface {
+	Name() string
+	Deliver(ctx context.Context, to Recipient, msg Message) error
+}
@@ -0,0 +1,112 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"treffly/mail"
+	"treffly/push"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingMailer struct {
+	sent []mail.Message
+}
+
+func (m *recordingMailer) Send(_ context.Context, msg mail.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+type stubSender struct {
+	results  map[string]error
+	payloads [][]byte
+}
+
+func (s *stubSender) Send(_ context.Context, sub push.Subscription, payload []byte) error {
+	s.payloads = append(s.payloads, payload)
+	return s.results[sub.Endpoint]
+}
+
+func subscriptions(endpoints ...string) []push.Subscription {
+	subs := make([]push.Subscription, len(endpoints))
+	for i, endpoint := range endpoints {
+		subs[i] = push.Subscription{Endpoint: endpoint}
+	}
+	return subs
+}
+
+func TestEmailChannel(t *testing.T) {
+	mailer := &recordingMailer{}
+	channel := NewEmailChannel(mailer)
+
+	err := channel.Deliver(context.Background(), Recipient{Username: "anna", Email: "anna@example.com"}, Message{
+		Title: "Завтра: Пикник",
+		Body:  "Скоро начало",
+		URL:   "https://treffly.ru/events/1",
+	})
+	require.NoError(t, err)
+	require.Len(t, mailer.sent, 1)
+	require.Equal(t, "anna@example.com", mailer.sent[0].To)
+	require.Equal(t, "Завтра: Пикник", mailer.sent[0].Subject)
+	require.Contains(t, mailer.sent[0].Body, "anna")
+	require.Contains(t, mailer.sent[0].Body, "https://treffly.ru/events/1")
+
+	err = channel.Deliver(context.Background(), Recipient{Username: "anna"}, Message{})
+	require.ErrorIs(t, err, ErrNoAddress)
+}
+
+func TestPushChannelPartialFailure(t *testing.T) {
+	sender := &stubSender{results: map[string]error{
+		"https://push.example/gone":   push.ErrGone,
+		"https://push.example/broken": errors.New("boom"),
+	}}
+	var gone []string
+	channel := NewPushChannel(sender, func(_ context.Context, endpoint string) {
+		gone = append(gone, endpoint)
+	})
+
+	to := Recipient{PushSubscriptions: subscriptions(
+		"https://push.example/gone",
+		"https://push.example/broken",
+		"https://push.example/ok",
+	)}
+	err := channel.Deliver(context.Background(), to, Message{Title: "t", Body: "b", URL: "u"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://push.example/gone"}, gone)
+	require.Len(t, sender.payloads, 3)
+
+	var payload map[string]string
+	require.NoError(t, json.Unmarshal(sender.payloads[0], &payload))
+	require.Equal(t, map[string]string{"title": "t", "body": "b", "url": "u"}, payload)
+}
+
+func TestPushChannelFailures(t *testing.T) {
+	sender := &stubSender{results: map[string]error{
+		"https://push.example/gone":   push.ErrGone,
+		"https://push.example/broken": errors.New("boom"),
+	}}
+	channel := NewPushChannel(sender, nil)
+
+	err := channel.Deliver(context.Background(), Recipient{}, Message{})
+	require.ErrorIs(t, err, ErrNoAddress)
+
+	err = channel.Deliver(context.Background(), Recipient{PushSubscriptions: subscriptions("https://push.example/gone")}, Message{})
+	require.ErrorIs(t, err, ErrNoAddress)
+
+	err = channel.Deliver(context.Background(), Recipient{PushSubscriptions: subscriptions("https://push.example/gone", "https://push.example/broken")}, Message{})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNoAddress)
+}
+
+func TestFakeChannel(t *testing.T) {
+	channel := NewFakeChannel(ChannelEmail)
+	require.NoError(t, channel.Deliver(context.Background(), Recipient{UserID: 1}, Message{Title: "t"}))
+	require.Len(t, channel.Delivered(), 1)
+
+	channel.Err = errors.New("down")
+	require.Error(t, channel.Deliver(context.Background(), Recipient{UserID: 2}, Message{}))
+	require.Len(t, channel.Delivered(), 1)
+}
@@ -0,0 +1,38 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+	"treffly/mail"
+)
+
+const emailBody = `Привет, %s!
+
+%s
+
+%s
+`
+
+type EmailChannel struct {
+	mailer mail.Mailer
+}
+
+func NewEmailChannel(mailer mail.Mailer) *EmailChannel {
+	return &EmailChannel{mailer: mailer}
+}
+
+func (c *EmailChannel) Name() string {
+	return ChannelEmail
+}
+
+func (c *EmailChannel) Deliver(ctx context.Context, to Recipient, msg Message) error {
+	if to.Email == "" {
+		return ErrNoAddress
+	}
+
+	return c.mailer.Send(ctx, mail.Message{
+		To:      to.Email,
+		Subject: msg.Title,
+		Body:    fmt.Sprintf(emailBody, to.Username, msg.Body, msg.URL),
+	})
+}
@@ -0,0 +1,48 @@
+package delivery
+
+import (
+	"context"
+	"sync"
+)
+
+type Delivered struct {
+	To  Recipient
+	Msg Message
+}
+
+// FakeChannel records deliveries instead of sending them. Err, if set, is
+// returned from every Deliver call.
+type FakeChannel struct {
+	ChannelName string
+	Err         error
+
+	mu        sync.Mutex
+	delivered []Delivered
+}
+
+func NewFakeChannel(name string) *FakeChannel {
+	return &FakeChannel{ChannelName: name}
+}
+
+func (c *FakeChannel) Name() string {
+	return c.ChannelName
+}
+
+func (c *FakeChannel) Deliver(_ context.Context, to Recipient, msg Message) error {
+	if c.Err != nil {
+		return c.Err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.delivered = append(c.delivered, Delivered{To: to, Msg: msg})
+
+	return nil
+}
+
+func (c *FakeChannel) Delivered() []Delivered {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Delivered(nil), c.delivered...)
+}
@@ -0,0 +1,72 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"treffly/push"
+)
+
+type pushPayload struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PushChannel sends the message to every subscription of the recipient.
+// Subscriptions the push service reports as gone are passed to onGone so they
+// can be forgotten.
+type PushChannel struct {
+	sender push.Sender
+	onGone func(ctx context.Context, endpoint string)
+}
+
+func NewPushChannel(sender push.Sender, onGone func(ctx context.Context, endpoint string)) *PushChannel {
+	return &PushChannel{
+		sender: sender,
+		onGone: onGone,
+	}
+}
+
+func (c *PushChannel) Name() string {
+	return ChannelPush
+}
+
+// Deliver succeeds if at least one device received the message.
+func (c *PushChannel) Deliver(ctx context.Context, to Recipient, msg Message) error {
+	payload, err := json.Marshal(pushPayload{
+		Title: msg.Title,
+		Body:  msg.Body,
+		URL:   msg.URL,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		delivered bool
+		errs      []error
+	)
+	for _, sub := range to.PushSubscriptions {
+		err := c.sender.Send(ctx, sub, payload)
+		switch {
+		case err == nil:
+			delivered = true
+		case errors.Is(err, push.ErrGone):
+			if c.onGone != nil {
+				c.onGone(ctx, sub.Endpoint)
+			}
+		default:
+			errs = append(errs, err)
+		}
+	}
+
+	if delivered {
+		return nil
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return ErrNoAddress
+}
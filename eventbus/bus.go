@@ -0,0 +1,87 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Message is an event as delivered to subscribers. ID is the outbox id and is
+// stable across redeliveries, so subscribers can use it to deduplicate.
+type Message struct {
+	ID         int64
+	OccurredAt time.Time
+	Event      Event
+}
+
+type Handler func(ctx context.Context, msg Message) error
+
+type subscription struct {
+	name    string
+	types   map[string]bool
+	handler Handler
+}
+
+// Bus dispatches messages to in-process subscribers. Delivery is
+// at-least-once: if any subscriber fails, the message is redelivered to all
+// of them.
+type Bus struct {
+	mu   sync.RWMutex
+	subs []subscription
+}
+
+func New() *Bus {
+	return &Bus{}
+}
+
+// Subscribe registers handler for the given event types, or for every event
+// if none are given.
+func (b *Bus) Subscribe(name string, handler Handler, types ...string) {
+	var filter map[string]bool
+	if len(types) > 0 {
+		filter = make(map[string]bool, len(types))
+		for _, t := range types {
+			filter[t] = true
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subs = append(b.subs, subscription{
+		name:    name,
+		types:   filter,
+		handler: handler,
+	})
+}
+
+// Publish runs every matching subscriber, even if an earlier one fails, and
+// returns their joined errors.
+func (b *Bus) Publish(ctx context.Context, msg Message) error {
+	b.mu.RLock()
+	subs := b.subs
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subs {
+		if sub.types != nil && !sub.types[msg.Event.Type()] {
+			continue
+		}
+		if err := sub.handle(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sub.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s subscription) handle(ctx context.Context, msg Message) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return s.handler(ctx, msg)
+}
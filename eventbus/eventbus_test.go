@@ -0,0 +1,75 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	events := []Event{
+		EventCreated{EventID: 1, OwnerID: 2, SeriesID: 3},
+		EventUpdated{EventID: 1},
+		EventDeleted{EventID: 1, OwnerID: 2, Name: "Пикник"},
+		ParticipantJoined{EventID: 1, UserID: 4, FromWaitlist: true},
+		ParticipantLeft{EventID: 1, UserID: 4},
+	}
+
+	for _, e := range events {
+		payload, err := Encode(e)
+		require.NoError(t, err)
+
+		decoded, err := Decode(e.Type(), payload)
+		require.NoError(t, err)
+		require.Equal(t, e, decoded)
+	}
+}
+
+func TestDecodeUnknownType(t *testing.T) {
+	_, err := Decode("event.archived", []byte(`{}`))
+	require.Error(t, err)
+}
+
+func TestPublishFiltersByType(t *testing.T) {
+	bus := New()
+
+	var all, joined []Message
+	bus.Subscribe("all", func(_ context.Context, msg Message) error {
+		all = append(all, msg)
+		return nil
+	})
+	bus.Subscribe("joined", func(_ context.Context, msg Message) error {
+		joined = append(joined, msg)
+		return nil
+	}, TypeParticipantJoined)
+
+	require.NoError(t, bus.Publish(context.Background(), Message{ID: 1, Event: EventCreated{EventID: 1}}))
+	require.NoError(t, bus.Publish(context.Background(), Message{ID: 2, Event: ParticipantJoined{EventID: 1, UserID: 2}}))
+
+	require.Len(t, all, 2)
+	require.Len(t, joined, 1)
+	require.Equal(t, int64(2), joined[0].ID)
+}
+
+func TestPublishRunsEverySubscriber(t *testing.T) {
+	bus := New()
+
+	var called int
+	bus.Subscribe("failing", func(context.Context, Message) error {
+		return errors.New("boom")
+	})
+	bus.Subscribe("panicking", func(context.Context, Message) error {
+		panic("oops")
+	})
+	bus.Subscribe("ok", func(context.Context, Message) error {
+		called++
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), Message{ID: 1, Event: EventDeleted{EventID: 1}})
+	require.ErrorContains(t, err, "failing: boom")
+	require.ErrorContains(t, err, "panicking: panic: oops")
+	require.Equal(t, 1, called)
+}
@@ -0,0 +1,104 @@
+package eventbus
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	TypeEventCreated      = "event.created"
+	TypeEventUpdated      = "event.updated"
+	TypeEventDeleted      = "event.deleted"
+	TypeParticipantJoined = "participant.joined"
+	TypeParticipantLeft   = "participant.left"
+)
+
+// Event is a domain event. Events are stored in the outbox as JSON, so their
+// fields must survive a round trip through encoding/json.
+type Event interface {
+	Type() string
+}
+
+type EventCreated struct {
+	EventID  int32 `json:"event_id"`
+	OwnerID  int32 `json:"owner_id"`
+	SeriesID int32 `json:"series_id,omitempty"`
+}
+
+func (EventCreated) Type() string { return TypeEventCreated }
+
+type EventUpdated struct {
+	EventID int32 `json:"event_id"`
+}
+
+func (EventUpdated) Type() string { return TypeEventUpdated }
+
+// EventDeleted carries the owner and name because the event row is gone by
+// the time subscribers see it.
+type EventDeleted struct {
+	EventID int32  `json:"event_id"`
+	OwnerID int32  `json:"owner_id"`
+	Name    string `json:"name"`
+}
+
+func (EventDeleted) Type() string { return TypeEventDeleted }
+
+type ParticipantJoined struct {
+	EventID      int32 `json:"event_id"`
+	UserID       int32 `json:"user_id"`
+	FromWaitlist bool  `json:"from_waitlist,omitempty"`
+}
+
+func (ParticipantJoined) Type() string { return TypeParticipantJoined }
+
+type ParticipantLeft struct {
+	EventID int32 `json:"event_id"`
+	UserID  int32 `json:"user_id"`
+}
+
+func (ParticipantLeft) Type() string { return TypeParticipantLeft }
+
+func Encode(e Event) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func Decode(eventType string, payload []byte) (Event, error) {
+	var e Event
+	switch eventType {
+	case TypeEventCreated:
+		e = &EventCreated{}
+	case TypeEventUpdated:
+		e = &EventUpdated{}
+	case TypeEventDeleted:
+		e = &EventDeleted{}
+	case TypeParticipantJoined:
+		e = &ParticipantJoined{}
+	case TypeParticipantLeft:
+		e = &ParticipantLeft{}
+	default:
+		return nil, fmt.Errorf("unknown event type %q", eventType)
+	}
+
+	if err := json.Unmarshal(payload, e); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", eventType, err)
+	}
+
+	return deref(e), nil
+}
+
+// deref lets subscribers type-switch on values rather than pointers.
+func deref(e Event) Event {
+	switch v := e.(type) {
+	case *EventCreated:
+		return *v
+	case *EventUpdated:
+		return *v
+	case *EventDeleted:
+		return *v
+	case *ParticipantJoined:
+		return *v
+	case *ParticipantLeft:
+		return *v
+	}
+	return e
+}
@@ -0,0 +1,36 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileMailer writes every message to its own .eml file instead of sending
+// it, which is enough for local development and tests.
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+func NewFileMailer(dir, from string) (FileMailer, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return FileMailer{}, err
+	}
+	return FileMailer{Dir: dir, From: from}, nil
+}
+
+func (m FileMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	recipient := strings.NewReplacer("/", "_", "\\", "_").Replace(msg.To)
+	filename := fmt.Sprintf("%d-%s.eml", now.UnixNano(), recipient)
+
+	return os.WriteFile(filepath.Join(m.Dir, filename), buildMessage(m.From, msg, now), 0o644)
+}
@@ -0,0 +1,35 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileMailerSend(t *testing.T) {
+	mailer, err := NewFileMailer(t.TempDir(), "no-reply@treffly.ru")
+	require.NoError(t, err)
+
+	err = mailer.Send(context.Background(), Message{
+		To:      "user@example.com",
+		Subject: "Подтверждение почты",
+		Body:    "Перейди по ссылке",
+	})
+	require.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(mailer.Dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.True(t, strings.HasSuffix(files[0], "-user@example.com.eml"))
+
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	require.Contains(t, string(content), "From: no-reply@treffly.ru\r\n")
+	require.Contains(t, string(content), "To: user@example.com\r\n")
+	require.Contains(t, string(content), "Subject: =?utf-8?q?")
+	require.True(t, strings.HasSuffix(string(content), "\r\n\r\nПерейди по ссылке"))
+}
@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"time"
+)
+
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		addr: fmt.Sprintf("%s:%d", host, port),
+		auth: smtp.PlainAuth("", username, password, host),
+		from: from,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, buildMessage(m.from, msg, time.Now()))
+}
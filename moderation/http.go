@@ -0,0 +1,76 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HTTPModerator delegates checks to an external service that accepts
+// {"text": "..."} and answers with {"allowed": bool, "reason": "..."}.
+type HTTPModerator struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+type httpRequest struct {
+	Text string `json:"text"`
+}
+
+type httpResponse struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason"`
+}
+
+func NewHTTPModerator(url, apiKey string, timeout time.Duration) *HTTPModerator {
+	return &HTTPModerator{
+		url:    url,
+		apiKey: apiKey,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (m *HTTPModerator) Check(ctx context.Context, text string) error {
+	body, err := json.Marshal(httpRequest{Text: text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("moderation request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("moderation service returned status %d", resp.StatusCode)
+	}
+
+	var result httpResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode moderation response: %w", err)
+	}
+
+	if !result.Allowed {
+		reason := result.Reason
+		if reason == "" {
+			reason = "rejected by moderation service"
+		}
+		return &Rejection{Reason: reason}
+	}
+
+	return nil
+}
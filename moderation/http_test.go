@@ -0,0 +1,49 @@
+package moderation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPModeratorCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var req httpRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := httpResponse{Allowed: req.Text != "spam"}
+		if !resp.Allowed {
+			resp.Reason = "spam"
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer server.Close()
+
+	m := NewHTTPModerator(server.URL, "secret", time.Second)
+
+	require.NoError(t, m.Check(context.Background(), "hello"))
+
+	var rejection *Rejection
+	require.ErrorAs(t, m.Check(context.Background(), "spam"), &rejection)
+	require.Equal(t, "spam", rejection.Reason)
+}
+
+func TestHTTPModeratorServiceError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	err := NewHTTPModerator(server.URL, "", time.Second).Check(context.Background(), "hello")
+	require.Error(t, err)
+
+	var rejection *Rejection
+	require.NotErrorAs(t, err, &rejection)
+}
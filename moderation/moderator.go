@@ -0,0 +1,60 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+)
+
+type Moderator interface {
+	// Check returns a *Rejection when text is not allowed and any other error
+	// when the text could not be checked.
+	Check(ctx context.Context, text string) error
+}
+
+type Rejection struct {
+	Field  string
+	Reason string
+}
+
+func (r *Rejection) Error() string {
+	if r.Field == "" {
+		return fmt.Sprintf("text rejected: %s", r.Reason)
+	}
+	return fmt.Sprintf("%s rejected: %s", r.Field, r.Reason)
+}
+
+// Chain runs moderators in order and stops at the first error.
+type Chain []Moderator
+
+func (c Chain) Check(ctx context.Context, text string) error {
+	for _, m := range c {
+		if err := m.Check(ctx, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type Field struct {
+	Name string
+	Text string
+}
+
+// CheckFields checks every non-empty field and tags a rejection with the name
+// of the field that caused it.
+func CheckFields(ctx context.Context, m Moderator, fields ...Field) error {
+	for _, f := range fields {
+		if f.Text == "" {
+			continue
+		}
+
+		err := m.Check(ctx, f.Text)
+		if rejection, ok := err.(*Rejection); ok {
+			return &Rejection{Field: f.Name, Reason: rejection.Reason}
+		}
+		if err != nil {
+			return fmt.Errorf("moderate %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package moderation
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const reasonProfanity = "profanity"
+
+// DefaultWords is the built-in Russian and English word list. Entries ending
+// with "*" match any word with that prefix, the rest match whole words only.
+// Entries starting with "!" are harmless words that begin with a listed
+// prefix.
+var DefaultWords = []string{
+	"fuck*", "motherfuck*", "shit*", "bullshit*", "cunt*", "bitch*", "asshole*",
+	"dick", "dickhead*", "cock", "cocksuck*", "whore*", "slut*", "bastard*",
+	"fag", "faggot*", "nigger*", "nigga*", "retard", "retards", "retarded",
+	"!shiitake", "!shitake",
+	"хуй*", "хуе*", "хуи*", "хуя*", "нахуй*", "похуй*", "охуе*", "охуи*",
+	"пизд*", "спизд*", "опизд*",
+	"ебат*", "ебан*", "ебал*", "ебл*", "ебну*", "ебуч*", "заеб*", "наеб*", "выеб*", "уеб*", "долбоеб*", "разъеб*",
+	"бля", "блять*", "бляд*",
+	"сука", "суки", "сучка", "сучар*",
+	"мудак*", "мудил*", "пидор*", "пидар*", "пидр*", "гандон*", "шлюх*", "залуп*",
+}
+
+var leetReplacer = strings.NewReplacer(
+	"0", "o",
+	"1", "i",
+	"3", "e",
+	"4", "a",
+	"5", "s",
+	"7", "t",
+	"@", "a",
+	"$", "s",
+	"ё", "е",
+)
+
+var (
+	latinToCyrillic = strings.NewReplacer(
+		"a", "а", "b", "в", "c", "с", "e", "е", "h", "н", "k", "к", "m", "м",
+		"o", "о", "p", "р", "t", "т", "x", "х", "y", "у", "u", "и",
+	)
+	cyrillicToLatin = strings.NewReplacer(
+		"а", "a", "в", "b", "с", "c", "е", "e", "н", "h", "к", "k", "м", "m",
+		"о", "o", "р", "p", "т", "t", "х", "x", "у", "y", "и", "u",
+	)
+)
+
+type WordListModerator struct {
+	words    map[string]struct{}
+	allowed  map[string]struct{}
+	stems    []string
+	patterns []*regexp.Regexp
+}
+
+// NewWordListModerator builds a moderator from words in the DefaultWords
+// format. Patterns are matched against the normalised text as a whole.
+func NewWordListModerator(words []string, patterns []*regexp.Regexp) *WordListModerator {
+	m := &WordListModerator{
+		words:    make(map[string]struct{}, len(words)),
+		allowed:  make(map[string]struct{}),
+		patterns: patterns,
+	}
+
+	for _, w := range words {
+		w = strings.ToLower(w)
+		if allowed, ok := strings.CutPrefix(w, "!"); ok {
+			m.allowed[collapseRepeats(allowed)] = struct{}{}
+			continue
+		}
+		if stem, ok := strings.CutSuffix(w, "*"); ok {
+			m.stems = append(m.stems, collapseRepeats(stem))
+			continue
+		}
+		m.words[w] = struct{}{}
+	}
+
+	return m
+}
+
+func (m *WordListModerator) Check(_ context.Context, text string) error {
+	words := normalize(text)
+
+	for _, w := range words {
+		if m.flagged(w) {
+			return &Rejection{Reason: reasonProfanity}
+		}
+	}
+
+	joined := strings.Join(words, " ")
+	for _, p := range m.patterns {
+		if p.MatchString(joined) {
+			return &Rejection{Reason: reasonProfanity}
+		}
+	}
+
+	return nil
+}
+
+func (m *WordListModerator) flagged(word string) bool {
+	spellings := variants(word)
+	for _, w := range spellings {
+		if _, ok := m.allowed[collapseRepeats(w)]; ok {
+			return false
+		}
+	}
+
+	for _, w := range spellings {
+		if m.matches(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches looks whole words up as written. Stems are compared with repeated
+// letters collapsed on both sides, so "fuuuck" and "asssshole" still match.
+func (m *WordListModerator) matches(word string) bool {
+	if _, ok := m.words[word]; ok {
+		return true
+	}
+
+	collapsed := collapseRepeats(word)
+	for _, stem := range m.stems {
+		if strings.HasPrefix(collapsed, stem) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize lowercases text, undoes common leetspeak substitutions and splits
+// it into words. Runs of single letters such as "f.u.c.k" or "f u c k" are
+// glued back into one word.
+func normalize(text string) []string {
+	text = leetReplacer.Replace(strings.ToLower(text))
+
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	var (
+		words   []string
+		letters strings.Builder
+	)
+	flush := func() {
+		if letters.Len() > 0 {
+			words = append(words, letters.String())
+			letters.Reset()
+		}
+	}
+
+	for _, f := range fields {
+		if len([]rune(f)) == 1 {
+			letters.WriteString(f)
+			continue
+		}
+		flush()
+		words = append(words, f)
+	}
+	flush()
+
+	return words
+}
+
+// variants returns the spellings of word to look up: as written and with
+// lookalike letters folded into a single script.
+func variants(word string) []string {
+	return []string{
+		word,
+		latinToCyrillic.Replace(word),
+		cyrillicToLatin.Replace(word),
+	}
+}
+
+func collapseRepeats(s string) string {
+	var b strings.Builder
+	var prev rune
+	for i, r := range s {
+		if i > 0 && r == prev {
+			continue
+		}
+		b.WriteRune(r)
+		prev = r
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package moderation
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordListModeratorCheck(t *testing.T) {
+	m := NewWordListModerator(DefaultWords, nil)
+
+	testCases := []struct {
+		text     string
+		rejected bool
+	}{
+		{text: "Концерт в парке", rejected: false},
+		{text: "Cocktail party", rejected: false},
+		{text: "Charles Dickens reading club", rejected: false},
+		{text: "Страхуем новичков на скалодроме", rejected: false},
+		{text: "Суккуленты и кактусы", rejected: false},
+		{text: "Shiitake cooking class", rejected: false},
+		{text: "Shiiitake cooking class", rejected: false},
+		{text: "Fire retardant workshop", rejected: false},
+		{text: "Сучки для костра", rejected: false},
+		{text: "retarded idea", rejected: true},
+		{text: "Ты сучка", rejected: true},
+		{text: "bullshiiit", rejected: true},
+		{text: "asssshole", rejected: true},
+		{text: "what the fuck", rejected: true},
+		{text: "F*U*C*K this", rejected: true},
+		{text: "sh1t show", rejected: true},
+		{text: "f.u.c.k", rejected: true},
+		{text: "fuuuuck", rejected: true},
+		{text: "иди нахуй", rejected: true},
+		{text: "Полная пиздец тусовка", rejected: true},
+		{text: "xyй", rejected: true},
+		{text: "cyka", rejected: true},
+		{text: "Ёбаный стыд", rejected: true},
+		{text: "БЛЯ", rejected: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.text, func(t *testing.T) {
+			err := m.Check(context.Background(), tc.text)
+			if !tc.rejected {
+				require.NoError(t, err)
+				return
+			}
+
+			var rejection *Rejection
+			require.ErrorAs(t, err, &rejection)
+			require.Equal(t, reasonProfanity, rejection.Reason)
+		})
+	}
+}
+
+func TestWordListModeratorPatterns(t *testing.T) {
+	m := NewWordListModerator(nil, []*regexp.Regexp{regexp.MustCompile(`buy followers`)})
+
+	require.NoError(t, m.Check(context.Background(), "Buy fresh flowers"))
+	require.Error(t, m.Check(context.Background(), "BUY   followers now"))
+}
+
+func TestCheckFields(t *testing.T) {
+	m := NewWordListModerator(DefaultWords, nil)
+
+	err := CheckFields(context.Background(), m,
+		Field{Name: "name", Text: "Пикник"},
+		Field{Name: "description", Text: "полная хуйня"},
+	)
+
+	var rejection *Rejection
+	require.ErrorAs(t, err, &rejection)
+	require.Equal(t, "description", rejection.Field)
+
+	require.NoError(t, CheckFields(context.Background(), m, Field{Name: "name", Text: ""}))
+}
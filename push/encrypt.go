@@ -0,0 +1,109 @@
+package push
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const recordSize = 4096
+
+// encrypt implements the aes128gcm content coding of RFC 8291 for a single
+// record: the payload is encrypted with a key derived from an ephemeral ECDH
+// exchange with the subscriber's key and their auth secret.
+func encrypt(sub Subscription, payload []byte) ([]byte, error) {
+	uaPublicBytes, err := decodeKey(sub.P256dh)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	authSecret, err := decodeKey(sub.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth secret: %w", err)
+	}
+
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublicBytes := asPrivate.PublicKey().Bytes()
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	sharedSecret, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublicBytes...)
+	keyInfo = append(keyInfo, asPublicBytes...)
+	ikm, err := expand(hkdf.Extract(sha256.New, sharedSecret, authSecret), keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	cek, err := expand(prk, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := expand(prk, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0x02 marks the last (and only) record.
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	if len(plaintext)+gcm.Overhead() > recordSize {
+		return nil, fmt.Errorf("payload of %d bytes does not fit into one record", len(payload))
+	}
+
+	header := make([]byte, 0, 16+4+1+len(asPublicBytes))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublicBytes)))
+	header = append(header, asPublicBytes...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+func expand(prk, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decodeKey accepts both padded and unpadded base64url, since browsers and
+// client libraries disagree on which one PushSubscription keys use.
+func decodeKey(s string) ([]byte, error) {
+	if b, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
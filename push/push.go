@@ -0,0 +1,20 @@
+package push
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrGone is returned when the push service reports that the subscription no
+// longer exists; callers should forget it.
+var ErrGone = errors.New("push subscription is gone")
+
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+type Sender interface {
+	Send(ctx context.Context, sub Subscription, payload []byte) error
+}
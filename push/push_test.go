@@ -0,0 +1,214 @@
+package push
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"treffly/util"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/hkdf"
+)
+
+type browser struct {
+	key  *ecdh.PrivateKey
+	auth []byte
+}
+
+func newBrowser(t *testing.T) browser {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+
+	return browser{key: key, auth: auth}
+}
+
+func (b browser) subscription(endpoint string) Subscription {
+	return Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(b.key.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(b.auth),
+	}
+}
+
+// decrypt is the user agent side of RFC 8291.
+func (b browser) decrypt(t *testing.T, body []byte) []byte {
+	salt := body[:16]
+	require.Equal(t, uint32(recordSize), binary.BigEndian.Uint32(body[16:20]))
+	idLen := int(body[20])
+	asPublicBytes := body[21 : 21+idLen]
+	ciphertext := body[21+idLen:]
+
+	asPublic, err := ecdh.P256().NewPublicKey(asPublicBytes)
+	require.NoError(t, err)
+	shared, err := b.key.ECDH(asPublic)
+	require.NoError(t, err)
+
+	keyInfo := append([]byte("WebPush: info\x00"), b.key.PublicKey().Bytes()...)
+	keyInfo = append(keyInfo, asPublicBytes...)
+	ikm := make([]byte, 32)
+	_, err = io.ReadFull(hkdf.New(sha256.New, shared, b.auth, keyInfo), ikm)
+	require.NoError(t, err)
+
+	cek := make([]byte, 16)
+	_, err = io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: aes128gcm\x00")), cek)
+	require.NoError(t, err)
+	nonce := make([]byte, 12)
+	_, err = io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: nonce\x00")), nonce)
+	require.NoError(t, err)
+
+	block, err := aes.NewCipher(cek)
+	require.NoError(t, err)
+	gcm, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), plaintext[len(plaintext)-1])
+
+	return plaintext[:len(plaintext)-1]
+}
+
+func verifyVAPID(t *testing.T, header, publicKey, audience string) {
+	require.True(t, strings.HasPrefix(header, "vapid t="))
+	parts := strings.Split(strings.TrimPrefix(header, "vapid t="), ", k=")
+	require.Len(t, parts, 2)
+	require.Equal(t, publicKey, parts[1])
+
+	segments := strings.Split(parts[0], ".")
+	require.Len(t, segments, 3)
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(segments[1])
+	require.NoError(t, err)
+	var claims struct {
+		Aud string `json:"aud"`
+		Exp int64  `json:"exp"`
+		Sub string `json:"sub"`
+	}
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	require.Equal(t, audience, claims.Aud)
+	require.Equal(t, "mailto:admin@treffly.ru", claims.Sub)
+	require.Greater(t, claims.Exp, time.Now().Unix())
+
+	public, err := base64.RawURLEncoding.DecodeString(publicKey)
+	require.NoError(t, err)
+	key, err := ecdh.P256().NewPublicKey(public)
+	require.NoError(t, err)
+	raw := key.Bytes()
+
+	signature, err := base64.RawURLEncoding.DecodeString(segments[2])
+	require.NoError(t, err)
+	require.Len(t, signature, 64)
+
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	ok := ecdsa.Verify(
+		&ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(raw[1:33]), Y: new(big.Int).SetBytes(raw[33:])},
+		digest[:],
+		new(big.Int).SetBytes(signature[:32]),
+		new(big.Int).SetBytes(signature[32:]),
+	)
+	require.True(t, ok)
+}
+
+func newTestSender(t *testing.T) *VAPIDSender {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+
+	sender, err := NewVAPIDSender(keys.PrivateKey, "mailto:admin@treffly.ru", time.Second, true)
+	require.NoError(t, err)
+	require.Equal(t, keys.PublicKey, sender.PublicKey())
+
+	return sender
+}
+
+func TestVAPIDSenderSend(t *testing.T) {
+	sender := newTestSender(t)
+	b := newBrowser(t)
+
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		require.NotEmpty(t, r.Header.Get("TTL"))
+		verifyVAPID(t, r.Header.Get("Authorization"), sender.PublicKey(), "http://"+r.Host)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = body
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	payload := []byte(`{"title":"Пикник","body":"Начало через час"}`)
+	err := sender.Send(context.Background(), b.subscription(server.URL+"/push/abc"), payload)
+	require.NoError(t, err)
+	require.Equal(t, payload, b.decrypt(t, received))
+}
+
+func TestVAPIDSenderGone(t *testing.T) {
+	sender := newTestSender(t)
+	b := newBrowser(t)
+
+	for _, status := range []int{http.StatusNotFound, http.StatusGone} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		err := sender.Send(context.Background(), b.subscription(server.URL), []byte("{}"))
+		require.ErrorIs(t, err, ErrGone)
+		server.Close()
+	}
+}
+
+func TestVAPIDSenderServerError(t *testing.T) {
+	sender := newTestSender(t)
+	b := newBrowser(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	err := sender.Send(context.Background(), b.subscription(server.URL), []byte("{}"))
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrGone)
+	require.Contains(t, err.Error(), "401")
+}
+
+func TestVAPIDSenderRejectsPrivateAddress(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	sender, err := NewVAPIDSender(keys.PrivateKey, "mailto:admin@treffly.ru", time.Second, false)
+	require.NoError(t, err)
+	b := newBrowser(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached a private address")
+	}))
+	defer server.Close()
+
+	err = sender.Send(context.Background(), b.subscription(server.URL), []byte("{}"))
+	require.ErrorIs(t, err, util.ErrPrivateAddress)
+}
+
+func TestEncryptRejectsBadKeys(t *testing.T) {
+	_, err := encrypt(Subscription{P256dh: "bm90IGEga2V5", Auth: "c2VjcmV0"}, []byte("{}"))
+	require.Error(t, err)
+}
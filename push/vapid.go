@@ -0,0 +1,92 @@
+package push
+
+import (
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"time"
+)
+
+const vapidTokenTTL = 12 * time.Hour
+
+type VAPIDKeys struct {
+	PublicKey  string
+	PrivateKey string
+}
+
+// GenerateVAPIDKeys returns a new P-256 key pair encoded as unpadded
+// base64url, the format browsers expect for applicationServerKey.
+func GenerateVAPIDKeys() (VAPIDKeys, error) {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return VAPIDKeys{}, err
+	}
+
+	return VAPIDKeys{
+		PublicKey:  base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		PrivateKey: base64.RawURLEncoding.EncodeToString(key.Bytes()),
+	}, nil
+}
+
+func parseVAPIDKey(privateKey string) (*ecdsa.PrivateKey, string, error) {
+	d, err := decodeKey(privateKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	key, err := ecdh.P256().NewPrivateKey(d)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	public := key.PublicKey().Bytes()
+
+	return &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(public[1:33]),
+			Y:     new(big.Int).SetBytes(public[33:]),
+		},
+		D: new(big.Int).SetBytes(d),
+	}, base64.RawURLEncoding.EncodeToString(public), nil
+}
+
+// vapidToken signs the ES256 JWT of RFC 8292 for the push service that hosts
+// endpoint.
+func vapidToken(key *ecdsa.PrivateKey, endpoint, subject string, now time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+
+	claims, err := json.Marshal(map[string]any{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(vapidTokenTTL).Unix(),
+		"sub": subject,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"ES256"}`)) +
+		"." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
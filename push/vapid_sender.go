@@ -0,0 +1,98 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+	"treffly/util"
+)
+
+const messageTTL = 24 * time.Hour
+
+type VAPIDSender struct {
+	key       *ecdsa.PrivateKey
+	publicKey string
+	subject   string
+	client    *http.Client
+}
+
+// NewVAPIDSender creates a sender identified by the given VAPID key pair.
+// subject is a mailto: or https: contact URL for the push service operator.
+// Endpoints come from browsers, so unless allowPrivate is set connections to
+// internal addresses are refused.
+func NewVAPIDSender(privateKey, subject string, timeout time.Duration, allowPrivate bool) (*VAPIDSender, error) {
+	key, publicKey, err := parseVAPIDKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = util.DenyPrivateAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+
+	return &VAPIDSender{
+		key:       key,
+		publicKey: publicKey,
+		subject:   subject,
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}, nil
+}
+
+// PublicKey is the applicationServerKey clients subscribe with.
+func (s *VAPIDSender) PublicKey() string {
+	return s.publicKey
+}
+
+func (s *VAPIDSender) Send(ctx context.Context, sub Subscription, payload []byte) error {
+	body, err := encrypt(sub, payload)
+	if err != nil {
+		return err
+	}
+
+	token, err := vapidToken(s.key, sub.Endpoint, s.subject, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", strconv.Itoa(int(messageTTL.Seconds())))
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", token, s.publicKey))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrGone
+	case resp.StatusCode >= 300:
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("push service returned %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
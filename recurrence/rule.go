@@ -0,0 +1,169 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+const MaxOccurrences = 52
+
+var (
+	ErrInvalidRule        = errors.New("invalid recurrence rule")
+	ErrTooManyOccurrences = fmt.Errorf("recurrence rule produces more than %d occurrences", MaxOccurrences)
+	ErrNoOccurrences      = errors.New("recurrence rule produces no occurrences")
+	untilLayouts          = []string{"20060102T150405Z", "20060102"}
+)
+
+// Rule is a subset of RFC 5545 RRULE: FREQ, INTERVAL and exactly one of COUNT or UNTIL.
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	Count    int
+	Until    time.Time
+}
+
+func Parse(s string) (Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return Rule{}, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	rule := Rule{Interval: 1}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if seen[key] {
+			return Rule{}, fmt.Errorf("%w: duplicate %s", ErrInvalidRule, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Freq = Frequency(strings.ToUpper(value))
+			if rule.Freq != Daily && rule.Freq != Weekly && rule.Freq != Monthly {
+				return Rule{}, fmt.Errorf("%w: unsupported FREQ %q", ErrInvalidRule, value)
+			}
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(value)
+		case "COUNT":
+			rule.Count, err = parsePositive(value)
+			if err == nil && rule.Count > MaxOccurrences {
+				err = ErrTooManyOccurrences
+			}
+		case "UNTIL":
+			rule.Until, err = parseUntil(value)
+		default:
+			return Rule{}, fmt.Errorf("%w: unsupported part %s", ErrInvalidRule, key)
+		}
+		if err != nil {
+			return Rule{}, fmt.Errorf("%w: %s: %w", ErrInvalidRule, key, err)
+		}
+	}
+
+	if rule.Freq == "" {
+		return Rule{}, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if (rule.Count == 0) == rule.Until.IsZero() {
+		return Rule{}, fmt.Errorf("%w: exactly one of COUNT or UNTIL is required", ErrInvalidRule)
+	}
+
+	return rule, nil
+}
+
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayouts[0]))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences expands the rule starting at start, which is always the first occurrence.
+// Monthly rules skip months that do not have start's day of month, as RFC 5545 requires.
+func (r Rule) Occurrences(start time.Time) ([]time.Time, error) {
+	var result []time.Time
+
+	for step := 0; ; step++ {
+		next, ok := r.step(start, step)
+		if !r.Until.IsZero() && next.After(r.Until) {
+			break
+		}
+		if ok {
+			if len(result) == MaxOccurrences {
+				return nil, ErrTooManyOccurrences
+			}
+			result = append(result, next)
+		}
+		if r.Count > 0 && len(result) == r.Count {
+			break
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, ErrNoOccurrences
+	}
+
+	return result, nil
+}
+
+func (r Rule) step(start time.Time, step int) (time.Time, bool) {
+	n := step * r.Interval
+	switch r.Freq {
+	case Daily:
+		return start.AddDate(0, 0, n), true
+	case Weekly:
+		return start.AddDate(0, 0, 7*n), true
+	default:
+		next := start.AddDate(0, n, 0)
+		return next, next.Day() == start.Day()
+	}
+}
+
+func parsePositive(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n < 1 {
+		return 0, errors.New("must be positive")
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(untilLayouts[0], value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(untilLayouts[1], value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.Add(24*time.Hour - time.Second), nil
+}
@@ -0,0 +1,91 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	rule, err := Parse("RRULE:FREQ=weekly;INTERVAL=2;COUNT=4")
+	require.NoError(t, err)
+	require.Equal(t, Weekly, rule.Freq)
+	require.Equal(t, 2, rule.Interval)
+	require.Equal(t, 4, rule.Count)
+	require.Equal(t, "FREQ=WEEKLY;INTERVAL=2;COUNT=4", rule.String())
+
+	rule, err = Parse("FREQ=DAILY;UNTIL=20261231")
+	require.NoError(t, err)
+	require.Equal(t, 1, rule.Interval)
+	require.Equal(t, time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC), rule.Until)
+
+	for _, invalid := range []string{
+		"",
+		"FREQ=YEARLY;COUNT=2",
+		"FREQ=DAILY",
+		"FREQ=DAILY;COUNT=2;UNTIL=20261231",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=100",
+		"FREQ=DAILY;INTERVAL=-1;COUNT=2",
+		"FREQ=DAILY;BYDAY=MO;COUNT=2",
+		"FREQ=DAILY;FREQ=WEEKLY;COUNT=2",
+		"COUNT=2",
+	} {
+		_, err = Parse(invalid)
+		require.ErrorIs(t, err, ErrInvalidRule, invalid)
+	}
+}
+
+func TestOccurrencesCount(t *testing.T) {
+	start := time.Date(2026, 3, 2, 19, 0, 0, 0, time.UTC)
+	rule, err := Parse("FREQ=WEEKLY;COUNT=3")
+	require.NoError(t, err)
+
+	dates, err := rule.Occurrences(start)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		start,
+		start.AddDate(0, 0, 7),
+		start.AddDate(0, 0, 14),
+	}, dates)
+}
+
+func TestOccurrencesUntil(t *testing.T) {
+	start := time.Date(2026, 3, 2, 19, 0, 0, 0, time.UTC)
+	rule, err := Parse("FREQ=DAILY;INTERVAL=3;UNTIL=20260311")
+	require.NoError(t, err)
+
+	dates, err := rule.Occurrences(start)
+	require.NoError(t, err)
+	require.Len(t, dates, 4)
+	require.Equal(t, time.Date(2026, 3, 11, 19, 0, 0, 0, time.UTC), dates[3])
+}
+
+func TestOccurrencesMonthlySkipsShortMonths(t *testing.T) {
+	start := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+	rule, err := Parse("FREQ=MONTHLY;COUNT=3")
+	require.NoError(t, err)
+
+	dates, err := rule.Occurrences(start)
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{
+		start,
+		time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 5, 31, 12, 0, 0, 0, time.UTC),
+	}, dates)
+}
+
+func TestOccurrencesLimits(t *testing.T) {
+	start := time.Date(2026, 3, 2, 19, 0, 0, 0, time.UTC)
+
+	rule, err := Parse("FREQ=DAILY;UNTIL=20280101")
+	require.NoError(t, err)
+	_, err = rule.Occurrences(start)
+	require.ErrorIs(t, err, ErrTooManyOccurrences)
+
+	rule, err = Parse("FREQ=DAILY;UNTIL=20260101")
+	require.NoError(t, err)
+	_, err = rule.Occurrences(start)
+	require.ErrorIs(t, err, ErrNoOccurrences)
+}
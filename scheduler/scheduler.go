@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"time"
+)
+
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// Scheduler runs registered jobs periodically in their own goroutines. A job
+// runs once on start and then every interval; a slow run delays the next tick
+// instead of overlapping with it.
+type Scheduler struct {
+	log  *zap.Logger
+	jobs []job
+}
+
+func New(log *zap.Logger) *Scheduler {
+	return &Scheduler{log: log}
+}
+
+func (s *Scheduler) Every(name string, interval time.Duration, run JobFunc) {
+	s.jobs = append(s.jobs, job{
+		name:     name,
+		interval: interval,
+		run:      run,
+	})
+}
+
+// Start launches all jobs and returns immediately. Jobs stop when ctx is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		go s.loop(ctx, j)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, j job) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, j)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, j job) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.log.Error("job panicked", zap.String("job", j.name), zap.Any("panic", r))
+		}
+	}()
+
+	if err := j.run(ctx); err != nil {
+		s.log.Error("job failed", zap.String("job", j.name), zap.Error(err))
+	}
+}
@@ -0,0 +1,49 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSchedulerRunsJobUntilCancelled(t *testing.T) {
+	s := New(zap.NewNop())
+
+	var runs atomic.Int32
+	s.Every("count", 10*time.Millisecond, func(ctx context.Context) error {
+		runs.Add(1)
+		return errors.New("failures do not stop the job")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.Start(ctx)
+
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+
+	cancel()
+	time.Sleep(30 * time.Millisecond)
+	stopped := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, stopped, runs.Load())
+}
+
+func TestSchedulerRecoversFromPanic(t *testing.T) {
+	s := New(zap.NewNop())
+
+	var runs atomic.Int32
+	s.Every("panic", 10*time.Millisecond, func(ctx context.Context) error {
+		runs.Add(1)
+		panic("boom")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	require.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+}
@@ -1,8 +1,12 @@
 package token
 
-import "time"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type Maker interface {
 	CreateToken(userID int32, duration time.Duration) (string, *Payload,  error)
+	CreateSessionToken(userID int32, sessionID uuid.UUID, role string, duration time.Duration) (string, *Payload, error)
 	VerifyToken(token string) (*Payload, error)
 }
@@ -2,6 +2,7 @@ package token
 
 import (
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/o1egl/paseto"
 	"golang.org/x/crypto/chacha20poly1305"
 	"time"
@@ -35,6 +36,18 @@ func (maker *PasetoMaker) CreateToken(userID int32, duration time.Duration) (str
 	return token, payload, err
 }
 
+func (maker *PasetoMaker) CreateSessionToken(userID int32, sessionID uuid.UUID, role string, duration time.Duration) (string, *Payload, error) {
+	payload, err := NewPayload(userID, duration)
+	if err != nil {
+		return "", payload, err
+	}
+	payload.SessionID = sessionID
+	payload.Role = role
+
+	token, err := maker.paseto.Encrypt(maker.symmetricKey, payload, nil)
+	return token, payload, err
+}
+
 func (maker *PasetoMaker) VerifyToken(token string) (*Payload, error) {
 	payload := &Payload{}
 
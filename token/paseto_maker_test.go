@@ -5,6 +5,7 @@ import (
 	"time"
 	"treffly/util"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -47,4 +48,24 @@ func TestExpiredPasetoToken(t *testing.T) {
 	require.Error(t, err)
 	require.EqualError(t, err, ErrExpiredToken.Error())
 	require.Nil(t, payload)
-}
\ No newline at end of file
+}
+
+func TestPasetoMakerSessionToken(t *testing.T) {
+	maker, err := NewPasetoMaker(util.RandomString(32))
+	require.NoError(t, err)
+
+	userID := int32(util.RandomInt(0, 100))
+	sessionID := uuid.New()
+
+	token, payload, err := maker.CreateSessionToken(userID, sessionID, "admin", time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+	require.Equal(t, sessionID, payload.SessionID)
+
+	payload, err = maker.VerifyToken(token)
+	require.NoError(t, err)
+	require.Equal(t, userID, payload.UserID)
+	require.Equal(t, sessionID, payload.SessionID)
+	require.Equal(t, "admin", payload.Role)
+	require.NotEqual(t, sessionID, payload.ID)
+}
@@ -14,6 +14,8 @@ var (
 type Payload struct {
 	ID        uuid.UUID `json:"id"`
 	UserID    int32       `json:"user_id"`
+	SessionID uuid.UUID `json:"session_id"`
+	Role      string    `json:"role"`
 	IssuedAt  time.Time `json:"issued_at"`
 	ExpiredAt time.Time `json:"expired_at"`
 }
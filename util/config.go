@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"github.com/spf13/viper"
 	"time"
 )
@@ -27,6 +28,44 @@ type Config struct {
 	RedisDB               int           `mapstructure:"REDIS_DB"`
 	GenLimit              int           `mapstructure:"GEN_LIMIT"`
 	GenTimeout            time.Duration `mapstructure:"GEN_TIMEOUT"`
+	EventsPageSize        int           `mapstructure:"EVENTS_PAGE_SIZE"`
+	EventsMaxPageSize     int           `mapstructure:"EVENTS_MAX_PAGE_SIZE"`
+	EventsDefaultRadius   float64       `mapstructure:"EVENTS_DEFAULT_RADIUS"`
+	EventsMaxRadius       float64       `mapstructure:"EVENTS_MAX_RADIUS"`
+	EventsMaxMarkers      int           `mapstructure:"EVENTS_MAX_MARKERS"`
+	SMTPHost              string        `mapstructure:"SMTP_HOST"`
+	SMTPPort              int           `mapstructure:"SMTP_PORT"`
+	SMTPUsername          string        `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword          string        `mapstructure:"SMTP_PASSWORD"`
+	MailFrom              string        `mapstructure:"MAIL_FROM"`
+	MailDir               string        `mapstructure:"MAIL_DIR"`
+	EmailVerifyDuration   time.Duration `mapstructure:"EMAIL_VERIFY_DURATION"`
+	PasswordResetDuration time.Duration `mapstructure:"PASSWORD_RESET_DURATION"`
+	AuthLimit             int           `mapstructure:"AUTH_LIMIT"`
+	AuthWindow            time.Duration `mapstructure:"AUTH_WINDOW"`
+	PromotionSyncInterval time.Duration `mapstructure:"PROMOTION_SYNC_INTERVAL"`
+	PromotionMaxDuration  time.Duration `mapstructure:"PROMOTION_MAX_DURATION"`
+	ReportHideThreshold   int           `mapstructure:"REPORT_HIDE_THRESHOLD"`
+	ModerationURL         string        `mapstructure:"MODERATION_URL"`
+	ModerationAPIKey      string        `mapstructure:"MODERATION_API_KEY"`
+	ModerationTimeout     time.Duration `mapstructure:"MODERATION_TIMEOUT"`
+	NotificationsInterval time.Duration `mapstructure:"NOTIFICATIONS_INTERVAL"`
+	RemindersInterval     time.Duration `mapstructure:"REMINDERS_INTERVAL"`
+	ReminderMaxAttempts   int           `mapstructure:"REMINDER_MAX_ATTEMPTS"`
+	VAPIDPrivateKey       string        `mapstructure:"VAPID_PRIVATE_KEY"`
+	VAPIDSubject          string        `mapstructure:"VAPID_SUBJECT"`
+	PushTimeout           time.Duration `mapstructure:"PUSH_TIMEOUT"`
+	PushAllowPrivate      bool          `mapstructure:"PUSH_ALLOW_PRIVATE_NETWORKS"`
+	OutboxRelayInterval   time.Duration `mapstructure:"OUTBOX_RELAY_INTERVAL"`
+	OutboxMaxAttempts     int           `mapstructure:"OUTBOX_MAX_ATTEMPTS"`
+	OutboxRetention       time.Duration `mapstructure:"OUTBOX_RETENTION"`
+	WebhooksInterval      time.Duration `mapstructure:"WEBHOOKS_INTERVAL"`
+	WebhookTimeout        time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	WebhookMaxAttempts    int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+	WebhookDisableAfter   int           `mapstructure:"WEBHOOK_DISABLE_THRESHOLD"`
+	WebhookAllowPrivate   bool          `mapstructure:"WEBHOOK_ALLOW_PRIVATE_NETWORKS"`
+	StreamHeartbeat       time.Duration `mapstructure:"STREAM_HEARTBEAT"`
+	StreamMaxConnections  int           `mapstructure:"STREAM_MAX_CONNECTIONS"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -34,6 +73,36 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetConfigName("app")
 	viper.SetConfigType("env")
 	viper.SetDefault("ENVIRONMENT", "development")
+	viper.SetDefault("EVENTS_PAGE_SIZE", 20)
+	viper.SetDefault("EVENTS_MAX_PAGE_SIZE", 50)
+	viper.SetDefault("EVENTS_DEFAULT_RADIUS", 100000)
+	viper.SetDefault("EVENTS_MAX_RADIUS", 500000)
+	viper.SetDefault("EVENTS_MAX_MARKERS", 500)
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("MAIL_FROM", "no-reply@treffly.ru")
+	viper.SetDefault("MAIL_DIR", "mail")
+	viper.SetDefault("EMAIL_VERIFY_DURATION", "24h")
+	viper.SetDefault("PASSWORD_RESET_DURATION", "1h")
+	viper.SetDefault("AUTH_LIMIT", 5)
+	viper.SetDefault("AUTH_WINDOW", "15m")
+	viper.SetDefault("PROMOTION_SYNC_INTERVAL", "1m")
+	viper.SetDefault("PROMOTION_MAX_DURATION", "720h")
+	viper.SetDefault("REPORT_HIDE_THRESHOLD", 5)
+	viper.SetDefault("MODERATION_TIMEOUT", "3s")
+	viper.SetDefault("NOTIFICATIONS_INTERVAL", "5m")
+	viper.SetDefault("REMINDERS_INTERVAL", "1m")
+	viper.SetDefault("REMINDER_MAX_ATTEMPTS", 5)
+	viper.SetDefault("VAPID_SUBJECT", "mailto:no-reply@treffly.ru")
+	viper.SetDefault("PUSH_TIMEOUT", "10s")
+	viper.SetDefault("OUTBOX_RELAY_INTERVAL", "2s")
+	viper.SetDefault("OUTBOX_MAX_ATTEMPTS", 10)
+	viper.SetDefault("OUTBOX_RETENTION", "168h")
+	viper.SetDefault("WEBHOOKS_INTERVAL", "10s")
+	viper.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_DISABLE_THRESHOLD", 25)
+	viper.SetDefault("STREAM_HEARTBEAT", "15s")
+	viper.SetDefault("STREAM_MAX_CONNECTIONS", 1000)
 
 	viper.AutomaticEnv()
 	err = viper.ReadInConfig()
@@ -41,5 +110,50 @@ func LoadConfig(path string) (config Config, err error) {
 		return
 	}
 	err = viper.Unmarshal(&config)
+	if err != nil {
+		return
+	}
+	err = config.validate()
 	return
 }
+
+// validate rejects settings that would only fail later: a zero interval
+// panics the scheduler's ticker, a zero attempt cap stops retries before the
+// first try and a zero limit refuses every request.
+func (c Config) validate() error {
+	intervals := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"STREAM_HEARTBEAT", c.StreamHeartbeat},
+		{"PROMOTION_SYNC_INTERVAL", c.PromotionSyncInterval},
+		{"NOTIFICATIONS_INTERVAL", c.NotificationsInterval},
+		{"REMINDERS_INTERVAL", c.RemindersInterval},
+		{"OUTBOX_RELAY_INTERVAL", c.OutboxRelayInterval},
+		{"WEBHOOKS_INTERVAL", c.WebhooksInterval},
+		{"AUTH_WINDOW", c.AuthWindow},
+	}
+	for _, interval := range intervals {
+		if interval.value <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", interval.name, interval.value)
+		}
+	}
+
+	counts := []struct {
+		name  string
+		value int
+	}{
+		{"STREAM_MAX_CONNECTIONS", c.StreamMaxConnections},
+		{"REMINDER_MAX_ATTEMPTS", c.ReminderMaxAttempts},
+		{"OUTBOX_MAX_ATTEMPTS", c.OutboxMaxAttempts},
+		{"WEBHOOK_MAX_ATTEMPTS", c.WebhookMaxAttempts},
+		{"AUTH_LIMIT", c.AuthLimit},
+	}
+	for _, count := range counts {
+		if count.value <= 0 {
+			return fmt.Errorf("%s must be positive, got %d", count.name, count.value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	valid := Config{
+		StreamHeartbeat:       15 * time.Second,
+		StreamMaxConnections:  1000,
+		PromotionSyncInterval: time.Minute,
+		NotificationsInterval: 5 * time.Minute,
+		RemindersInterval:     time.Minute,
+		ReminderMaxAttempts:   5,
+		OutboxRelayInterval:   2 * time.Second,
+		OutboxMaxAttempts:     10,
+		WebhooksInterval:      10 * time.Second,
+		WebhookMaxAttempts:    8,
+		AuthLimit:             5,
+		AuthWindow:            15 * time.Minute,
+	}
+	require.NoError(t, valid.validate())
+
+	testCases := []struct {
+		name   string
+		mutate func(c *Config)
+	}{
+		{name: "ZeroHeartbeat", mutate: func(c *Config) { c.StreamHeartbeat = 0 }},
+		{name: "NegativeHeartbeat", mutate: func(c *Config) { c.StreamHeartbeat = -time.Second }},
+		{name: "ZeroConnections", mutate: func(c *Config) { c.StreamMaxConnections = 0 }},
+		{name: "NegativeConnections", mutate: func(c *Config) { c.StreamMaxConnections = -1 }},
+		{name: "ZeroPromotionSync", mutate: func(c *Config) { c.PromotionSyncInterval = 0 }},
+		{name: "ZeroNotifications", mutate: func(c *Config) { c.NotificationsInterval = 0 }},
+		{name: "ZeroReminders", mutate: func(c *Config) { c.RemindersInterval = 0 }},
+		{name: "ZeroReminderAttempts", mutate: func(c *Config) { c.ReminderMaxAttempts = 0 }},
+		{name: "ZeroOutboxRelay", mutate: func(c *Config) { c.OutboxRelayInterval = 0 }},
+		{name: "ZeroOutboxAttempts", mutate: func(c *Config) { c.OutboxMaxAttempts = 0 }},
+		{name: "NegativeWebhooks", mutate: func(c *Config) { c.WebhooksInterval = -time.Second }},
+		{name: "ZeroWebhookAttempts", mutate: func(c *Config) { c.WebhookMaxAttempts = 0 }},
+		{name: "ZeroAuthLimit", mutate: func(c *Config) { c.AuthLimit = 0 }},
+		{name: "ZeroAuthWindow", mutate: func(c *Config) { c.AuthWindow = 0 }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := valid
+			tc.mutate(&config)
+			require.Error(t, config.validate())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package util
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// ErrPrivateAddress is returned by DenyPrivateAddress for loopback, private
+// and link-local addresses.
+var ErrPrivateAddress = errors.New("address is loopback, private or link-local")
+
+// DenyPrivateAddress is a net.Dialer Control function that refuses to connect
+// to internal addresses, so user-supplied URLs cannot reach the backend
+// network. It runs after DNS resolution, which also covers rebinding.
+func DenyPrivateAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return ErrPrivateAddress
+	}
+
+	return nil
+}
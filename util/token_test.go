@@ -0,0 +1,26 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecureToken(t *testing.T) {
+	token1, err := GenerateSecureToken(32)
+	require.NoError(t, err)
+	require.Len(t, token1, 43)
+
+	token2, err := GenerateSecureToken(32)
+	require.NoError(t, err)
+	require.NotEqual(t, token1, token2)
+}
+
+func TestHashToken(t *testing.T) {
+	token := RandomString(32)
+
+	hash := HashToken(token)
+	require.Len(t, hash, 64)
+	require.Equal(t, hash, HashToken(token))
+	require.NotEqual(t, hash, HashToken(RandomString(32)))
+}
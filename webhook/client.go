@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+	"treffly/util"
+)
+
+// ErrForbiddenAddress is returned when the endpoint resolves to a loopback,
+// private or link-local address and private networks are not allowed.
+var ErrForbiddenAddress = util.ErrPrivateAddress
+
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID int64
+	EventType  string
+	Body       []byte
+}
+
+type Client struct {
+	http *http.Client
+}
+
+// NewClient creates a client whose requests time out after timeout. Unless
+// allowPrivate is set, connections to internal addresses are refused so
+// organisers cannot use webhooks to probe the backend network.
+func NewClient(timeout time.Duration, allowPrivate bool) *Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = util.DenyPrivateAddress
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+
+	return &Client{
+		http: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send posts the signed body and returns the response status. Any non-2xx
+// status is returned as an error together with the status code.
+func (c *Client) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", "Treffly-Webhooks/1.0")
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderDelivery, strconv.FormatInt(req.DeliveryID, 10))
+	httpReq.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, now, req.Body))
+
+	resp, err := c.http.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Backoff returns the delay before retry number attempt (starting at 1):
+// 30s doubled on every attempt and capped at six hours.
+func Backoff(attempt int) time.Duration {
+	const (
+		base    = 30 * time.Second
+		maximum = 6 * time.Hour
+	)
+
+	if attempt < 1 {
+		attempt = 1
+	}
+	if attempt > 20 {
+		return maximum
+	}
+
+	return min(base<<(attempt-1), maximum)
+}
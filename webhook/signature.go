@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Treffly-Event"
+	HeaderDelivery  = "X-Treffly-Delivery"
+	HeaderTimestamp = "X-Treffly-Timestamp"
+	HeaderSignature = "X-Treffly-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature header value for body. The timestamp is part of
+// the signed content so receivers can reject replayed requests.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature produced by Sign. It is what receivers are
+// expected to implement and is used in tests.
+func Verify(secret, timestamp, signature string, body []byte) bool {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+
+	expected := Sign(secret, time.Unix(unix, 0), body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":1}`)
+
+	signature := Sign("secret", now, body)
+	require.True(t, Verify("secret", "1700000000", signature, body))
+	require.False(t, Verify("other", "1700000000", signature, body))
+	require.False(t, Verify("secret", "1700000001", signature, body))
+	require.False(t, Verify("secret", "1700000000", signature, []byte(`{"id":2}`)))
+	require.False(t, Verify("secret", "soon", signature, body))
+}
+
+func TestSendSignsRequest(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	body := []byte(`{"type":"participant.joined"}`)
+	status, err := NewClient(time.Second, true).Send(context.Background(), Request{
+		URL:        srv.URL,
+		Secret:     "secret",
+		DeliveryID: 42,
+		EventType:  "participant.joined",
+		Body:       body,
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, status)
+
+	require.Equal(t, http.MethodPost, received.Method)
+	require.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	require.Equal(t, "participant.joined", received.Header.Get(HeaderEvent))
+	require.Equal(t, "42", received.Header.Get(HeaderDelivery))
+	require.Equal(t, body, receivedBody)
+	require.True(t, Verify("secret", received.Header.Get(HeaderTimestamp), received.Header.Get(HeaderSignature), receivedBody))
+}
+
+func TestSendErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://example.com", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	status, err := NewClient(time.Second, true).Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+	require.Error(t, err)
+	require.Equal(t, http.StatusFound, status)
+}
+
+func TestSendRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request must not reach a private address")
+	}))
+	defer srv.Close()
+
+	_, err := NewClient(time.Second, false).Send(context.Background(), Request{URL: srv.URL, Body: []byte(`{}`)})
+	require.True(t, errors.Is(err, ErrForbiddenAddress))
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, 30*time.Second, Backoff(1))
+	require.Equal(t, time.Minute, Backoff(2))
+	require.Equal(t, 8*time.Minute, Backoff(5))
+	require.Equal(t, 6*time.Hour, Backoff(15))
+	require.Equal(t, 6*time.Hour, Backoff(100))
+}